@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayAbandonedReport shows PRs closed without merging: how many, how long they stayed open, and where they concentrate.
+func displayAbandonedReport(prs []github.PullRequest) {
+	report := stats.CalculateAbandonedReport(prs)
+	if report.Closed == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🗑️  Closed Without Merge:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Closed without merge"), fmt.Sprintf("%d", report.Closed)})
+	table.Append([]string{i18n.T("Abandon Rate"), fmt.Sprintf("%.1f%%", report.Rate)})
+	table.Append([]string{i18n.T("Time Open (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageTimeOpen), formatDuration(report.MedianTimeOpen))})
+	table.Append([]string{i18n.T("Top Authors"), formatNamedCounts(report.TopAuthors)})
+	if len(report.TopLabels) > 0 {
+		table.Append([]string{i18n.T("Top Labels"), formatNamedCounts(report.TopLabels)})
+	}
+	table.Render()
+}
+
+// formatNamedCounts renders counts as "alice (3), bob (1)".
+func formatNamedCounts(counts []stats.NamedCount) string {
+	parts := make([]string, 0, len(counts))
+	for _, c := range counts {
+		parts = append(parts, fmt.Sprintf("%s (%d)", c.Name, c.Count))
+	}
+	return strings.Join(parts, ", ")
+}
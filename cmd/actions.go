@@ -6,14 +6,20 @@ import (
 	"strings"
 	"time"
 	"visuche/internal/actions"
-	"visuche/internal/git"
 	"visuche/internal/i18n"
+	"visuche/internal/progress"
+	"visuche/internal/provider"
 
 	"github.com/manifoldco/promptui"
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
+var failureDetailLimit int
+
+// maxFailureGroups is how many failure groups are listed.
+const maxFailureGroups = 10
+
 var actionsCmd = &cobra.Command{
 	Use:   "actions",
 	Short: "Analyze GitHub Actions CI/CD performance",
@@ -28,6 +34,7 @@ func init() {
 	actionsCmd.Flags().StringVarP(&repo, "repo", "r", "", "GitHub repository in 'owner/repo' format")
 	actionsCmd.Flags().StringVarP(&since, "since", "s", "", "Analyze runs since date (YYYY-MM-DD)")
 	actionsCmd.Flags().StringVarP(&until, "until", "u", "", "Analyze runs until date (YYYY-MM-DD)")
+	rootCmd.PersistentFlags().IntVar(&failureDetailLimit, "failure-detail-limit", 0, "Look up the failed job and step of only the N most recent CI failures (0 = all; one API call each)")
 }
 
 func runActionsAnalysis() {
@@ -37,41 +44,87 @@ func runActionsAnalysis() {
 	// Get repository
 	targetRepo, err := getActionsRepo()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error", err)
 	}
 	repo = targetRepo
 
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	if err := validatePush(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateChartSnippets(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateExport(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateP99Budgets(); err != nil {
+		exitWithError("Error", err)
+	}
+	if failureDetailLimit < 0 {
+		exitWithError("Error", fmt.Errorf("--failure-detail-limit must not be negative"))
+	}
+
 	// Set default date range if not provided (last 1 month)
 	if since == "" && until == "" {
 		now := time.Now()
 		since = now.AddDate(0, -1, 0).Format("2006-01-02")
 		until = now.Format("2006-01-02")
-		fmt.Printf(i18n.Sprintf("📅 Using default date range: %s to %s\n"), since, until)
+		fmt.Print(i18n.Sprintf("📅 Using default date range: %s to %s\n", since, until))
 	}
 
-	fmt.Printf(i18n.Sprintf("✅ Analyzing repository: %s\n"), repo)
-	fmt.Printf(i18n.Sprintf("📊 Period: %s to %s\n"), since, until)
+	fmt.Print(i18n.Sprintf("✅ Analyzing repository: %s\n", repo))
+	fmt.Print(i18n.Sprintf("📊 Period: %s to %s\n", since, until))
+
+	runActionsReport(p)
+}
 
+// runActionsReport fetches, analyzes and displays the workflow runs of the current repository and
+// period. It returns the runs within the period.
+func runActionsReport(p provider.CIProvider) []actions.WorkflowRun {
 	// Fetch workflow runs
 	fmt.Println(i18n.T("🔄 Fetching workflow runs..."))
-	runs, err := actions.FetchWorkflowRuns(repo, since, until)
+	stage := progress.Start("fetch_workflow_runs", 0)
+	runs, err := p.FetchWorkflowRuns(repo, since, until)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error fetching workflow runs: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error fetching workflow runs", err)
 	}
+	stage.Add(len(runs))
+	stage.End()
+	rememberRepo()
 
 	if len(runs) == 0 {
 		fmt.Println(i18n.T("⚠️  No workflow runs found in the specified period"))
-		return
+		return nil
 	}
 
 	// Analyze runs
 	fmt.Printf("🎯 Found %d workflow runs\n", len(runs))
+	runRunStream(actions.FilterRunsByDate(runs, since, until))
 	analytics := actions.AnalyzeWorkflowRuns(runs, since, until)
+	analytics.FailureDetails = p.FetchFailureDetails(repo, runs, analytics.FailureDetails, failureDetailLimit)
 
 	// Display results
 	displayActionsAnalytics(analytics)
+	runRedTimeReport(p, runs)
+	periodRuns := actions.FilterRunsByDate(runs, since, until)
+	runFailureRateAnomalies(periodRuns)
+	runCICharts(periodRuns)
+	runRunExport(periodRuns)
+	runFailureExport(analytics.FailureDetails)
+	details := newCIDetails(p, periodRuns)
+	displayCancellations(actions.AnalyzeCancellations(periodRuns))
+	runSlowStepsReport(details)
+	runLatencyReport(details)
+	runWorkflowAudit(details)
+	runArtifactsReport(p, periodRuns)
+	runActorReport(p, periodRuns)
+	displayDispatchStats(actions.CalculateDispatchStats(periodRuns))
+	runCIPush(analytics)
 
 	// Optional: Show failure details
 	if analytics.TotalFailures > 0 {
@@ -84,6 +137,7 @@ func runActionsAnalysis() {
 			displayFailureDetails(analytics.FailureDetails)
 		}
 	}
+	return actions.FilterRunsByDate(runs, since, until)
 }
 
 func getActionsRepo() (string, error) {
@@ -91,7 +145,7 @@ func getActionsRepo() (string, error) {
 		return repo, nil
 	}
 
-	detectedRepo, err := git.GetRepoFromGitRemote()
+	detectedRepo, err := detectRepoFromRemote()
 	if err == nil {
 		prompt := promptui.Select{
 			Label: fmt.Sprintf("Found repository '%s'. Analyze this?", detectedRepo),
@@ -109,13 +163,8 @@ func getActionsRepo() (string, error) {
 
 	// Manual entry
 	prompt := promptui.Prompt{
-		Label: "Enter GitHub repository (owner/repo format)",
-		Validate: func(input string) error {
-			if len(strings.Split(input, "/")) != 2 || strings.TrimSpace(input) == "" {
-				return fmt.Errorf("invalid format, please use 'owner/repo'")
-			}
-			return nil
-		},
+		Label:    "Enter GitHub repository (owner/repo format)",
+		Validate: validateRepoInput,
 	}
 	result, err := prompt.Run()
 	if err != nil {
@@ -148,12 +197,11 @@ func displayActionsAnalytics(analytics actions.WorkflowAnalytics) {
 	if len(analytics.WorkflowStats) > 0 {
 		fmt.Println("\n" + i18n.T("🔄 Workflow Breakdown:"))
 		workflowTable := tablewriter.NewWriter(os.Stdout)
-		workflowTable.SetHeader([]string{i18n.T("Workflow"), i18n.T("Runs"), i18n.T("Success"), i18n.T("Failed"), i18n.T("Success Rate"), i18n.T("Avg Duration")})
+		workflowTable.SetHeader([]string{i18n.T("Workflow"), i18n.T("Runs"), i18n.T("Success"), i18n.T("Failed"), i18n.T("Success Rate"), "P50", "P90", "P99"})
 		workflowTable.SetBorder(true)
 
 		for workflowName, stats := range analytics.WorkflowStats {
 			workflowSuccessRate := float64(stats.Successes) / float64(stats.TotalRuns) * 100
-			avgWorkflowDuration := time.Duration(stats.AverageDurationMs) * time.Millisecond
 
 			workflowTable.Append([]string{
 				workflowName,
@@ -161,10 +209,13 @@ func displayActionsAnalytics(analytics actions.WorkflowAnalytics) {
 				fmt.Sprintf("%d", stats.Successes),
 				fmt.Sprintf("%d", stats.Failures),
 				fmt.Sprintf("%.1f%%", workflowSuccessRate),
-				formatDuration(avgWorkflowDuration),
+				formatDuration(stats.P50Duration),
+				formatDuration(stats.P90Duration),
+				formatDuration(stats.P99Duration),
 			})
 		}
 		workflowTable.Render()
+		displayOverBudget(workflowsOverBudget(analytics.WorkflowStats))
 	}
 
 	// Event Trigger Analysis
@@ -186,28 +237,31 @@ func displayActionsAnalytics(analytics actions.WorkflowAnalytics) {
 	}
 }
 
+// displayFailureDetails lists the failures grouped by workflow, failed job and failed step, most
+// frequent first, so recurring problems stand out from one-off failures.
 func displayFailureDetails(failures []actions.FailureDetail) {
-	fmt.Println("\n" + i18n.T("❌ Failure Analysis:"))
-	fmt.Println("=" + strings.Repeat("=", 50))
+	groups := actions.GroupFailures(failures)
+	fmt.Println("\n" + i18n.T("❌ Recurring Failures:"))
 
-	for i, failure := range failures {
-		if i >= 10 { // Limit to first 10 failures
-			fmt.Printf(i18n.Sprintf("\n... and %d more failures\n", len(failures)-10))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Workflow"), i18n.T("Failed Job"), i18n.T("Failed Step"), i18n.T("Failures"), i18n.T("Last Seen"), "URL"})
+	table.SetBorder(true)
+	for i, g := range groups {
+		if i >= maxFailureGroups {
 			break
 		}
-
-		fmt.Printf("\n" + i18n.Sprintf("🔴 Failure #%d:", i+1) + "\n")
-		fmt.Printf(i18n.Sprintf("  Workflow: %s\n", failure.WorkflowName))
-		fmt.Printf(i18n.Sprintf("  Run: %s\n", failure.DisplayTitle))
-		fmt.Printf(i18n.Sprintf("  Date: %s\n", failure.CreatedAt.Format("2006-01-02 15:04")))
-		fmt.Printf(i18n.Sprintf("  Duration: %s\n", formatDuration(failure.Duration)))
-
-		if failure.FailedJob != "" {
-			fmt.Printf(i18n.Sprintf("  Failed Job: %s\n", failure.FailedJob))
+		job, step := g.FailedJob, g.FailedStep
+		if job == "" {
+			job = i18n.T("(unknown)")
 		}
-		if failure.FailedStep != "" {
-			fmt.Printf(i18n.Sprintf("  Failed Step: %s\n", failure.FailedStep))
+		if step == "" {
+			step = "-"
 		}
-		fmt.Printf(i18n.Sprintf("  URL: %s\n", failure.URL))
+		table.Append([]string{g.Workflow, job, step, fmt.Sprintf("%d", g.Count), g.LastSeen.Format("2006-01-02 15:04"), g.LastURL})
+	}
+	table.Render()
+	if len(groups) > maxFailureGroups {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(groups)-maxFailureGroups))
 	}
+	fmt.Println(i18n.T("💡 Failures of the same workflow, job and step are counted together; the URL is the most recent one. (unknown) jobs were not looked up or had no failed job."))
 }
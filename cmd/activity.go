@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/csv"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/json"
+	"visuche/internal/metadata"
+	"visuche/internal/stats"
+)
+
+var activityCalendar bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&activityCalendar, "activity-calendar", false, "Export a per-author daily activity calendar (PRs opened/merged, reviews given) as CSV, or JSON with --json")
+}
+
+// runActivityCalendarExport writes the activity calendar when --activity-calendar is set: as CSV unless
+// only --json is given. The period is --since/--until, defaulting to the first PR's creation through today.
+func runActivityCalendarExport(prs []github.PullRequest) {
+	if !activityCalendar || len(prs) == 0 {
+		return
+	}
+
+	now := time.Now()
+	from, to := prs[0].CreatedAt, now
+	for _, pr := range prs {
+		if pr.CreatedAt.Before(from) {
+			from = pr.CreatedAt
+		}
+	}
+	if t, err := time.Parse("2006-01-02", since); err == nil {
+		from = t
+	}
+	if t, err := time.Parse("2006-01-02", until); err == nil && t.Before(now) {
+		to = t
+	}
+	calendar := stats.CalculateActivityCalendar(prs, from, to)
+
+	base := fmt.Sprintf("visuche_%s_activity", strings.ReplaceAll(repo, "/", "-"))
+	if csvOutput || !jsonOutput {
+		filename := base + ".csv"
+		if err := csv.WriteActivityCalendarToCSV(filename, calendar, exportMetadata(metadata.ActivityCalendar)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing activity calendar: %v\n", err)
+		} else {
+			fmt.Printf(i18n.Sprintf("📅 Activity calendar: %s\n", filename))
+		}
+	}
+	if jsonOutput {
+		filename := base + ".json"
+		if err := json.WriteActivityCalendarToJSON(filename, calendar, exportMetadata(metadata.ActivityCalendar)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing activity calendar: %v\n", err)
+		} else {
+			fmt.Printf(i18n.Sprintf("📅 Activity calendar: %s\n", filename))
+		}
+	}
+}
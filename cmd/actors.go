@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var actorReport bool
+
+// maxActors is how many actors the CI time breakdown lists.
+const maxActors = 15
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&actorReport, "by-actor", false, "Break CI runs and time down by the user, bot or schedule that triggered them (one extra paginated API call)")
+}
+
+// runActorReport breaks the runs down by triggering actor (only with --by-actor).
+func runActorReport(p provider.CIProvider, runs []actions.WorkflowRun) {
+	if !actorReport {
+		return
+	}
+	fetcher, ok := p.(provider.RunActorFetcher)
+	if !ok {
+		fmt.Println("⚠️  --by-actor is not supported by this provider")
+		return
+	}
+
+	displayActorStats(actions.CalculateActorStats(fetcher.FetchRunActors(repo, runs)))
+}
+
+func displayActorStats(actors []actions.ActorStats) {
+	if len(actors) == 0 {
+		return
+	}
+	fmt.Println("\n" + i18n.T("👤 CI Time by Actor:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Actor"), i18n.T("Type"), i18n.T("Runs"), i18n.T("Failed"), i18n.T("CI Time"), i18n.T("Share"), i18n.T("Top Workflow")})
+	table.SetBorder(true)
+	for i, a := range actors {
+		if i >= maxActors {
+			break
+		}
+		actor := a.Actor
+		if actor == actions.ScheduleActor || actor == actions.UnknownActor {
+			actor = i18n.T(actor)
+		}
+		table.Append([]string{
+			actor,
+			i18n.T(a.Kind),
+			fmt.Sprintf("%d", a.Runs),
+			fmt.Sprintf("%d", a.Failures),
+			formatDuration(a.Time),
+			fmt.Sprintf("%.1f%%", a.Share),
+			a.TopWorkflow,
+		})
+	}
+	table.Render()
+	if len(actors) > maxActors {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(actors)-maxActors))
+	}
+	fmt.Println(i18n.T("💡 CI time is the run time of completed runs; scheduled runs are grouped under (schedule) whoever edited the cron."))
+}
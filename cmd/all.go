@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var allCmd = &cobra.Command{
+	Use:   "all",
+	Short: "Run the PR and GitHub Actions analyses together",
+	Long:  `Analyze pull requests and GitHub Actions runs for the same repository and period in one run, followed by cross metrics such as CI minutes per merged PR.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Both halves must cover the same period; default to the Actions default (last 1 month)
+		if since == "" && until == "" {
+			now := time.Now()
+			since = now.AddDate(0, -1, 0).Format("2006-01-02")
+			until = now.Format("2006-01-02")
+			fmt.Print(i18n.Sprintf("📅 Using default date range: %s to %s\n", since, until))
+		}
+		runCombinedAnalysis(allPRReports)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(allCmd)
+}
+
+// runCombinedAnalysis runs the PR analysis and the Actions report for the same repository and
+// period, then relates the two.
+func runCombinedAnalysis(sel reportSelection) {
+	analysis := runPRAnalysis(sel)
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	fmt.Println("\n" + i18n.T("🔧 GitHub Actions Analysis"))
+	fmt.Println("=" + strings.Repeat("=", 50))
+	runs := runActionsReport(p)
+
+	displayCrossMetrics(stats.CalculateCrossMetrics(analysis.Stats, runs))
+	runDXScore(analysis.Stats, runs)
+}
+
+// displayCrossMetrics displays CI usage relative to merged PRs.
+func displayCrossMetrics(m stats.CrossMetrics) {
+	fmt.Println("\n" + i18n.T("🔗 PR × CI Cross Metrics:"))
+	if m.MergedPRs == 0 {
+		fmt.Println(i18n.T("No merged PRs in this period"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Merged PRs"), fmt.Sprintf("%d", m.MergedPRs)})
+	table.Append([]string{i18n.T("CI Minutes (total)"), fmt.Sprintf("%.0f", m.CIMinutes)})
+	table.Append([]string{i18n.T("CI Minutes per Merged PR"), fmt.Sprintf("%.1f", m.CIMinutesPerMergedPR)})
+	table.Append([]string{i18n.T("CI Runs per Merged PR"), fmt.Sprintf("%.1f", m.CIRunsPerMergedPR)})
+	table.Append([]string{i18n.T("Failed Runs per Merged PR"), fmt.Sprintf("%.2f", m.FailedRunsPerMergedPR)})
+	table.Append([]string{i18n.T("PR-triggered Runs"), fmt.Sprintf("%d / %d", m.PRTriggeredRuns, m.CIRuns)})
+	table.Render()
+	fmt.Println(i18n.T("💡 CI minutes are wall-clock run durations, not billed runner minutes."))
+}
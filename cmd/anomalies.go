@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+)
+
+var anomalyMADs float64
+
+func init() {
+	rootCmd.PersistentFlags().Float64Var(&anomalyMADs, "anomaly-mads", 0, "Flag weeks whose lead time or CI failure rate is more than this many MADs from the rolling baseline (overrides config; default 3.5)")
+}
+
+// anomalySettings resolves the detection threshold and baseline window from flags and config.
+func anomalySettings() (threshold float64, window int) {
+	threshold, window = cfg.Anomalies.MADs, cfg.Anomalies.BaselineWeeks
+	if anomalyMADs > 0 {
+		threshold = anomalyMADs
+	}
+	if threshold <= 0 {
+		threshold = 3.5
+	}
+	if window <= 0 {
+		window = 8
+	}
+	return threshold, window
+}
+
+// runLeadTimeAnomalies lists weeks with an unusual median lead time. Nothing is shown when the period
+// has too few weeks for a baseline or no week stands out.
+func runLeadTimeAnomalies(prs []github.PullRequest) {
+	threshold, window := anomalySettings()
+	anomalies := stats.DetectLeadTimeAnomalies(prs, window, threshold)
+	if len(anomalies) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🚨 Unusual Weeks (median lead time):"))
+	for _, a := range anomalies {
+		fmt.Println(i18n.Sprintf("Week of %s: %s (baseline %s, %+.1f MADs)", a.Week.Format("2006-01-02"),
+			formatDuration(time.Duration(a.Value*float64(time.Hour))), formatDuration(time.Duration(a.Baseline*float64(time.Hour))), a.Deviation))
+		for _, pr := range a.PRs {
+			fmt.Printf("  #%d %s (%s)%s\n", pr.Number, pr.Title, formatDuration(pr.LeadTime), linkSuffix(pr.URL))
+		}
+	}
+	fmt.Println(i18n.Sprintf("Baseline: median of up to %d preceding weeks; flagged beyond %.1f median absolute deviations.", window, threshold))
+}
+
+// runFailureRateAnomalies lists weeks with an unusual CI failure rate and their failed runs.
+func runFailureRateAnomalies(runs []actions.WorkflowRun) {
+	threshold, window := anomalySettings()
+	anomalies := stats.DetectFailureRateAnomalies(runs, window, threshold)
+	if len(anomalies) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🚨 Unusual Weeks (CI failure rate):"))
+	for _, a := range anomalies {
+		fmt.Println(i18n.Sprintf("Week of %s: %.1f%% failed (baseline %.1f%%, %+.1f MADs)", a.Week.Format("2006-01-02"), a.Value, a.Baseline, a.Deviation))
+		for _, run := range a.Runs {
+			fmt.Printf("  %s #%d %s %s\n", run.WorkflowName, run.Number, run.Conclusion, run.URL)
+		}
+	}
+	fmt.Println(i18n.Sprintf("Baseline: median of up to %d preceding weeks; flagged beyond %.1f median absolute deviations.", window, threshold))
+}
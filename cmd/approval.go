@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var approvalByReviewers bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&approvalByReviewers, "approval-by-reviewers", false, "Report time to approval by number of requested reviewers (1 vs 2 vs 3+)")
+}
+
+// runApprovalByReviewersReport displays time to approval per requested-reviewer count when --approval-by-reviewers is set.
+func runApprovalByReviewersReport(prs []github.PullRequest) {
+	if !approvalByReviewers {
+		return
+	}
+	displayApprovalByReviewers(stats.CalculateApprovalByReviewerCount(prs))
+}
+
+// displayApprovalByReviewers shows one row per requested-reviewer bucket.
+func displayApprovalByReviewers(buckets []stats.ReviewerCountBucket) {
+	if len(buckets) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("👥 Time to Approval by Requested Reviewers (merged PRs):"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		i18n.T("Requested Reviewers"),
+		i18n.T("PRs"),
+		i18n.T("Approved"),
+		i18n.T("Time to Approval (avg/median)"),
+		i18n.T("Lead Time (avg)"),
+	})
+	table.SetBorder(true)
+	for _, b := range buckets {
+		table.Append([]string{
+			b.Reviewers,
+			fmt.Sprintf("%d", b.PRs),
+			fmt.Sprintf("%d", b.Approved),
+			fmt.Sprintf("%s / %s", formatDuration(b.AverageTimeToApproval), formatDuration(b.MedianTimeToApproval)),
+			formatDuration(b.AverageLeadTime),
+		})
+	}
+	table.Render()
+	fmt.Println(i18n.T("Requested reviewers include teams and requests that were later fulfilled or removed."))
+}
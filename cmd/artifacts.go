@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var artifactsReport bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&artifactsReport, "artifacts", false, "Report artifact storage per workflow and per week (one extra API call per run)")
+}
+
+// runArtifactsReport totals the artifact storage produced by the runs (only with --artifacts).
+func runArtifactsReport(p provider.CIProvider, runs []actions.WorkflowRun) {
+	if !artifactsReport {
+		return
+	}
+	fetcher, ok := p.(provider.RunArtifactsFetcher)
+	if !ok {
+		fmt.Println("⚠️  --artifacts is not supported by this provider")
+		return
+	}
+
+	displayArtifactUsage(actions.CalculateArtifactUsage(runs, fetcher.FetchRunArtifacts(repo, runs)))
+}
+
+func displayArtifactUsage(usage actions.ArtifactUsage) {
+	fmt.Println("\n" + i18n.T("📦 Artifact Storage:"))
+	if usage.Artifacts == 0 {
+		fmt.Println(i18n.T("No artifacts uploaded by these runs"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Workflow"), i18n.T("Runs"), i18n.T("Artifacts"), i18n.T("Total Size"), i18n.T("Per Run"), i18n.T("Largest Artifact")})
+	table.SetBorder(true)
+	var large []actions.WorkflowArtifactUsage
+	for _, w := range usage.Workflows {
+		name := w.Workflow
+		if w.Large {
+			name += " (!)"
+			large = append(large, w)
+		}
+		table.Append([]string{
+			name,
+			fmt.Sprintf("%d", w.Runs),
+			fmt.Sprintf("%d", w.Artifacts),
+			formatBytes(uint64(w.Total)),
+			formatBytes(uint64(w.PerRun)),
+			fmt.Sprintf("%s (%s)", w.Largest.Name, formatBytes(uint64(w.Largest.SizeInBytes))),
+		})
+	}
+	table.Render()
+	fmt.Printf(i18n.Sprintf("Total: %d artifacts, %s\n", usage.Artifacts, formatBytes(uint64(usage.Total))))
+
+	if len(usage.Weeks) > 1 {
+		weekTable := tablewriter.NewWriter(os.Stdout)
+		weekTable.SetHeader([]string{i18n.T("Week"), i18n.T("Artifacts"), i18n.T("Total Size")})
+		weekTable.SetBorder(true)
+		for _, wk := range usage.Weeks {
+			weekTable.Append([]string{
+				wk.Week.Format("2006-01-02"),
+				fmt.Sprintf("%d", wk.Artifacts),
+				formatBytes(uint64(wk.Total)),
+			})
+		}
+		weekTable.Render()
+	}
+
+	for _, w := range large {
+		fmt.Printf(i18n.Sprintf("⚠️  %s uploads %s per run, %.0fx the median run (%s); check what it uploads and its retention-days\n",
+			w.Workflow, formatBytes(uint64(w.PerRun)), float64(w.PerRun)/float64(usage.MedianPerRun), formatBytes(uint64(usage.MedianPerRun))))
+	}
+}
@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchIterations int
+	benchScale      int
+	benchProfileDir string
+)
+
+// defaultBenchFixtures is the fixture dataset bench runs against when --fixtures is not given.
+const defaultBenchFixtures = "testdata/fixtures"
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Benchmark the PR pipeline against a fixture dataset",
+	Long: `Run the fetch → enrichment → stats pipeline against the mock provider and report timings and
+allocations per stage. --scale replicates the fixture PRs to approximate a large organization, and
+--profile writes CPU and heap pprof files for "go tool pprof".`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runBench()
+	},
+}
+
+func init() {
+	benchCmd.Flags().IntVar(&benchIterations, "iterations", 5, "Number of times to run the pipeline")
+	benchCmd.Flags().IntVar(&benchScale, "scale", 1, "Replicate the fixture PRs this many times")
+	benchCmd.Flags().StringVar(&benchProfileDir, "profile", "", "Directory to write cpu.pprof and heap.pprof to")
+	rootCmd.AddCommand(benchCmd)
+}
+
+// benchStage accumulates the measurements of one pipeline stage across iterations.
+type benchStage struct {
+	Name    string
+	Min     time.Duration
+	Max     time.Duration
+	Total   time.Duration
+	Allocs  uint64 // Bytes allocated, summed over iterations
+	Samples int
+}
+
+func (s *benchStage) record(elapsed time.Duration, allocated uint64) {
+	if s.Samples == 0 || elapsed < s.Min {
+		s.Min = elapsed
+	}
+	if elapsed > s.Max {
+		s.Max = elapsed
+	}
+	s.Total += elapsed
+	s.Allocs += allocated
+	s.Samples++
+}
+
+func runBench() {
+	if benchIterations < 1 {
+		exitWithError("Error", fmt.Errorf("--iterations must be at least 1"))
+	}
+	if benchScale < 1 {
+		exitWithError("Error", fmt.Errorf("--scale must be at least 1"))
+	}
+
+	source := fixturesDir
+	if source == "" {
+		source = defaultBenchFixtures
+	}
+	dir := source
+	if benchScale > 1 {
+		scaled, err := scaleFixtures(source, benchScale)
+		if err != nil {
+			exitWithError("Error", err)
+		}
+		defer os.RemoveAll(scaled)
+		dir = scaled
+	}
+	p := provider.NewMock(dir)
+
+	fmt.Println(i18n.T("⏱️  visuche bench"))
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	stopProfile := startCPUProfile()
+	stages := []*benchStage{{Name: "Fetch"}, {Name: "Enrichment"}, {Name: "Stats"}}
+	var prCount int
+	for i := 0; i < benchIterations; i++ {
+		var prs []github.PullRequest
+		measureStage(stages[0], func() {
+			var err error
+			prs, err = p.FetchPullRequests(repo, since, until, author, label, true)
+			if err != nil {
+				exitWithError("Error", err)
+			}
+			prs, _ = github.SplitBotPRs(prs)
+		})
+		measureStage(stages[1], func() {
+			prs = p.EnrichPullRequests(repo, prs)
+			prs = p.FetchLinkedIssues(repo, prs)
+			prs = p.FetchRequestedReviewers(repo, prs)
+		})
+		measureStage(stages[2], func() {
+			prs = CalculateLeadTimes(prs)
+			prs = stats.CalculateCycleStages(prs)
+			stats.CalculateStats(prs, "main", time.Now())
+		})
+		prCount = len(prs)
+	}
+	stopProfile()
+	writeHeapProfile()
+
+	fmt.Printf(i18n.Sprintf("%d PRs from %s (scale %d), %d iterations\n", prCount, source, benchScale, benchIterations))
+	displayBenchStages(stages)
+}
+
+// measureStage runs fn and records its wall-clock time and allocated bytes on stage.
+func measureStage(stage *benchStage, fn func()) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	fn()
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	stage.record(elapsed, after.TotalAlloc-before.TotalAlloc)
+}
+
+func displayBenchStages(stages []*benchStage) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Stage"), i18n.T("Min"), i18n.T("Avg"), i18n.T("Max"), i18n.T("Alloc/iter")})
+	table.SetBorder(true)
+	var total time.Duration
+	for _, s := range stages {
+		total += s.Total
+		table.Append([]string{
+			i18n.T(s.Name),
+			formatBenchDuration(s.Min),
+			formatBenchDuration(s.Total / time.Duration(s.Samples)),
+			formatBenchDuration(s.Max),
+			formatBytes(s.Allocs / uint64(s.Samples)),
+		})
+	}
+	table.Render()
+	fmt.Printf(i18n.Sprintf("Total per iteration: %s\n", formatBenchDuration(total/time.Duration(benchIterations))))
+}
+
+// startCPUProfile starts CPU profiling into --profile and returns the function that stops it.
+// Profiling problems are reported but never fail the benchmark.
+func startCPUProfile() func() {
+	if benchProfileDir == "" {
+		return func() {}
+	}
+	if err := os.MkdirAll(benchProfileDir, 0o755); err != nil {
+		fmt.Printf("⚠️  Failed to create profile directory: %v\n", err)
+		return func() {}
+	}
+	path := filepath.Join(benchProfileDir, "cpu.pprof")
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to create CPU profile: %v\n", err)
+		return func() {}
+	}
+	if err := pprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		fmt.Printf("⚠️  Failed to start CPU profile: %v\n", err)
+		return func() {}
+	}
+	return func() {
+		pprof.StopCPUProfile()
+		f.Close()
+		fmt.Printf(i18n.Sprintf("📄 CPU profile written to %s\n", path))
+	}
+}
+
+// writeHeapProfile writes heap.pprof into --profile after a GC so it reflects live data.
+func writeHeapProfile() {
+	if benchProfileDir == "" {
+		return
+	}
+	path := filepath.Join(benchProfileDir, "heap.pprof")
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to create heap profile: %v\n", err)
+		return
+	}
+	defer f.Close()
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		fmt.Printf("⚠️  Failed to write heap profile: %v\n", err)
+		return
+	}
+	fmt.Printf(i18n.Sprintf("📄 Heap profile written to %s\n", path))
+}
+
+// scaleFixtures copies the fixtures in dir to a temporary directory with the PRs replicated
+// factor times. Copies get distinct numbers so per-PR lookups behave like a larger repository.
+func scaleFixtures(dir string, factor int) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, provider.PullRequestsFixture))
+	if err != nil {
+		return "", fmt.Errorf("failed to read fixture: %w", err)
+	}
+	var prs []map[string]interface{}
+	if err := json.Unmarshal(data, &prs); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", provider.PullRequestsFixture, err)
+	}
+
+	maxNumber := 0
+	for _, pr := range prs {
+		if n, ok := pr["number"].(float64); ok && int(n) > maxNumber {
+			maxNumber = int(n)
+		}
+	}
+	scaled := make([]map[string]interface{}, 0, len(prs)*factor)
+	for copyIndex := 0; copyIndex < factor; copyIndex++ {
+		for _, pr := range prs {
+			replica := make(map[string]interface{}, len(pr))
+			for k, v := range pr {
+				replica[k] = v
+			}
+			if n, ok := pr["number"].(float64); ok {
+				replica["number"] = int(n) + copyIndex*maxNumber
+			}
+			scaled = append(scaled, replica)
+		}
+	}
+
+	tmp, err := os.MkdirTemp("", "visuche-bench-")
+	if err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(scaled)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(tmp, provider.PullRequestsFixture), out, 0o644)
+	}
+	for _, name := range []string{provider.LinkedIssuesFixture, provider.ReviewRequestsFixture} {
+		if err != nil {
+			break
+		}
+		if extra, readErr := os.ReadFile(filepath.Join(dir, name)); readErr == nil {
+			err = os.WriteFile(filepath.Join(tmp, name), extra, 0o644)
+		}
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+		return "", fmt.Errorf("failed to write scaled fixtures: %w", err)
+	}
+	return tmp, nil
+}
+
+// formatBenchDuration rounds d to a readable precision for the timing table.
+func formatBenchDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
+
+// formatBytes formats a byte count with a binary unit.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
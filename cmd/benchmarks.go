@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"visuche/internal/i18n"
+	"visuche/internal/report"
+	"visuche/internal/stats"
+)
+
+// addBenchmarks shows which DORA band each derivable metric falls into.
+func addBenchmarks(r *report.Report, statistics stats.Stats) {
+	benchmarks := stats.CalculateBenchmarks(statistics)
+	if len(benchmarks) == 0 {
+		return
+	}
+
+	table := r.AddSection(i18n.T("📐 DORA Benchmark (heuristic):"), i18n.T("Metric"), i18n.T("Value"), i18n.T("Band"), i18n.T("Elite"), i18n.T("Derived From"))
+	for _, b := range benchmarks {
+		elite := fmt.Sprintf("≤ %.0f %s", b.Elite, b.Unit)
+		if b.HigherIsBetter {
+			elite = fmt.Sprintf("≥ %.0f %s", b.Elite, b.Unit)
+		}
+		table.Append(
+			i18n.T(b.Metric),
+			fmt.Sprintf("%.1f %s", b.Value, b.Unit),
+			i18n.T(b.Band),
+			elite,
+			i18n.T(b.Proxy),
+		)
+	}
+	table.Note(i18n.T("💡 Bands follow the thresholds of the 2023 DORA State of DevOps report. visuche sees PRs, not deployments or incidents, so treat them as a rough orientation."))
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+)
+
+var p99Budget string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&p99Budget, "p99-budget", "", "Flag workflows whose p99 run duration exceeds this, e.g. 30m (overrides the config default)")
+}
+
+// p99Budgets resolves the default p99 duration budget and the per-workflow budgets from flags and
+// config. A zero default leaves workflows without their own budget unchecked.
+func p99Budgets() (def time.Duration, perWorkflow map[string]time.Duration, err error) {
+	value := cfg.Actions.P99Budget
+	if p99Budget != "" {
+		value = p99Budget
+	}
+	if value != "" {
+		if def, err = parseBudget(value); err != nil {
+			return 0, nil, err
+		}
+	}
+	perWorkflow = make(map[string]time.Duration, len(cfg.Actions.P99Budgets))
+	for workflow, v := range cfg.Actions.P99Budgets {
+		if perWorkflow[workflow], err = parseBudget(v); err != nil {
+			return 0, nil, err
+		}
+	}
+	return def, perWorkflow, nil
+}
+
+func parseBudget(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid p99 budget %q: use a duration like 30m", value)
+	}
+	return d, nil
+}
+
+// overBudget is a workflow whose p99 run duration exceeds its budget.
+type overBudget struct {
+	Workflow string
+	P99      time.Duration
+	Budget   time.Duration
+}
+
+// workflowsOverBudget returns the workflows whose p99 duration exceeds their budget, furthest over first.
+func workflowsOverBudget(workflowStats map[string]actions.WorkflowStats) []overBudget {
+	def, perWorkflow, err := p99Budgets()
+	if err != nil {
+		return nil // Reported by validateP99Budgets before anything is fetched
+	}
+	var over []overBudget
+	for workflow, s := range workflowStats {
+		budget, ok := perWorkflow[workflow]
+		if !ok {
+			budget = def
+		}
+		if budget > 0 && s.P99Duration > budget {
+			over = append(over, overBudget{Workflow: workflow, P99: s.P99Duration, Budget: budget})
+		}
+	}
+	sort.Slice(over, func(i, j int) bool {
+		return float64(over[i].P99)/float64(over[i].Budget) > float64(over[j].P99)/float64(over[j].Budget)
+	})
+	return over
+}
+
+// validateP99Budgets checks --p99-budget and the configured budgets before any data is fetched.
+func validateP99Budgets() error {
+	_, _, err := p99Budgets()
+	return err
+}
+
+// displayOverBudget lists the workflows whose p99 duration exceeds their budget.
+func displayOverBudget(over []overBudget) {
+	if len(over) == 0 {
+		return
+	}
+	fmt.Println("\n" + i18n.T("⏱️  Workflows over their p99 budget:"))
+	for _, o := range over {
+		fmt.Println(i18n.Sprintf("  ⚠️  %s: p99 %s exceeds the budget of %s", o.Workflow, formatDuration(o.P99), formatDuration(o.Budget)))
+	}
+}
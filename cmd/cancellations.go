@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// maxLateCancellations is how many runs cancelled late are listed.
+const maxLateCancellations = 5
+
+// displayCancellations reports superseded vs manual cancellations and the CI time they saved or
+// wasted. Nothing is shown when no run was cancelled.
+func displayCancellations(analysis actions.CancellationAnalysis) {
+	if len(analysis.Runs) == 0 {
+		return
+	}
+	fmt.Println("\n" + i18n.T("🛑 Cancelled Runs:"))
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Workflow"), i18n.T("Superseded"), i18n.T("Manual"), i18n.T("Cancelled Late"), i18n.T("Minutes Saved"), i18n.T("Minutes Wasted")})
+	table.SetBorder(true)
+	for _, w := range append(analysis.Workflows, analysis.Total) {
+		name := w.Workflow
+		if name == "" {
+			name = i18n.T("Total")
+		}
+		table.Append([]string{
+			name,
+			fmt.Sprintf("%d", w.Superseded),
+			fmt.Sprintf("%d", w.Manual),
+			fmt.Sprintf("%d", w.Late),
+			fmt.Sprintf("%.1f", w.Saved.Minutes()),
+			fmt.Sprintf("%.1f", w.Wasted.Minutes()),
+		})
+	}
+	table.Render()
+
+	if analysis.Total.Late > 0 {
+		fmt.Println(i18n.T("Cancelled late:"))
+		lateTable := tablewriter.NewWriter(os.Stdout)
+		lateTable.SetHeader([]string{i18n.T("Workflow"), i18n.T("Branch"), i18n.T("Reason"), i18n.T("Ran For"), i18n.T("Typical"), "URL"})
+		lateTable.SetBorder(true)
+		for i, c := range analysis.Runs {
+			if i >= maxLateCancellations || !c.Late {
+				break
+			}
+			reason := i18n.T("manual")
+			if c.Superseded {
+				reason = i18n.T("superseded")
+			}
+			lateTable.Append([]string{
+				c.Workflow,
+				c.Run.HeadBranch,
+				reason,
+				formatDuration(c.Elapsed),
+				formatDuration(c.Typical),
+				c.Run.URL,
+			})
+		}
+		lateTable.Render()
+	}
+	fmt.Println(i18n.T("💡 A run counts as superseded when a newer run of the same workflow, branch and event started before it was cancelled; late means it had used at least half of the workflow's median duration."))
+}
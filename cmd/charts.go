@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"visuche/internal/actions"
+	"visuche/internal/chart"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+)
+
+var (
+	chartOut      string
+	chartSnippets string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&chartOut, "chart-out", "", "Render key charts (lead time trend, PR size distribution, CI success trend) as PNG and SVG files into this directory")
+	rootCmd.PersistentFlags().StringVar(&chartSnippets, "chart-snippets", "", "Print the key charts as diagram definitions for wikis (mermaid: xychart/pie, plantuml: timing/salt)")
+}
+
+// validateChartSnippets checks the --chart-snippets value.
+func validateChartSnippets() error {
+	if chartSnippets == "" {
+		return nil
+	}
+	_, err := chart.Chart{}.Snippet(chartSnippets)
+	return err
+}
+
+// sizeBuckets are the SizeLabel buckets in chart order.
+var sizeBuckets = []string{"XS", "S", "M", "L", "XL"}
+
+// namedChart is a chart and the base name of its files.
+type namedChart struct {
+	name  string
+	chart chart.Chart
+}
+
+// runPRCharts renders the lead time trend and the size distribution of merged PRs when --chart-out or
+// --chart-snippets is set.
+func runPRCharts(prs []github.PullRequest) {
+	if chartOut == "" && chartSnippets == "" {
+		return
+	}
+
+	var leadTime []chart.Point
+	for _, p := range stats.WeeklyPRSeries(prs)[stats.MetricLeadTimeMedianHours] {
+		leadTime = append(leadTime, chart.Point{Label: p.Time.Format("01-02"), Value: p.Value})
+	}
+
+	counts := map[string]int{}
+	for _, pr := range prs {
+		if pr.Merged {
+			counts[stats.SizeLabel(pr.Additions+pr.Deletions)]++
+		}
+	}
+	var sizes []chart.Point
+	for _, bucket := range sizeBuckets {
+		sizes = append(sizes, chart.Point{Label: bucket, Value: float64(counts[bucket])})
+	}
+
+	emitCharts([]namedChart{
+		{"lead_time_trend", chart.Chart{Title: "Median Lead Time by Week", YLabel: "hours", Kind: chart.Line, Points: leadTime}},
+		{"size_distribution", chart.Chart{Title: "Merged PRs by Size", YLabel: "PRs", Kind: chart.Bar, Points: sizes}},
+	})
+}
+
+// runCICharts renders the CI success trend when --chart-out or --chart-snippets is set.
+func runCICharts(runs []actions.WorkflowRun) {
+	if chartOut == "" && chartSnippets == "" {
+		return
+	}
+
+	var successRate []chart.Point
+	for _, p := range stats.WeeklyCISeries(runs)[stats.MetricCISuccessRate] {
+		successRate = append(successRate, chart.Point{Label: p.Time.Format("01-02"), Value: p.Value})
+	}
+
+	emitCharts([]namedChart{
+		{"ci_success_trend", chart.Chart{Title: "CI Success Rate by Week", YLabel: "%", Kind: chart.Line, Points: successRate}},
+	})
+}
+
+// emitCharts writes the charts as images (--chart-out) and prints them as snippets (--chart-snippets).
+func emitCharts(charts []namedChart) {
+	if chartOut != "" {
+		writeCharts(charts)
+	}
+	if chartSnippets != "" {
+		printChartSnippets(charts)
+	}
+}
+
+// writeCharts writes each chart as <name>.svg and <name>.png under --chart-out. Failures are reported
+// without stopping the analysis.
+func writeCharts(charts []namedChart) {
+	if err := os.MkdirAll(chartOut, 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.Sprintf("⚠️  Failed to create chart directory: %v", err))
+		return
+	}
+	for _, c := range charts {
+		paths, err := c.chart.WriteFiles(filepath.Join(chartOut, c.name))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.Sprintf("⚠️  Failed to write chart %s: %v", c.name, err))
+			continue
+		}
+		for _, path := range paths {
+			fmt.Println(i18n.Sprintf("📁 Chart output: %s", path))
+		}
+	}
+}
+
+// printChartSnippets prints each chart as a fenced block in the --chart-snippets format, ready to paste
+// into a wiki page.
+func printChartSnippets(charts []namedChart) {
+	for _, c := range charts {
+		snippet, err := c.chart.Snippet(chartSnippets)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.Sprintf("⚠️  Failed to write chart %s: %v", c.name, err))
+			return
+		}
+		fmt.Println("\n" + i18n.Sprintf("📈 %s (%s):", c.chart.Title, chartSnippets))
+		fmt.Println("```" + chartSnippets)
+		fmt.Print(snippet)
+		fmt.Println("```")
+	}
+}
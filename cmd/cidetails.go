@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"visuche/internal/actions"
+	"visuche/internal/provider"
+)
+
+// ciDetails lazily fetches the per-run details several actions reports need, so each is fetched at
+// most once per report.
+type ciDetails struct {
+	p    provider.CIProvider
+	runs []actions.WorkflowRun // Runs within the period
+
+	jobs        map[int64][]actions.WorkflowJob
+	jobsFetched bool
+
+	files        []actions.WorkflowFile
+	filesErr     error
+	filesFetched bool
+}
+
+func newCIDetails(p provider.CIProvider, runs []actions.WorkflowRun) *ciDetails {
+	return &ciDetails{p: p, runs: runs}
+}
+
+// Jobs returns the jobs of the runs keyed by run ID. ok is false when the provider cannot list jobs.
+func (d *ciDetails) Jobs() (jobs map[int64][]actions.WorkflowJob, ok bool) {
+	fetcher, ok := d.p.(provider.RunJobsFetcher)
+	if !ok {
+		return nil, false
+	}
+	if !d.jobsFetched {
+		d.jobs = fetcher.FetchRunJobs(repo, d.runs)
+		d.jobsFetched = true
+	}
+	return d.jobs, true
+}
+
+// WorkflowFiles returns the repository's workflow files. ok is false when the provider cannot read them.
+func (d *ciDetails) WorkflowFiles() (files []actions.WorkflowFile, ok bool, err error) {
+	fetcher, ok := d.p.(provider.WorkflowFileFetcher)
+	if !ok {
+		return nil, false, nil
+	}
+	if !d.filesFetched {
+		d.files, d.filesErr = fetcher.FetchWorkflowFiles(repo)
+		d.filesFetched = true
+	}
+	return d.files, true, d.filesErr
+}
@@ -0,0 +1,297 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/provider"
+	"visuche/internal/store"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	storeDir        string
+	collectAddr     string
+	collectSecret   string
+	collectInsecure bool
+)
+
+// maxWebhookBody bounds webhook payloads; GitHub caps them at 25 MB.
+const maxWebhookBody = 25 << 20
+
+// dispatchMatchWindow is how long the inputs of a workflow_dispatch event wait for the workflow_run
+// event of the run it started.
+const dispatchMatchWindow = 10 * time.Minute
+
+var collectCmd = &cobra.Command{
+	Use:   "collect [owner/repo...]",
+	Short: "Receive GitHub webhooks and keep the local store up to date",
+	Long: `Listen for GitHub webhooks at /webhook and update the local store incrementally:
+
+  pull_request, pull_request_review  the PR is re-fetched (one API call) and replaced in the store
+  workflow_run                       the run in the payload is stored as-is (no API call)
+  workflow_dispatch                  the inputs are attached to the run the dispatch starts
+
+Repositories given as arguments are backfilled from --since/--until first. Analyze the store with
+--provider store, which never calls the API:
+
+  visuche --provider store --repo owner/repo --since 2024-01-01
+
+Set the webhook's content type to application/json and its secret to --secret (or VISUCHE_WEBHOOK_SECRET).
+Without a secret anyone who can reach --addr could write to the store, so collect refuses to start
+unless --insecure is given.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runCollect(args)
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&storeDir, "store-dir", "", "Directory of the local store filled by collect (default: ~/.local/share/visuche/store)")
+	collectCmd.Flags().StringVar(&collectAddr, "addr", "127.0.0.1:8090", "Address to listen on")
+	collectCmd.Flags().StringVar(&collectSecret, "secret", "", "Webhook secret used to verify payloads; VISUCHE_WEBHOOK_SECRET takes precedence")
+	collectCmd.Flags().BoolVar(&collectInsecure, "insecure", false, "Accept payloads without verifying their signature when no secret is configured")
+	rootCmd.AddCommand(collectCmd)
+}
+
+// localStore returns the store selected by --store-dir.
+func localStore() *store.Store {
+	dir := storeDir
+	if dir == "" {
+		dir = store.DefaultDir()
+	}
+	return store.New(dir)
+}
+
+// collector applies webhook events to the store one at a time, in the order they arrive.
+type collector struct {
+	provider provider.Provider
+	store    *store.Store
+	secret   string
+	events   chan collectorEvent
+
+	// dispatches holds the inputs of workflow_dispatch events whose run hasn't been seen yet, oldest
+	// first, by repository and workflow path. Only run touches it.
+	dispatches map[string][]pendingDispatch
+}
+
+// pendingDispatch is the inputs of a workflow_dispatch event waiting for its run.
+type pendingDispatch struct {
+	inputs   map[string]string
+	received time.Time
+}
+
+type collectorEvent struct {
+	name  string
+	event github.WebhookEvent
+}
+
+func runCollect(repos []string) {
+	if providerName == "store" {
+		exitWithError("Error", fmt.Errorf("collect needs a live provider to fetch PRs; use --provider github"))
+	}
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	c := &collector{
+		provider:   p,
+		store:      localStore(),
+		secret:     collectSecret,
+		events:     make(chan collectorEvent, 256),
+		dispatches: make(map[string][]pendingDispatch),
+	}
+	if env := os.Getenv("VISUCHE_WEBHOOK_SECRET"); env != "" {
+		c.secret = env
+	}
+	if c.secret == "" {
+		if !collectInsecure {
+			exitWithError("Error", fmt.Errorf("no webhook secret configured; set --secret or VISUCHE_WEBHOOK_SECRET, or pass --insecure to accept unsigned payloads"))
+		}
+		fmt.Println("⚠️  No webhook secret configured; payloads are accepted without verifying their signature")
+	}
+
+	for _, r := range repos {
+		if err := validateRepoInput(r); err != nil {
+			exitWithError("Error", fmt.Errorf("%s: %w", r, err))
+		}
+		if err := c.backfill(r); err != nil {
+			exitWithError("Error backfilling "+r, err)
+		}
+	}
+
+	go c.run()
+	if cfg.Retention.RawMonths > 0 {
+		go c.pruneDaily(cfg.Retention.RawMonths)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhook", c.handleWebhook)
+	fmt.Printf("📡 Collecting webhooks on http://%s/webhook (store: %s)\n", collectAddr, c.store.Dir)
+	if err := http.ListenAndServe(collectAddr, mux); err != nil {
+		exitWithError("Error", err)
+	}
+}
+
+// pruneDaily applies the raw data retention now and then once a day, so the store doesn't grow unbounded.
+func (c *collector) pruneDaily(months int) {
+	for {
+		prs, runs, err := pruneStore(c.store, time.Now().AddDate(0, -months, 0))
+		if err != nil {
+			log.Printf("pruning the store failed: %v", err)
+		} else if prs+runs > 0 {
+			log.Printf("pruned %d PRs and %d workflow runs older than %d months", prs, runs, months)
+		}
+		time.Sleep(24 * time.Hour)
+	}
+}
+
+// backfill stores the PRs and runs of repo in the --since/--until period.
+func (c *collector) backfill(r string) error {
+	fmt.Printf("📥 Backfilling %s...\n", r)
+	prs, err := c.provider.FetchPullRequests(r, since, until, "", "", true)
+	if err != nil {
+		return err
+	}
+	if err := c.store.PutPullRequests(r, c.provider.EnrichPullRequests(r, prs)...); err != nil {
+		return err
+	}
+	runs, err := c.provider.FetchWorkflowRuns(r, since, until)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch workflow runs of %s: %v\n", r, err)
+		runs = nil
+	}
+	if err := c.store.PutWorkflowRuns(r, actions.FilterRunsByDate(runs, since, until)...); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Stored %d PRs and %d workflow runs of %s\n", len(prs), len(runs), r)
+	return nil
+}
+
+// handleWebhook verifies and queues a delivery. GitHub expects an answer within 10 seconds, so the
+// event is applied in the background.
+func (c *collector) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if c.secret != "" {
+		if err := github.VerifyWebhookSignature(c.secret, body, r.Header.Get("X-Hub-Signature-256")); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	name := r.Header.Get("X-GitHub-Event")
+	switch name {
+	case "ping":
+		w.WriteHeader(http.StatusOK)
+		return
+	case "pull_request", "pull_request_review", "workflow_run", "workflow_dispatch":
+	default:
+		// Other events are acknowledged so GitHub doesn't report failed deliveries
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	event, err := github.ParseWebhookEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	select {
+	case c.events <- collectorEvent{name: name, event: event}:
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		http.Error(w, "event queue is full", http.StatusServiceUnavailable)
+	}
+}
+
+// run applies queued events to the store.
+func (c *collector) run() {
+	for e := range c.events {
+		if err := c.apply(e); err != nil {
+			log.Printf("%s event for %s failed: %v", e.name, e.event.Repository.FullName, err)
+		}
+	}
+}
+
+func (c *collector) apply(e collectorEvent) error {
+	r := e.event.Repository.FullName
+	switch e.name {
+	case "workflow_run":
+		run, err := actions.ParseRESTWorkflowRun(e.event.WorkflowRun)
+		if err != nil {
+			return err
+		}
+		// Only the first attempt's requested event claims inputs; later events and re-runs keep the
+		// stored ones
+		if run.Event == "workflow_dispatch" && e.event.Action == "requested" && run.Attempt <= 1 {
+			run.Inputs = c.claimDispatch(r, e.event.WorkflowPath())
+		}
+		if err := c.store.PutWorkflowRuns(r, run); err != nil {
+			return err
+		}
+		log.Printf("stored workflow run %d of %s (%s)", run.DatabaseId, r, run.Status)
+	case "workflow_dispatch":
+		key := dispatchKey(r, e.event.WorkflowPath())
+		c.dispatches[key] = append(c.pruneDispatches(key), pendingDispatch{inputs: e.event.InputValues(), received: time.Now()})
+		log.Printf("recorded workflow_dispatch inputs of %s for %s", e.event.WorkflowPath(), r)
+	default:
+		fetcher, ok := c.provider.(provider.PRDetailFetcher)
+		if !ok {
+			return fmt.Errorf("the %s provider cannot fetch single PRs", c.provider.Name())
+		}
+		pr, err := fetcher.FetchPullRequest(r, e.event.PullRequest.Number)
+		if err != nil {
+			return err
+		}
+		if err := c.store.PutPullRequests(r, pr); err != nil {
+			return err
+		}
+		log.Printf("stored PR #%d of %s (%s)", pr.Number, r, e.event.Action)
+	}
+	return nil
+}
+
+// claimDispatch returns the inputs of the oldest pending workflow_dispatch event of the workflow at
+// path, or nil when there is none. GitHub doesn't link the two events, so runs dispatched at the same
+// moment may swap inputs.
+func (c *collector) claimDispatch(repo, path string) map[string]string {
+	key := dispatchKey(repo, path)
+	pending := c.pruneDispatches(key)
+	if len(pending) == 0 {
+		delete(c.dispatches, key)
+		return nil
+	}
+	c.dispatches[key] = pending[1:]
+	return pending[0].inputs
+}
+
+// pruneDispatches drops the pending dispatches of key older than dispatchMatchWindow and returns the rest.
+func (c *collector) pruneDispatches(key string) []pendingDispatch {
+	pending := c.dispatches[key]
+	cutoff := time.Now().Add(-dispatchMatchWindow)
+	for len(pending) > 0 && pending[0].received.Before(cutoff) {
+		pending = pending[1:]
+	}
+	return pending
+}
+
+// dispatchKey identifies a workflow across workflow_dispatch and workflow_run payloads; the latter
+// may suffix the path with "@ref".
+func dispatchKey(repo, path string) string {
+	if i := strings.Index(path, "@"); i >= 0 {
+		path = path[:i]
+	}
+	return repo + "\x00" + path
+}
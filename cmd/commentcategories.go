@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// commentCategoryReviewers is how many reviewers the per-reviewer comment mix lists.
+const commentCategoryReviewers = 10
+
+var commentCategories bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&commentCategories, "comment-categories", false, "Classify review comments as questions, nits, blocking requests or approval remarks per repo and reviewer (extra GraphQL queries)")
+}
+
+// needsReviewComments reports whether any requested report needs the text of review comments.
+func needsReviewComments() bool {
+	return commentCategories || suggestionReport
+}
+
+// runCommentCategoryReport displays the review comment mix when --comment-categories is set.
+func runCommentCategoryReport(prs []github.PullRequest) {
+	if !commentCategories {
+		return
+	}
+	displayCommentCategoryReport(stats.CalculateCommentCategories(prs))
+}
+
+// commentCategoryLabel returns the translated column header of a comment category.
+func commentCategoryLabel(category string) string {
+	switch category {
+	case stats.CommentBlocking:
+		return i18n.T("Blocking")
+	case stats.CommentQuestion:
+		return i18n.T("Question")
+	case stats.CommentNit:
+		return i18n.T("Nit")
+	case stats.CommentApproval:
+		return i18n.T("Approval")
+	default:
+		return i18n.T("Other")
+	}
+}
+
+// displayCommentCategoryReport shows the comment mix of the repository followed by the most active reviewers.
+func displayCommentCategoryReport(report stats.CommentCategoryReport) {
+	fmt.Println("\n" + i18n.T("💬 Review Comment Categories:"))
+	if report.Repo.Total == 0 {
+		fmt.Println(i18n.T("No review comments to classify"))
+		return
+	}
+
+	header := []string{i18n.T("Reviewer"), i18n.T("Comments")}
+	for _, category := range stats.CommentCategories {
+		header = append(header, commentCategoryLabel(category))
+	}
+	row := func(name string, mix stats.CommentMix) []string {
+		cells := []string{name, fmt.Sprintf("%d", mix.Total)}
+		for _, category := range stats.CommentCategories {
+			cells = append(cells, fmt.Sprintf("%d (%.0f%%)", mix.Counts[category], mix.Share(category)))
+		}
+		return cells
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetBorder(true)
+	table.Append(row(i18n.T("(all reviewers)"), report.Repo))
+	for i, r := range report.Reviewers {
+		if i == commentCategoryReviewers {
+			break
+		}
+		table.Append(row(r.Reviewer, r.CommentMix))
+	}
+	table.Render()
+
+	fmt.Println(i18n.Sprintf("Classified %d comments on %d PRs with keyword rules; replies by PR authors and bots are excluded.", report.Repo.Total, report.PRs))
+}
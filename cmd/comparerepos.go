@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// compareSignificance is the relative difference from which compare-repos highlights a metric.
+const compareSignificance = 0.25
+
+var compareReposCmd = &cobra.Command{
+	Use:   "compare-repos <owner/a> <owner/b>",
+	Short: "Compare the PR and CI metrics of two repositories side by side",
+	Long: `Run the same PR and GitHub Actions analysis on two repositories for the same period (--since/--until,
+default the last month through today) and show the metrics side by side, highlighting differences of 25% or more.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runCompareRepos(args[0], args[1])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(compareReposCmd)
+}
+
+// repoMetrics is what compare-repos collects for one repository.
+type repoMetrics struct {
+	Repo  string
+	Stats stats.Stats
+	CI    actions.WorkflowAnalytics
+	HasCI bool
+}
+
+// ciMetric is a CI metric of the comparison.
+type ciMetric struct {
+	label         string
+	value         func(actions.WorkflowAnalytics) float64
+	format        func(float64) string
+	lowerIsBetter bool
+}
+
+var compareCIMetrics = []ciMetric{
+	{label: "CI Success Rate", value: func(a actions.WorkflowAnalytics) float64 {
+		if a.TotalRuns == 0 {
+			return 0
+		}
+		return float64(a.TotalSuccesses) / float64(a.TotalRuns) * 100
+	}, format: formatPercent},
+	{label: "CI Average Duration", value: func(a actions.WorkflowAnalytics) float64 {
+		return float64(time.Duration(a.AverageDurationMs) * time.Millisecond)
+	}, format: formatDurationValue, lowerIsBetter: true},
+}
+
+func runCompareRepos(a, b string) {
+	for _, r := range []string{a, b} {
+		if err := validateRepoInput(r); err != nil {
+			exitWithError("Error", fmt.Errorf("%s: %w", r, err))
+		}
+	}
+	now := time.Now()
+	if since == "" {
+		since = now.AddDate(0, -1, 0).Format("2006-01-02")
+	}
+	if until == "" {
+		until = now.Format("2006-01-02")
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	fmt.Printf(i18n.Sprintf("📊 Period: %s to %s\n", since, until))
+	var results []repoMetrics
+	for _, r := range []string{a, b} {
+		fmt.Printf(i18n.Sprintf("📥 Analyzing %s...\n", r))
+		analysis, err := analyzePullRequests(p, r, since, until, author, label)
+		if err != nil {
+			exitWithError("Error fetching pull requests", err)
+		}
+		m := repoMetrics{Repo: r, Stats: analysis.Stats}
+
+		runs, err := p.FetchWorkflowRuns(r, since, until)
+		if err != nil {
+			fmt.Printf("⚠️  Failed to fetch workflow runs of %s: %v\n", r, err)
+		} else if len(runs) > 0 {
+			m.CI = actions.AnalyzeWorkflowRuns(runs, since, until)
+			m.HasCI = true
+		}
+		results = append(results, m)
+	}
+
+	displayRepoComparison(results[0], results[1])
+}
+
+// displayRepoComparison shows the metrics of a and b side by side. The difference is b relative to a.
+func displayRepoComparison(a, b repoMetrics) {
+	fmt.Println("\n" + i18n.T("⚖️  Repository Comparison:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), a.Repo, b.Repo, i18n.Sprintf("%s vs %s", b.Repo, a.Repo)})
+	table.SetBorder(true)
+
+	significant := 0
+	row := func(label string, va, vb float64, format func(float64) string, lowerIsBetter bool) {
+		diff := formatDelta(va, vb, lowerIsBetter)
+		if isSignificantDifference(va, vb) {
+			diff = "❗ " + diff
+			significant++
+		}
+		table.Append([]string{i18n.T(label), format(va), format(vb), diff})
+	}
+	for _, m := range historyMetrics {
+		row(m.label, m.value(a.Stats), m.value(b.Stats), m.format, m.lowerIsBetter)
+	}
+	if a.HasCI || b.HasCI {
+		for _, m := range compareCIMetrics {
+			row(m.label, m.value(a.CI), m.value(b.CI), m.format, m.lowerIsBetter)
+		}
+	}
+	table.Render()
+	fmt.Println(i18n.Sprintf("❗ marks differences of %.0f%% or more (%d metrics).", compareSignificance*100, significant))
+}
+
+// isSignificantDifference reports whether b differs from a by at least compareSignificance.
+func isSignificantDifference(a, b float64) bool {
+	if a == b {
+		return false
+	}
+	if a == 0 {
+		return true
+	}
+	return math.Abs(b-a)/math.Abs(a) >= compareSignificance
+}
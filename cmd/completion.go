@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/transport"
+
+	"github.com/spf13/cobra"
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish]",
+	Short: "Generate the shell completion script",
+	Long: `Generate the completion script for bash, zsh or fish. --repo completes from the git remotes
+of the current clone, repositories analyzed before (run history) and your recently pushed GitHub repositories.
+
+  bash:  source <(visuche completion bash)
+  zsh:   visuche completion zsh > "${fpath[1]}/_visuche"
+  fish:  visuche completion fish > ~/.config/fish/completions/visuche.fish`,
+	ValidArgs:             []string{"bash", "zsh", "fish"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	DisableFlagsInUseLine: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch args[0] {
+		case "zsh":
+			return rootCmd.GenZshCompletion(os.Stdout)
+		case "fish":
+			return rootCmd.GenFishCompletion(os.Stdout, true)
+		default:
+			return rootCmd.GenBashCompletionV2(os.Stdout, true)
+		}
+	},
+}
+
+func init() {
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	rootCmd.AddCommand(completionCmd)
+}
+
+// registerFlagCompletions attaches dynamic value completion to the persistent flags. It runs from
+// Execute because the flags are defined by init functions of files that sort after this one.
+func registerFlagCompletions() {
+	_ = rootCmd.RegisterFlagCompletionFunc("repo", completeRepo)
+	_ = rootCmd.RegisterFlagCompletionFunc("provider", cobra.FixedCompletions([]string{"github", "gitlab", "mock", "store"}, cobra.ShellCompDirectiveNoFileComp))
+	_ = rootCmd.RegisterFlagCompletionFunc("lang", cobra.FixedCompletions([]string{"en", "jp"}, cobra.ShellCompDirectiveNoFileComp))
+	_ = rootCmd.RegisterFlagCompletionFunc("group-by", cobra.FixedCompletions([]string{"team", "author", "label"}, cobra.ShellCompDirectiveNoFileComp))
+	_ = rootCmd.RegisterFlagCompletionFunc("push", cobra.FixedCompletions([]string{"otlp", "datadog", "bigquery:", "postgres:"}, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// recentReposTimeout bounds the gh call so completion stays responsive when offline.
+const recentReposTimeout = 3 * time.Second
+
+// completeRepo suggests repositories for --repo: git remotes of the current clone first, then
+// recently analyzed repositories and the run history, then the user's recently pushed repositories.
+func completeRepo(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	seen := make(map[string]bool)
+	var suggestions []string
+	add := func(repo, source string) {
+		if repo == "" || seen[repo] || !strings.HasPrefix(repo, toComplete) {
+			return
+		}
+		seen[repo] = true
+		suggestions = append(suggestions, repo+"\t"+source)
+	}
+
+	if remotes, err := detectRemoteCandidates(); err == nil {
+		for _, remote := range remotes {
+			add(remote.Repo, "git remote "+remote.Name)
+		}
+	}
+	for _, recent := range recentRepos() {
+		add(recent.Repo, "recently analyzed")
+	}
+	if store, err := historyStore(); err == nil {
+		if repos, err := store.Repos(); err == nil {
+			for _, repo := range repos {
+				add(repo, "analyzed before")
+			}
+		}
+		store.Close()
+	}
+	if providerName == "" || providerName == "github" {
+		for _, repo := range recentGitHubRepos() {
+			add(repo, "recently pushed")
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// recentGitHubRepos returns the authenticated user's repositories by last push, or nil when gh is unavailable.
+func recentGitHubRepos() []string {
+	ctx, cancel := context.WithTimeout(context.Background(), recentReposTimeout)
+	defer cancel()
+
+	cmd := transport.CommandContext(ctx, "gh", "api", "user/repos?sort=pushed&per_page=50", "--jq", ".[].full_name")
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+	return strings.Fields(stdout.String())
+}
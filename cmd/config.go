@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/config"
+
+	"github.com/spf13/cobra"
+)
+
+var configPath string
+
+// cfg is the loaded user configuration; flags take precedence over it.
+var cfg = config.Default()
+
+func init() {
+	cobra.OnInitialize(loadConfig)
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Config file path (default: ~/.config/visuche/config.json)")
+}
+
+// loadConfig loads the config file, falling back to defaults with a warning on errors.
+func loadConfig() {
+	path := configPath
+	if path == "" {
+		path = config.DefaultPath()
+	} else if _, err := os.Stat(path); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Config file not found: %s\n", path)
+	}
+
+	loaded, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+	}
+	cfg = loaded
+}
+
+// recentReposPath returns where recently analyzed repositories are remembered (next to the config file).
+func recentReposPath() string {
+	path := configPath
+	if path == "" {
+		path = config.DefaultPath()
+	}
+	return config.RecentReposPath(path)
+}
+
+// recentRepos returns the recently analyzed repositories of the selected provider, most recent first.
+func recentRepos() []config.RecentRepo {
+	all, err := config.LoadRecentRepos(recentReposPath())
+	if err != nil {
+		return nil
+	}
+	var recent []config.RecentRepo
+	for _, r := range all {
+		if r.Provider == providerName {
+			recent = append(recent, r)
+		}
+	}
+	return recent
+}
+
+// rememberRepo records the analyzed repository for the interactive quick-pick. Fixture runs are not remembered.
+func rememberRepo() {
+	if providerName == "mock" {
+		return
+	}
+	if err := config.RememberRepo(recentReposPath(), providerName, repo, time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+	}
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/confluence"
+	"visuche/internal/stats"
+)
+
+// runConfluencePublish creates or updates the repository's Confluence page with the run summary,
+// so the latest metrics live at a stable URL. It does nothing unless confluence is configured.
+func runConfluencePublish(s stats.Stats) {
+	if !cfg.Confluence.Enabled() {
+		return
+	}
+
+	token := cfg.Confluence.Token
+	if env := os.Getenv("VISUCHE_CONFLUENCE_TOKEN"); env != "" {
+		token = env
+	}
+	client := confluence.NewClient(cfg.Confluence.BaseURL, cfg.Confluence.Email, token)
+
+	body := confluence.RenderSummary(buildRunSummary(s), time.Now())
+	pageURL, err := client.PublishPage(cfg.Confluence.Space, cfg.Confluence.PageTitle(repo), cfg.Confluence.ParentID, body)
+	if err != nil {
+		fmt.Printf("⚠️  Confluence publishing failed: %v\n", err)
+		return
+	}
+	fmt.Printf("📄 Confluence page: %s\n", pageURL)
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import "visuche/internal/csv"
+
+var (
+	csvDelimiter string
+	csvEncoding  string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&csvDelimiter, "csv-delimiter", "comma", "Delimiter of CSV exports and imports: comma, semicolon or tab")
+	rootCmd.PersistentFlags().StringVar(&csvEncoding, "csv-encoding", "utf-8", "Encoding of CSV exports and imports: utf-8, utf-8-bom (Excel) or shift_jis (Japanese Excel)")
+}
+
+// configureCSV applies --csv-delimiter and --csv-encoding to every CSV export and import.
+func configureCSV() error {
+	delimiter, err := csv.ParseDelimiter(csvDelimiter)
+	if err != nil {
+		return err
+	}
+	encoding, err := csv.ParseEncoding(csvEncoding)
+	if err != nil {
+		return err
+	}
+	csv.SetFormat(csv.Format{Delimiter: delimiter, Encoding: encoding})
+	return nil
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"visuche/internal/config"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+)
+
+var (
+	isoWeekPattern   = regexp.MustCompile(`^(\d{4})-w(\d{1,2})$`)
+	quarterPattern   = regexp.MustCompile(`^(\d{4})-q(\d)$`)
+	monthPattern     = regexp.MustCompile(`^(\d{4})-(\d{1,2})$`)
+	lastNSprintsExpr = regexp.MustCompile(`^last\s+(\d+)\s+sprints?$`)
+)
+
+// ParseDateRange resolves a period expression to an inclusive range of days. ok is false when
+// input is not a period expression (e.g. a single date), so callers can fall back to ParseFlexibleDate.
+//
+// Supported: "last quarter", "this quarter", "year to date"/"ytd", "last sprint", "this sprint",
+// "last N sprints", ISO weeks ("2024-W15"), quarters ("2024-Q1") and months ("2024-01").
+// Ranges that include the current period end at baseDate.
+func ParseDateRange(input string, baseDate time.Time, sprint config.SprintConfig) (start, end time.Time, ok bool, err error) {
+	input = strings.Join(strings.Fields(strings.ToLower(input)), " ")
+	today := time.Date(baseDate.Year(), baseDate.Month(), baseDate.Day(), 0, 0, 0, 0, baseDate.Location())
+	quarterStart := time.Date(today.Year(), time.Month((int(today.Month())-1)/3*3+1), 1, 0, 0, 0, 0, today.Location())
+
+	switch input {
+	case "last quarter":
+		return quarterStart.AddDate(0, -3, 0), quarterStart.AddDate(0, 0, -1), true, nil
+	case "this quarter":
+		return quarterStart, today, true, nil
+	case "year to date", "ytd":
+		return time.Date(today.Year(), 1, 1, 0, 0, 0, 0, today.Location()), today, true, nil
+	case "last sprint":
+		return lastSprints(1, today, sprint)
+	case "this sprint":
+		sprintStart, _, err := currentSprint(today, sprint)
+		return sprintStart, today, err == nil, err
+	}
+
+	if matches := lastNSprintsExpr.FindStringSubmatch(input); matches != nil {
+		n, _ := strconv.Atoi(matches[1])
+		if n < 1 {
+			return time.Time{}, time.Time{}, true, fmt.Errorf("number of sprints must be at least 1")
+		}
+		return lastSprints(n, today, sprint)
+	}
+
+	if matches := isoWeekPattern.FindStringSubmatch(input); matches != nil {
+		year, _ := strconv.Atoi(matches[1])
+		week, _ := strconv.Atoi(matches[2])
+		monday := isoWeekStart(year, week, today.Location())
+		if y, w := monday.ISOWeek(); week < 1 || y != year || w != week {
+			return time.Time{}, time.Time{}, true, fmt.Errorf("invalid ISO week: %s", input)
+		}
+		return monday, monday.AddDate(0, 0, 6), true, nil
+	}
+
+	if matches := quarterPattern.FindStringSubmatch(input); matches != nil {
+		year, _ := strconv.Atoi(matches[1])
+		quarter, _ := strconv.Atoi(matches[2])
+		if quarter < 1 || quarter > 4 {
+			return time.Time{}, time.Time{}, true, fmt.Errorf("invalid quarter: %d", quarter)
+		}
+		start := time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, today.Location())
+		return start, start.AddDate(0, 3, -1), true, nil
+	}
+
+	if matches := monthPattern.FindStringSubmatch(input); matches != nil {
+		year, _ := strconv.Atoi(matches[1])
+		month, _ := strconv.Atoi(matches[2])
+		if month < 1 || month > 12 {
+			return time.Time{}, time.Time{}, true, fmt.Errorf("invalid month: %d", month)
+		}
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, today.Location())
+		return start, start.AddDate(0, 1, -1), true, nil
+	}
+
+	return time.Time{}, time.Time{}, false, nil
+}
+
+// currentSprint returns the first day of the sprint containing today and the sprint length in days.
+func currentSprint(today time.Time, sprint config.SprintConfig) (time.Time, int, error) {
+	length := sprint.LengthDays
+	if length <= 0 {
+		return time.Time{}, 0, fmt.Errorf("sprint.lengthDays must be positive")
+	}
+	anchor, err := time.ParseInLocation("2006-01-02", sprint.Anchor, today.Location())
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid sprint.anchor %q (expected YYYY-MM-DD)", sprint.Anchor)
+	}
+
+	// Count whole days on the calendar so DST changes don't shift the boundary
+	days := int(time.Date(today.Year(), today.Month(), today.Day(), 12, 0, 0, 0, time.UTC).Sub(
+		time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 12, 0, 0, 0, time.UTC)).Hours() / 24)
+	offset := days % length
+	if offset < 0 {
+		offset += length
+	}
+	return today.AddDate(0, 0, -offset), length, nil
+}
+
+// lastSprints returns the n completed sprints before the current one.
+func lastSprints(n int, today time.Time, sprint config.SprintConfig) (time.Time, time.Time, bool, error) {
+	sprintStart, length, err := currentSprint(today, sprint)
+	if err != nil {
+		return time.Time{}, time.Time{}, true, err
+	}
+	return sprintStart.AddDate(0, 0, -n*length), sprintStart.AddDate(0, 0, -1), true, nil
+}
+
+// isoWeekStart returns the Monday of ISO week week of year.
+func isoWeekStart(year, week int, loc *time.Location) time.Time {
+	// January 4th is always in week 1
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	week1 := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return week1.AddDate(0, 0, (week-1)*7)
+}
+
+// resolveDateFlags normalizes --since/--until to YYYY-MM-DD. Both accept everything the interactive
+// custom range does (see ParseFlexibleDate); a period in --since also fills an empty --until with
+// its end, and a period in --until uses its last day.
+func resolveDateFlags() error {
+	now := time.Now()
+	start, end, ok, err := ParseDateRange(since, now, cfg.Sprint)
+	if err != nil {
+		return fmt.Errorf("--since: %w", err)
+	}
+	if ok {
+		since = start.Format("2006-01-02")
+		if until == "" {
+			until = end.Format("2006-01-02")
+		}
+	}
+
+	_, end, ok, err = ParseDateRange(until, now, cfg.Sprint)
+	if err != nil {
+		return fmt.Errorf("--until: %w", err)
+	}
+	if ok {
+		until = end.Format("2006-01-02")
+	}
+
+	if since, err = normalizeDateFlag("--since", since, now); err != nil {
+		return err
+	}
+	if until, err = normalizeDateFlag("--until", until, now); err != nil {
+		return err
+	}
+	if since != "" && until != "" && until < since {
+		return fmt.Errorf("--until %s is before --since %s", until, since)
+	}
+	return nil
+}
+
+// normalizeDateFlag parses a flexible date flag value into YYYY-MM-DD; empty stays empty.
+func normalizeDateFlag(flag, value string, now time.Time) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	date, err := ParseFlexibleDate(value, now)
+	if err != nil {
+		return "", fmt.Errorf("%s %q: %v (use YYYY-MM-DD, \"30 days ago\", \"2024-Q1\", \"last quarter\", ...)", flag, value, err)
+	}
+	return date.Format("2006-01-02"), nil
+}
+
+// warnLargeRange warns when the analyzed period is longer than maxRangeDays in the config. For
+// GitHub it counts the PRs in the period (one search call) to estimate how many API calls the run needs.
+func warnLargeRange() {
+	if since == "" || cfg.MaxRangeDays <= 0 {
+		return
+	}
+	start, err := time.Parse("2006-01-02", since)
+	if err != nil {
+		return
+	}
+	end := time.Now()
+	if until != "" {
+		if t, err := time.Parse("2006-01-02", until); err == nil {
+			end = t
+		}
+	}
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days <= cfg.MaxRangeDays {
+		return
+	}
+
+	fmt.Printf(i18n.Sprintf("⚠️  The period spans %d days (more than maxRangeDays %d).\n", days, cfg.MaxRangeDays))
+	if providerName != "github" {
+		return
+	}
+	count, err := github.CountPullRequests(repo, since, until)
+	if err != nil {
+		return
+	}
+	fmt.Printf(i18n.Sprintf("   %d PRs in the period, roughly %d API calls. Narrow --since/--until if you hit rate limits.\n", count, github.EstimateAPICalls(count)))
+}
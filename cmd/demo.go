@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/config"
+	"visuche/internal/demo"
+	"visuche/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	demoSeed int64
+	demoDays int
+	demoOut  string
+)
+
+var demoCmd = &cobra.Command{
+	Use:   "demo",
+	Short: "Run the full report against a generated sample dataset",
+	Long: `Generate a realistic synthetic dataset (PRs, reviews, CI runs) for a fictional repository and run
+the PR and GitHub Actions reports against it — no token or repository needed. The same --seed and
+--until always produce the same dataset, so screenshots and comparisons are reproducible.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runDemo()
+	},
+}
+
+func init() {
+	demoCmd.Flags().Int64Var(&demoSeed, "seed", 42, "Seed for the generated dataset")
+	demoCmd.Flags().IntVar(&demoDays, "days", 90, "Length of the generated period in days")
+	demoCmd.Flags().StringVar(&demoOut, "out", "", "Keep the generated fixtures in this directory (usable with --provider mock --fixtures)")
+	rootCmd.AddCommand(demoCmd)
+}
+
+func runDemo() {
+	end := time.Now()
+	if until != "" {
+		parsed, err := time.Parse("2006-01-02", until)
+		if err != nil {
+			exitWithError("Error", err)
+		}
+		end = parsed
+	}
+
+	dir := demoOut
+	if dir == "" {
+		tmp, err := os.MkdirTemp("", "visuche-demo-")
+		if err != nil {
+			exitWithError("Error", err)
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+	summary, err := demo.Generate(dir, demo.Options{Seed: demoSeed, End: end, Days: demoDays})
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	fmt.Println(i18n.T("🎬 visuche demo"))
+	fmt.Printf(i18n.Sprintf("Generated %d PRs and %d workflow runs for the fictional repository %s (seed %d)\n",
+		summary.PullRequests, summary.WorkflowRuns, demo.Repo, demoSeed))
+	if demoOut != "" {
+		fmt.Printf(i18n.Sprintf("📁 Fixtures written to %s\n", demoOut))
+	}
+
+	// The demo stands alone: ignore the user's config and history, show every optional report
+	providerName = "mock"
+	fixturesDir = dir
+	repo = demo.Repo
+	since = end.AddDate(0, 0, -(demoDays - 1)).Format("2006-01-02")
+	until = end.Format("2006-01-02")
+	noHistory = true
+	cfg = config.Default()
+	cfg.Teams = demo.Teams
+	cfg.SLA.FirstReview = "8h"
+	groupBy = "team"
+	wipReport = true
+	busFactorReport = true
+	reworkReport = true
+	sizeCorrelationReport = true
+	approvalByReviewers = true
+
+	runCombinedAnalysis(allPRReports)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayDependencyStats displays the Dependabot/Renovate report, separate from human PR metrics.
+func displayDependencyStats(deps stats.DependencyStats) {
+	if deps.Opened == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🤖 Dependency Update PRs:"))
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("Opened"), fmt.Sprintf("%d", deps.Opened)})
+	summaryTable.Append([]string{i18n.T("Merged"), fmt.Sprintf("%d", deps.Merged)})
+	summaryTable.Append([]string{i18n.T("Auto-merged"), fmt.Sprintf("%d", deps.AutoMerged)})
+	summaryTable.Append([]string{i18n.T("Closed without merge"), fmt.Sprintf("%d", deps.Closed)})
+	summaryTable.Append([]string{i18n.T("Still open"), fmt.Sprintf("%d", deps.Open)})
+	summaryTable.Append([]string{i18n.Sprintf("Stuck open (>%dd)", int(stats.StuckDependencyAge.Hours()/24)), fmt.Sprintf("%d", deps.StuckOpen)})
+	summaryTable.Append([]string{i18n.T("Time to Merge (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(deps.AverageTimeToMerge), formatDuration(deps.MedianTimeToMerge))})
+	summaryTable.Render()
+
+	if len(deps.Tools) > 1 {
+		toolTable := tablewriter.NewWriter(os.Stdout)
+		toolTable.SetHeader([]string{i18n.T("Tool"), i18n.T("Opened"), i18n.T("Merged"), i18n.T("Auto-merged"), i18n.T("Still open"), i18n.T("Stuck")})
+		toolTable.SetBorder(true)
+		for _, t := range deps.Tools {
+			toolTable.Append([]string{t.Tool, fmt.Sprintf("%d", t.Opened), fmt.Sprintf("%d", t.Merged), fmt.Sprintf("%d", t.AutoMerged), fmt.Sprintf("%d", t.Open), fmt.Sprintf("%d", t.StuckOpen)})
+		}
+		toolTable.Render()
+	}
+
+	if len(deps.StuckPRs) > 0 {
+		fmt.Println("\n" + i18n.T("⏳ Stuck dependency PRs:"))
+		now := time.Now()
+		for i, pr := range deps.StuckPRs {
+			if i >= 10 {
+				fmt.Print(i18n.Sprintf("... and %d more\n", len(deps.StuckPRs)-10))
+				break
+			}
+			fmt.Printf("  #%d %s (%s)%s\n", pr.Number, pr.Title, formatDuration(now.Sub(pr.CreatedAt)), linkSuffix(pr.URL))
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayDescriptionReport shows how PR description quality relates to review speed and discussion.
+func displayDescriptionReport(prs []github.PullRequest) {
+	report := stats.CalculateDescriptionReport(prs)
+	if report.AnalyzedPRs == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("📝 PR Description Quality:"))
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("Average Description Length"), i18n.Sprintf("%.0f chars", report.AverageLength)})
+	summaryTable.Append([]string{i18n.T("PRs with Linked Issue"), fmt.Sprintf("%.1f%%", report.LinkedIssueRate)})
+	summaryTable.Append([]string{i18n.T("PRs with Checklist"), fmt.Sprintf("%.1f%%", report.ChecklistRate)})
+	if report.ChecklistRate > 0 {
+		summaryTable.Append([]string{i18n.T("Checklist Items Checked"), fmt.Sprintf("%.1f%%", report.ChecklistCompletion)})
+	}
+	if report.HasApprovalSamples {
+		summaryTable.Append([]string{i18n.T("Length ↔ Time to Approval (r)"), fmt.Sprintf("%+.2f", report.LengthVsApproval)})
+	}
+	summaryTable.Append([]string{i18n.T("Length ↔ Comments (r)"), fmt.Sprintf("%+.2f", report.LengthVsComments)})
+	summaryTable.Render()
+
+	groupTable := tablewriter.NewWriter(os.Stdout)
+	groupTable.SetHeader([]string{i18n.T("Description"), i18n.T("PRs"), i18n.T("Median Time to Approval"), i18n.T("Comments (avg/median)")})
+	groupTable.SetBorder(true)
+	for _, groups := range [][]stats.DescriptionGroup{report.ByLength, report.ByLinkedIssue, report.ByChecklist} {
+		for _, g := range groups {
+			if g.PRs == 0 {
+				continue
+			}
+			groupTable.Append([]string{
+				descriptionGroupLabel(g.Label),
+				fmt.Sprintf("%d", g.PRs),
+				formatDuration(g.MedianTimeToApproval),
+				fmt.Sprintf("%.1f / %.1f", g.AverageComments, g.MedianComments),
+			})
+		}
+	}
+	groupTable.Render()
+	fmt.Println(i18n.Sprintf("💡 Length buckets: short < %d chars, long ≥ %d chars. r < 0 means longer descriptions go with faster reviews / fewer comments.", stats.ShortDescriptionLength, stats.LongDescriptionLength))
+}
+
+// descriptionGroupLabel translates a description group label.
+func descriptionGroupLabel(label string) string {
+	switch label {
+	case "Short", "Medium", "Long":
+		return i18n.T(label + " description")
+	}
+	return i18n.T(label)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// maxDispatchCombinations is how many input combinations the dispatch report lists.
+const maxDispatchCombinations = 20
+
+// displayDispatchStats lists the manual (workflow_dispatch) runs by workflow and input combination.
+func displayDispatchStats(stats []actions.DispatchStats) {
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Println("\n" + i18n.T("🎛️  Workflow Dispatch Inputs:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Workflow"), i18n.T("Inputs"), i18n.T("Runs"), i18n.T("Failed"), i18n.T("Success Rate"), i18n.T("Last Run")})
+	table.SetBorder(true)
+	known := false
+	for i, s := range stats {
+		if s.Inputs != "" {
+			known = true
+		}
+		if i >= maxDispatchCombinations {
+			continue
+		}
+		inputs := s.Inputs
+		if inputs == "" {
+			inputs = i18n.T("(unknown)")
+		}
+		table.Append([]string{
+			s.Workflow,
+			inputs,
+			fmt.Sprintf("%d", s.Runs),
+			fmt.Sprintf("%d", s.Failures),
+			fmt.Sprintf("%.1f%%", s.SuccessRate),
+			s.LastRun.Format("2006-01-02 15:04"),
+		})
+	}
+	table.Render()
+	if len(stats) > maxDispatchCombinations {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(stats)-maxDispatchCombinations))
+	}
+	if !known {
+		fmt.Println(i18n.T("💡 The API doesn't return dispatch inputs; run visuche collect with workflow_dispatch webhooks and analyze with --provider store to record them."))
+	}
+}
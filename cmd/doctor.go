@@ -0,0 +1,311 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"visuche/internal/diagnose"
+	"visuche/internal/i18n"
+	"visuche/internal/transport"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that gh/glab, authentication, the git remote and the API are ready",
+	Long:  `Run preflight checks — CLI installation, authentication, token scopes, git remote detection and API reachability — and print what to fix for each failed check.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runDoctor()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+// requiredScopes are the classic OAuth scopes visuche needs: repo for private repositories,
+// read:org for team review requests and organization membership.
+var requiredScopes = []string{"repo", "read:org"}
+
+// doctorCheck is the outcome of a single preflight check.
+type doctorCheck struct {
+	Name   string
+	Status string // "pass", "warn" or "fail"
+	Detail string
+	Hints  []string
+}
+
+func runDoctor() {
+	fmt.Println(i18n.T("🩺 visuche doctor"))
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	var checks []doctorCheck
+	if providerName == "mock" {
+		checks = []doctorCheck{checkFixtures()}
+	} else {
+		checks = runToolChecks()
+	}
+
+	failed := 0
+	for _, c := range checks {
+		icon := "✅"
+		switch c.Status {
+		case "warn":
+			icon = "⚠️ "
+		case "fail":
+			icon = "❌"
+			failed++
+		}
+		line := fmt.Sprintf("%s %s", icon, i18n.T(c.Name))
+		if c.Detail != "" {
+			line += ": " + c.Detail
+		}
+		fmt.Println(line)
+		for _, hint := range c.Hints {
+			fmt.Printf("     💡 %s\n", hint)
+		}
+	}
+
+	fmt.Println()
+	if failed > 0 {
+		fmt.Printf(i18n.Sprintf("%d of %d checks failed\n", failed, len(checks)))
+		os.Exit(1)
+	}
+	fmt.Println(i18n.T("All checks passed"))
+}
+
+// runToolChecks checks the gh (or glab) setup. Later checks are skipped once the CLI is missing
+// or unauthenticated, because they would only repeat the same failure.
+func runToolChecks() []doctorCheck {
+	tool := "gh"
+	if providerName == "gitlab" {
+		tool = "glab"
+	}
+
+	install := checkInstalled(tool)
+	checks := []doctorCheck{install}
+	if install.Status == "fail" {
+		return checks
+	}
+
+	auth := checkAuth(tool)
+	checks = append(checks, auth)
+	if auth.Status != "fail" && tool == "gh" {
+		checks = append(checks, checkScopes())
+	}
+
+	remote := checkRemote()
+	checks = append(checks, remote)
+	if auth.Status == "fail" {
+		return checks
+	}
+
+	checks = append(checks, checkAPI(tool))
+
+	target := repo
+	if target == "" && remote.Status == "pass" {
+		target = remote.Detail
+	}
+	if target != "" {
+		checks = append(checks, checkRepoAccess(tool, target))
+	}
+	return checks
+}
+
+func checkInstalled(tool string) doctorCheck {
+	c := doctorCheck{Name: "CLI installed"}
+	out, err := runDoctorCommand(tool, "--version")
+	if err != nil {
+		return failedCheck(c, err)
+	}
+	c.Status = "pass"
+	c.Detail = firstLine(out)
+	return c
+}
+
+func checkAuth(tool string) doctorCheck {
+	c := doctorCheck{Name: "Authentication"}
+	out, err := runDoctorCommand(tool, "auth", "status")
+	if err != nil {
+		c.Status = "fail"
+		c.Detail = "not logged in"
+		c.Hints = []string{
+			fmt.Sprintf("Run: %s auth login", tool),
+		}
+		return c
+	}
+	c.Status = "pass"
+	c.Detail = loggedInAccount(out)
+	return c
+}
+
+func checkScopes() doctorCheck {
+	c := doctorCheck{Name: "Token scopes"}
+	out, err := runDoctorCommand("gh", "api", "-i", "user")
+	if err != nil {
+		return failedCheck(c, err)
+	}
+
+	var header string
+	for _, line := range strings.Split(out, "\n") {
+		if name, value, ok := strings.Cut(line, ":"); ok && strings.EqualFold(strings.TrimSpace(name), "X-OAuth-Scopes") {
+			header = strings.TrimSpace(value)
+			break
+		}
+	}
+	if header == "" {
+		// Fine-grained tokens and GitHub App tokens have no classic scopes; access is per repository
+		c.Status = "pass"
+		c.Detail = "fine-grained token (permissions are checked per repository)"
+		return c
+	}
+
+	granted := make(map[string]bool)
+	for _, scope := range strings.Split(header, ",") {
+		granted[strings.TrimSpace(scope)] = true
+	}
+	var missing []string
+	for _, scope := range requiredScopes {
+		// write:org and admin:org include read:org
+		if granted[scope] || (scope == "read:org" && (granted["write:org"] || granted["admin:org"])) {
+			continue
+		}
+		missing = append(missing, scope)
+	}
+	c.Detail = header
+	if len(missing) == 0 {
+		c.Status = "pass"
+		return c
+	}
+	c.Status = "warn"
+	c.Detail = fmt.Sprintf("%s (missing: %s)", header, strings.Join(missing, ", "))
+	c.Hints = []string{"Add the scopes with: gh auth refresh -s " + strings.Join(missing, ",")}
+	return c
+}
+
+func checkRemote() doctorCheck {
+	c := doctorCheck{Name: "Git remote"}
+	detected, err := detectRepoFromRemote()
+	if err != nil {
+		c.Status = "warn"
+		c.Detail = err.Error()
+		c.Hints = []string{"Run visuche inside a clone of the repository, or pass --repo owner/repo"}
+		return c
+	}
+	c.Status = "pass"
+	c.Detail = detected
+	return c
+}
+
+func checkAPI(tool string) doctorCheck {
+	c := doctorCheck{Name: "API reachability"}
+	if tool == "glab" {
+		if _, err := runDoctorCommand("glab", "api", "user"); err != nil {
+			return failedCheck(c, err)
+		}
+		c.Status = "pass"
+		return c
+	}
+
+	out, err := runDoctorCommand("gh", "api", "rate_limit", "--jq", `"\(.resources.core.remaining) \(.resources.core.limit) \(.resources.graphql.remaining) \(.resources.graphql.limit)"`)
+	if err != nil {
+		return failedCheck(c, err)
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 4 {
+		c.Status = "pass"
+		return c
+	}
+	c.Status = "pass"
+	c.Detail = fmt.Sprintf("REST %s/%s, GraphQL %s/%s requests left", fields[0], fields[1], fields[2], fields[3])
+	for _, i := range []int{0, 2} {
+		remaining, err1 := strconv.Atoi(fields[i])
+		limit, err2 := strconv.Atoi(fields[i+1])
+		if err1 == nil && err2 == nil && limit > 0 && remaining*10 < limit {
+			c.Status = "warn"
+			c.Hints = []string{"Less than 10% of the rate limit is left; check the reset time with: gh api rate_limit"}
+		}
+	}
+	return c
+}
+
+func checkRepoAccess(tool, target string) doctorCheck {
+	c := doctorCheck{Name: "Repository access"}
+	var err error
+	if tool == "glab" {
+		_, err = runDoctorCommand("glab", "api", "projects/"+url.PathEscape(target))
+	} else {
+		_, err = runDoctorCommand("gh", "api", "repos/"+target, "--jq", ".full_name")
+	}
+	if err != nil {
+		return failedCheck(c, err)
+	}
+	c.Status = "pass"
+	c.Detail = target
+	return c
+}
+
+func checkFixtures() doctorCheck {
+	c := doctorCheck{Name: "Fixture directory"}
+	if fixturesDir == "" {
+		c.Status = "fail"
+		c.Hints = []string{"Pass --fixtures <dir> with the mock provider"}
+		return c
+	}
+	info, err := os.Stat(fixturesDir)
+	if err != nil || !info.IsDir() {
+		c.Status = "fail"
+		c.Detail = fixturesDir
+		c.Hints = []string{"The directory does not exist; see testdata/fixtures for the expected files"}
+		return c
+	}
+	c.Status = "pass"
+	c.Detail = fixturesDir
+	return c
+}
+
+// failedCheck fills c from a failed command, using the error diagnosis for the summary and hints.
+func failedCheck(c doctorCheck, err error) doctorCheck {
+	d := diagnose.Classify(err)
+	c.Status = "fail"
+	if d.Kind == diagnose.Unknown {
+		c.Detail = firstLine(err.Error())
+		return c
+	}
+	c.Detail = d.Summary
+	c.Hints = d.Hints
+	return c
+}
+
+// runDoctorCommand runs tool and returns its combined output; failures are reported in the same
+// "<tool> command failed" form as the providers so diagnose can classify them.
+func runDoctorCommand(tool string, args ...string) (string, error) {
+	cmd := transport.Command(tool, args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s command failed: %w\n%s", tool, err, out.String())
+	}
+	return out.String(), nil
+}
+
+// loggedInAccount extracts "account (host)" from auth status output, which differs between tool versions.
+func loggedInAccount(out string) string {
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(line, "✓ "))
+		if strings.HasPrefix(line, "Logged in to ") {
+			return strings.TrimPrefix(line, "Logged in to ")
+		}
+	}
+	return ""
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}
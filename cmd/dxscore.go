@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// dxWeights returns the DX score weights from the config.
+func dxWeights() stats.DXWeights {
+	return stats.DXWeights{
+		LeadTime:      cfg.DXScore.LeadTime,
+		ReviewLatency: cfg.DXScore.ReviewLatency,
+		CIWait:        cfg.DXScore.CIWait,
+		FailureRate:   cfg.DXScore.FailureRate,
+	}
+}
+
+// runDXScore shows the composite developer experience score of the combined report.
+func runDXScore(s stats.Stats, runs []actions.WorkflowRun) {
+	displayDXScore(stats.CalculateDXScore(s, runs, dxWeights()))
+}
+
+func displayDXScore(score stats.DXScore) {
+	fmt.Println("\n" + i18n.T("🧭 Developer Experience Score:"))
+	if len(score.Components) == 0 {
+		fmt.Println(i18n.T("Not enough data for a score in this period"))
+		return
+	}
+	fmt.Printf(i18n.Sprintf("Score: %.0f / 100 (grade %s)\n", score.Score, score.Grade))
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Component"), i18n.T("Value"), i18n.T("Component Score"), i18n.T("Weight"), i18n.T("Contribution")})
+	table.SetBorder(true)
+	for _, c := range score.Components {
+		table.Append([]string{
+			i18n.T(c.Name),
+			fmt.Sprintf("%.1f %s", c.Value, c.Unit),
+			fmt.Sprintf("%.0f", c.Score),
+			fmt.Sprintf("%.0f%%", c.Weight*100),
+			fmt.Sprintf("%.1f", c.Contribution),
+		})
+	}
+	table.Render()
+
+	if len(score.Missing) > 0 {
+		missing := make([]string, len(score.Missing))
+		for i, name := range score.Missing {
+			missing[i] = i18n.T(name)
+		}
+		fmt.Printf(i18n.Sprintf("Left out (no data): %s\n", strings.Join(missing, ", ")))
+	}
+	fmt.Println(i18n.T("💡 An opinionated heuristic: each component scores 100 at or below a good value and 0 at or beyond a poor one. Adjust the weights under \"dxScore\" in the config."))
+}
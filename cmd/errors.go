@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/diagnose"
+)
+
+// exitWithError prints err under prefix and exits. Known gh/glab failure modes are shown as a
+// short explanation with remediation hints and their own exit code instead of the raw command
+// output, which is still printed when VISUCHE_DEBUG is set.
+func exitWithError(prefix string, err error) {
+	d := diagnose.Classify(err)
+	if d.Kind == diagnose.Unknown {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", prefix, err)
+		os.Exit(d.ExitCode())
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: %s\n", prefix, d.Summary)
+	for _, hint := range d.Hints {
+		fmt.Fprintf(os.Stderr, "  💡 %s\n", hint)
+	}
+	if os.Getenv("VISUCHE_DEBUG") != "" {
+		fmt.Fprintf(os.Stderr, "\n%s\n", d.Detail)
+	} else {
+		fmt.Fprintln(os.Stderr, "  (set VISUCHE_DEBUG=1 to see the full command output)")
+	}
+	os.Exit(d.ExitCode())
+}
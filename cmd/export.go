@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"visuche/internal/actions"
+	"visuche/internal/csv"
+	"visuche/internal/github"
+	"visuche/internal/json"
+	"visuche/internal/metadata"
+	"visuche/internal/parquet"
+)
+
+var exportFormats []string
+
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&exportFormats, "export", nil, "Export the per-PR dataset (and the per-run dataset with visuche actions) in additional formats (parquet, jsonl)")
+}
+
+// validateExport checks the --export formats before any data is fetched.
+func validateExport() error {
+	for _, format := range exportFormats {
+		switch format {
+		case "parquet", "jsonl":
+		default:
+			return fmt.Errorf("unsupported --export format %q (supported: parquet, jsonl)", format)
+		}
+	}
+	return nil
+}
+
+// exporting reports whether --export includes format.
+func exporting(format string) bool {
+	for _, f := range exportFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// runPRExport writes the per-PR dataset in the --export formats.
+func runPRExport(prs []github.PullRequest) {
+	if !exporting("parquet") {
+		return
+	}
+	filename := fmt.Sprintf("visuche_%s.parquet", strings.ReplaceAll(repo, "/", "-"))
+	if err := parquet.WritePullRequestsToParquet(filename, prs, exportMetadata(metadata.PullRequests)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing Parquet: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("📁 Parquet output: %s\n", filename)
+}
+
+// runRunExport writes the per-run dataset in the --export formats.
+func runRunExport(runs []actions.WorkflowRun) {
+	if !exporting("parquet") {
+		return
+	}
+	filename := fmt.Sprintf("visuche_%s_runs.parquet", strings.ReplaceAll(repo, "/", "-"))
+	if err := parquet.WriteWorkflowRunsToParquet(filename, runs, exportMetadata(metadata.WorkflowRuns)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing Parquet: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("📁 Parquet output: %s\n", filename)
+}
+
+// runFailureExport writes the failed runs with their failed job and step to CSV (--csv) and JSON (--json).
+func runFailureExport(failures []actions.FailureDetail) {
+	base := fmt.Sprintf("visuche_%s_failures", strings.ReplaceAll(repo, "/", "-"))
+	meta := exportMetadata(metadata.WorkflowFailures)
+	if csvOutput {
+		filename := base + ".csv"
+		if err := csv.WriteFailureDetailsToCSV(filename, failures, meta); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		} else {
+			fmt.Printf("📁 CSV output: %s (%d failures)\n", filename, len(failures))
+		}
+	}
+	if jsonOutput {
+		filename := base + ".json"
+		if err := json.WriteFailureDetailsToJSON(filename, failures, meta); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing JSON: %v\n", err)
+		} else {
+			fmt.Printf("📁 JSON output: %s (%d failures)\n", filename, len(failures))
+		}
+	}
+}
+
+// runRunStream writes the fetched workflow runs of the period to a JSON Lines file with --export jsonl,
+// before they are analyzed.
+func runRunStream(runs []actions.WorkflowRun) {
+	if !exporting("jsonl") {
+		return
+	}
+	filename := fmt.Sprintf("visuche_%s_runs.jsonl", strings.ReplaceAll(repo, "/", "-"))
+	w, err := json.CreateLines(filename, exportMetadata(metadata.WorkflowRuns))
+	if err == nil {
+		for _, run := range runs {
+			if err = w.WriteWorkflowRun(run); err != nil {
+				break
+			}
+		}
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing JSON Lines: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("📁 JSON Lines output: %s (%d runs)\n", filename, w.Count)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayForkReport compares PRs opened from forks with PRs from the repository's own branches.
+// Nothing is shown when no PR comes from a fork.
+func displayForkReport(prs []github.PullRequest) {
+	report := stats.CalculateForkReport(prs)
+	if report.Fork.PRs == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🍴 Fork vs Branch PRs:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Forks"), i18n.T("Branches")})
+	table.SetBorder(true)
+	f, b := report.Fork, report.SameRepo
+	table.Append([]string{i18n.T("PRs"), fmt.Sprintf("%d", f.PRs), fmt.Sprintf("%d", b.PRs)})
+	table.Append([]string{i18n.T("Contributors"), fmt.Sprintf("%d", f.Contributors), fmt.Sprintf("%d", b.Contributors)})
+	table.Append([]string{i18n.T("Merged"), fmt.Sprintf("%d", f.Merged), fmt.Sprintf("%d", b.Merged)})
+	table.Append([]string{i18n.T("Closed without merge"), fmt.Sprintf("%d", f.Closed), fmt.Sprintf("%d", b.Closed)})
+	table.Append([]string{i18n.T("Merge Rate"), formatMergeRate(f), formatMergeRate(b)})
+	table.Append([]string{i18n.T("Time to First Review (median)"), formatDuration(f.MedianTimeToReview), formatDuration(b.MedianTimeToReview)})
+	table.Append([]string{i18n.T("Median Lead Time"), formatDuration(f.MedianLeadTime), formatDuration(b.MedianLeadTime)})
+	table.Append([]string{i18n.T("Open"), fmt.Sprintf("%d", f.Open), fmt.Sprintf("%d", b.Open)})
+	table.Append([]string{i18n.T("Open without review"), fmt.Sprintf("%d", f.UnreviewedOpen), fmt.Sprintf("%d", b.UnreviewedOpen)})
+	table.Render()
+	fmt.Println(i18n.T("💡 Merge rate is the share of closed PRs that were merged; time to first review counts reviewed PRs only."))
+}
+
+// formatMergeRate formats the merge rate of g, or "-" when none of its PRs is closed yet.
+func formatMergeRate(g stats.ContributionGroup) string {
+	if g.Merged+g.Closed == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", g.MergeRate)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/mermaid"
+)
+
+var (
+	ganttSlowest int
+	prGantt      bool
+)
+
+func init() {
+	rootCmd.PersistentFlags().IntVar(&ganttSlowest, "gantt-slowest", 0, "Print a Mermaid gantt diagram of the stages of the N merged PRs with the longest lead time")
+	prCmd.Flags().BoolVar(&prGantt, "gantt", false, "Also print a Mermaid gantt diagram of the PR's stages")
+}
+
+// runSlowestGantt prints the stage gantt of the slowest merged PRs (only with --gantt-slowest).
+func runSlowestGantt(prs []github.PullRequest) {
+	if ganttSlowest <= 0 {
+		return
+	}
+
+	var merged []github.PullRequest
+	for _, pr := range prs {
+		if pr.Merged {
+			merged = append(merged, pr)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].LeadTime > merged[j].LeadTime })
+	if len(merged) > ganttSlowest {
+		merged = merged[:ganttSlowest]
+	}
+
+	fmt.Println("\n" + i18n.Sprintf("🗓️  Slowest %d PRs (Mermaid gantt):", len(merged)))
+	printMermaid(mermaid.PRGantt(i18n.Sprintf("Slowest PRs in %s", repo), merged))
+}
+
+// printMermaid prints a diagram as a fenced block ready to paste into GitHub Markdown.
+func printMermaid(diagram string) {
+	fmt.Println("```mermaid")
+	fmt.Print(diagram)
+	fmt.Println("```")
+}
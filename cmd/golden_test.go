@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"visuche/internal/config"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+	"visuche/internal/report"
+)
+
+// update rewrites the golden files with the current output: go test ./cmd -run TestGolden -update
+var update = flag.Bool("update", false, "rewrite the golden files with the current output")
+
+// goldenTime pins the age of the open fixture PRs.
+var goldenTime = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// goldenDir holds the expected renderer output, next to the fixtures it is rendered from. Tests run
+// in the package directory, so both are one level up.
+var goldenDir = filepath.Join("..", "testdata", "golden")
+
+// goldenExtensions maps report formats to golden file extensions.
+var goldenExtensions = map[string]string{
+	report.FormatTable:    ".txt",
+	report.FormatMarkdown: ".md",
+	report.FormatJSON:     ".json",
+}
+
+// TestGolden renders the PR statistics report of testdata/fixtures and a renderer sample in every
+// report format and compares the output with the golden files. Reports are rendered in English with
+// the default heuristics, whatever the config says.
+func TestGolden(t *testing.T) {
+	i18n.SetLanguage("en")
+	cfg.Heuristics = config.HeuristicsConfig{}
+	applyHeuristics()
+	analysisTime = func() time.Time { return goldenTime }
+
+	fixtures := filepath.Join("..", filepath.FromSlash(defaultBenchFixtures))
+	analysis, err := analyzePullRequests(provider.NewMock(fixtures), "example/visuche", "2000-01-01", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports := map[string]report.Report{
+		"stats":    statsReport(analysis.Stats, allPRReports),
+		"renderer": rendererSample(),
+	}
+
+	for _, name := range []string{"stats", "renderer"} {
+		for _, format := range report.Formats {
+			path := filepath.Join(goldenDir, name+goldenExtensions[format])
+			t.Run(filepath.Base(path), func(t *testing.T) {
+				var buf bytes.Buffer
+				if err := report.Render(&buf, format, reports[name]); err != nil {
+					t.Fatal(err)
+				}
+				if *update {
+					if err := os.MkdirAll(goldenDir, 0o755); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("%v (run go test ./cmd -run TestGolden -update to create it)", err)
+				}
+				if line, ok := firstDifference(want, buf.Bytes()); !ok {
+					t.Errorf("%s differs from line %d; if the change is intended, run go test ./cmd -run TestGolden -update", path, line)
+				}
+			})
+		}
+	}
+}
+
+// firstDifference compares want and got line by line, returning the first differing line (1-based)
+// and false when they differ.
+func firstDifference(want, got []byte) (int, bool) {
+	if bytes.Equal(want, got) {
+		return 0, true
+	}
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+	for i := 0; i < len(wantLines) && i < len(gotLines); i++ {
+		if wantLines[i] != gotLines[i] {
+			return i + 1, false
+		}
+	}
+	if len(wantLines) < len(gotLines) {
+		return len(wantLines) + 1, false
+	}
+	return len(gotLines) + 1, false
+}
+
+// rendererSample exercises the renderer edge cases the stats report may not hit: pipes in cells,
+// empty cells, a section without rows and a notes-only section.
+func rendererSample() report.Report {
+	r := report.Report{Title: "Renderer Sample"}
+	cells := r.AddSection("Cells:", "Name", "Value")
+	cells.Append("pipe", "a | b")
+	cells.Append("empty", "")
+	cells.Append("unicode", "承認→マージ")
+	r.AddSection("No Rows:", "Metric", "Value")
+	notes := r.AddSection("Notes Only:")
+	notes.Note("First note")
+	notes.Note("   • Indented note")
+	withNote := r.AddSection("Table With Note:", "Metric")
+	withNote.Append("row")
+	withNote.Note("💡 Note below the table")
+	return r
+}
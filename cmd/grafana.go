@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	encjson "encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+	"visuche/internal/stats"
+)
+
+// series returns the weekly series for metric, from the PR or CI analysis depending on the metric.
+func (s *apiServer) series(query apiQuery, metric string) ([]stats.Point, error) {
+	for _, m := range stats.PRMetrics {
+		if m == metric {
+			result, err := s.prAnalysis(query)
+			if err != nil {
+				return nil, err
+			}
+			return result.Series[metric], nil
+		}
+	}
+	for _, m := range stats.CIMetrics {
+		if m == metric {
+			result, err := s.actionsAnalysis(query)
+			if err != nil {
+				return nil, err
+			}
+			return result.Series[metric], nil
+		}
+	}
+	return nil, apiError{fmt.Sprintf("unknown metric %q", metric)}
+}
+
+// handleTimeseries serves one weekly series as [{time, value}], the shape the Infinity datasource expects.
+func (s *apiServer) handleTimeseries(w http.ResponseWriter, r *http.Request) {
+	query, err := parseAPIQuery(r)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	points, err := s.series(query, r.URL.Query().Get("metric"))
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	if points == nil {
+		points = []stats.Point{}
+	}
+	writeAPIJSON(w, points)
+}
+
+// handleGrafanaHealth answers the JSON datasource connection test.
+func (s *apiServer) handleGrafanaHealth(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/grafana/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleGrafanaMetrics lists the metrics selectable in the Grafana query editor.
+func (s *apiServer) handleGrafanaMetrics(w http.ResponseWriter, r *http.Request) {
+	type metric struct {
+		Label string `json:"label"`
+		Value string `json:"value"`
+	}
+	var metrics []metric
+	for _, names := range [][]string{stats.PRMetrics, stats.CIMetrics} {
+		for _, name := range names {
+			metrics = append(metrics, metric{Label: name, Value: name})
+		}
+	}
+	writeAPIJSON(w, metrics)
+}
+
+// grafanaQueryRequest is the subset of the JSON datasource /query body we use.
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	Targets []struct {
+		Target  string `json:"target"`
+		RefID   string `json:"refId"`
+		Payload struct {
+			Repo string `json:"repo"`
+		} `json:"payload"`
+	} `json:"targets"`
+}
+
+// grafanaSeries is a timeseries response: datapoints are [value, unix milliseconds].
+type grafanaSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// handleGrafanaQuery answers JSON datasource queries. The dashboard time range becomes since/until and
+// the repository comes from the target payload ({"repo": "owner/repo"}) or --repo.
+func (s *apiServer) handleGrafanaQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, apiError{"POST required"})
+		return
+	}
+
+	var req grafanaQueryRequest
+	if err := encjson.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, apiError{fmt.Sprintf("invalid query body: %v", err)})
+		return
+	}
+
+	response := []grafanaSeries{}
+	for _, target := range req.Targets {
+		if target.Target == "" {
+			continue
+		}
+		query := apiQuery{repo: target.Payload.Repo}
+		if query.repo == "" {
+			query.repo = repo
+		}
+		if query.repo == "" {
+			writeAPIError(w, apiError{"repo is required: set it in the query payload or start serve with --repo"})
+			return
+		}
+		if err := validateRepoInput(query.repo); err != nil {
+			writeAPIError(w, apiError{err.Error()})
+			return
+		}
+		if !req.Range.From.IsZero() {
+			query.since = req.Range.From.UTC().Format("2006-01-02")
+		}
+		if !req.Range.To.IsZero() {
+			query.until = req.Range.To.UTC().Format("2006-01-02")
+		}
+
+		points, err := s.series(query, target.Target)
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		series := grafanaSeries{Target: target.Target, Datapoints: [][2]float64{}}
+		for _, p := range points {
+			series.Datapoints = append(series.Datapoints, [2]float64{p.Value, float64(p.Time.UnixMilli())})
+		}
+		response = append(response, series)
+	}
+	writeAPIJSON(w, response)
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var groupBy string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&groupBy, "group-by", "", "Aggregate PR and review metrics per group (team: uses the teams mapping in the config, author: per PR author, label: per PR label)")
+}
+
+// validateGroupBy checks the --group-by value and its prerequisites.
+func validateGroupBy() error {
+	switch groupBy {
+	case "":
+		return nil
+	case "team":
+		if len(cfg.Teams) == 0 {
+			return fmt.Errorf("--group-by team requires a \"teams\" mapping in the config file")
+		}
+		return nil
+	case "author", "label":
+		return nil
+	default:
+		return fmt.Errorf("unsupported --group-by value %q (supported: team, author, label)", groupBy)
+	}
+}
+
+// runGroupReport displays per-group metrics when --group-by is set.
+func runGroupReport(prs []github.PullRequest, defaultBranch string) {
+	switch groupBy {
+	case "team":
+		displayGroupStats(stats.CalculateGroupStats(prs, cfg.TeamOf, defaultBranch, analysisTime()), "👥 Metrics by Team:", "Team", "Reviews Given (PRs)")
+	case "author":
+		displayAuthorStats(prs, defaultBranch)
+	case "label":
+		displayGroupStats(stats.CalculateLabelStats(prs, defaultBranch, analysisTime()), "🏷️  Metrics by Label:", "Label", "Reviews Received (PRs)")
+	}
+}
+
+// displayAuthorStats displays PR and review metrics per PR author.
+func displayAuthorStats(prs []github.PullRequest, defaultBranch string) {
+	byLogin := func(login string) string { return login }
+	displayGroupStats(stats.CalculateGroupStats(prs, byLogin, defaultBranch, analysisTime()), "👥 Metrics by Author:", "Author", "Reviews Given (PRs)")
+}
+
+// displayGroupStats displays PR and review metrics side by side for each group. reviewsHeader names the
+// GroupStats review counts, which mean reviews given for people and reviews received for labels.
+func displayGroupStats(groups []stats.GroupStats, title, nameHeader, reviewsHeader string) {
+	if len(groups) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T(title))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		i18n.T(nameHeader),
+		i18n.T("PRs (merged)"),
+		i18n.T("Lead Time (avg/median)"),
+		i18n.T("First Review (median)"),
+		i18n.T("Review Stage (median)"),
+		i18n.T("Approval→Merge (median)"),
+		i18n.T("Comments/PR"),
+		i18n.T("Self-merge"),
+		i18n.T(reviewsHeader),
+	})
+	table.SetBorder(true)
+	for _, g := range groups {
+		name := g.Name
+		if name == stats.UnassignedGroup || name == stats.NoLabelGroup {
+			name = i18n.T(name)
+		}
+		s := g.Stats
+		table.Append([]string{
+			name,
+			fmt.Sprintf("%d (%d)", s.TotalPRs, s.MergedPRs),
+			fmt.Sprintf("%s / %s", formatDuration(s.AverageLeadTime), formatDuration(s.MedianLeadTime)),
+			formatDuration(s.MedianTimeToFirstReview),
+			formatDuration(s.MedianReviewStageTime),
+			formatDuration(s.MedianApprovalToMerge),
+			fmt.Sprintf("%.1f", s.AverageCommentsPerPR),
+			fmt.Sprintf("%.1f%%", s.SelfMergeRate),
+			fmt.Sprintf("%d (%d)", g.ReviewsGiven, g.PRsReviewed),
+		})
+	}
+	table.Render()
+}
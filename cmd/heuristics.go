@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+)
+
+// applyHeuristics installs the heuristic overrides from the config, skipping invalid patterns with a warning.
+func applyHeuristics() {
+	h := stats.DefaultHeuristics()
+	c := cfg.Heuristics
+	h.ReleaseBranches = c.ReleaseBranches
+	h.WIPLabels = c.WIPLabels
+	h.HotfixLabels = c.HotfixLabels
+	h.RevertLabels = c.RevertLabels
+	if len(c.LongLivedBranches) > 0 {
+		h.LongLivedBranches = c.LongLivedBranches
+	}
+	if len(c.HotfixPatterns) > 0 {
+		h.HotfixPatterns = nil
+		for _, p := range c.HotfixPatterns {
+			pattern, err := regexp.Compile(p)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Ignoring invalid hotfixPatterns entry %q: %v\n", p, err)
+				continue
+			}
+			h.HotfixPatterns = append(h.HotfixPatterns, pattern)
+		}
+	}
+	if c.WIPTitlePattern != "" {
+		pattern, err := regexp.Compile(c.WIPTitlePattern)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Ignoring invalid wipTitlePattern %q: %v\n", c.WIPTitlePattern, err)
+		} else {
+			h.WIPTitlePattern = pattern
+		}
+	}
+	stats.SetHeuristics(h)
+	github.SetBotLogins(c.BotLogins)
+}
+
+// displayEffectiveSettings echoes the heuristics the report was computed with, so numbers from runs with
+// different configs are not compared unknowingly.
+func displayEffectiveSettings(defaultBranch string) {
+	h := stats.CurrentHeuristics()
+
+	releases := defaultBranch
+	if releases == "" {
+		releases = "main/master"
+	}
+	if len(h.ReleaseBranches) > 0 {
+		releases = strings.Join(h.ReleaseBranches, ", ")
+	}
+	var patterns []string
+	for _, pattern := range h.HotfixPatterns {
+		patterns = append(patterns, pattern.String())
+	}
+	hotfixes := i18n.Sprintf("head branches matching %s", strings.Join(patterns, ", "))
+	if len(h.HotfixLabels) > 0 {
+		hotfixes += ", " + i18n.Sprintf("labels %s", strings.Join(h.HotfixLabels, ", "))
+	}
+	reverts := i18n.T("titles containing \"revert\"")
+	if len(h.RevertLabels) > 0 {
+		reverts += ", " + i18n.Sprintf("labels %s", strings.Join(h.RevertLabels, ", "))
+	}
+	wip := []string{i18n.T("drafts")}
+	if len(h.WIPLabels) > 0 {
+		wip = append(wip, i18n.Sprintf("labels %s", strings.Join(h.WIPLabels, ", ")))
+	}
+	if h.WIPTitlePattern != nil {
+		wip = append(wip, i18n.Sprintf("titles matching %s", h.WIPTitlePattern))
+	}
+	bots := i18n.T("[bot]/app accounts and known merge bots")
+	if len(cfg.Heuristics.BotLogins) > 0 {
+		bots += ", " + strings.Join(cfg.Heuristics.BotLogins, ", ")
+	}
+	threshold, window := anomalySettings()
+
+	fmt.Println("\n" + i18n.T("⚙️  Effective Settings:"))
+	fmt.Println(i18n.Sprintf("  Releases: merges into %s", releases))
+	fmt.Println(i18n.Sprintf("  Hotfixes: %s", hotfixes))
+	fmt.Println(i18n.Sprintf("  Reverts: %s", reverts))
+	fmt.Println(i18n.Sprintf("  WIP: open %s", strings.Join(wip, ", ")))
+	fmt.Println(i18n.Sprintf("  Bots (PRs and reviews excluded): %s", bots))
+	fmt.Println(i18n.Sprintf("  Long-lived branches (never stack parents): %s", strings.Join(h.LongLivedBranches, ", ")))
+	fmt.Println(i18n.Sprintf("  Anomalies: beyond %.1f MADs of the preceding %d weeks", threshold, window))
+	if slaUseBusinessHours() {
+		fmt.Println(i18n.Sprintf("  Business hours: %d:00–%d:00 on weekdays", cfg.SLA.WorkdayStart, cfg.SLA.WorkdayEnd))
+	}
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+	"visuche/internal/history"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var (
+	noHistory  bool
+	historyDir string
+)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&noHistory, "no-history", false, "Don't record this run in the local history or show changes vs the last comparable run")
+	rootCmd.PersistentFlags().StringVar(&historyDir, "history-dir", "", "Directory of the local run history (default: ~/.local/share/visuche/history)")
+}
+
+// historyStore opens the backend selected by the "history" config, under --history-dir for the file-based ones.
+func historyStore() (history.Backend, error) {
+	dir := historyDir
+	if dir == "" {
+		dir = history.DefaultDir()
+	}
+	dsn := cfg.History.DSN
+	if env := os.Getenv("VISUCHE_HISTORY_DSN"); env != "" {
+		dsn = env
+	}
+	return history.Open(cfg.History.Backend, dir, dsn)
+}
+
+// runHistory shows changes against the previous comparable run and records the current one.
+// History problems are reported but never fail the run.
+func runHistory(s stats.Stats) {
+	// Runs limited with --metrics are not comparable with full ones
+	if noHistory || len(selectedMetrics) > 0 {
+		return
+	}
+
+	current := history.Snapshot{
+		RecordedAt: time.Now().UTC(),
+		Provider:   providerName,
+		Repo:       repo,
+		Since:      since,
+		Until:      until,
+		Author:     author,
+		Label:      label,
+		Stats:      s,
+	}
+
+	store, err := historyStore()
+	if err != nil {
+		fmt.Printf("⚠️  Opening run history failed: %v\n", err)
+		return
+	}
+	defer store.Close()
+	snapshots, err := store.Load(repo)
+	if err != nil {
+		fmt.Printf("⚠️  Reading run history failed: %v\n", err)
+	}
+	if previous, ok := history.Previous(snapshots, current); ok {
+		displayHistoryDeltas(previous, s)
+	}
+
+	if err := store.Append(current); err != nil {
+		fmt.Printf("⚠️  Recording run history failed: %v\n", err)
+	}
+}
+
+// historyMetric is a metric annotated with its change since the last run.
+type historyMetric struct {
+	label         string
+	value         func(stats.Stats) float64
+	format        func(float64) string
+	lowerIsBetter bool
+}
+
+var historyMetrics = []historyMetric{
+	{label: "Merged PRs", value: func(s stats.Stats) float64 { return float64(s.MergedPRs) }, format: formatCount},
+	{label: "Lead Time (median)", value: func(s stats.Stats) float64 { return float64(s.MedianLeadTime) }, format: formatDurationValue, lowerIsBetter: true},
+	{label: "First Review (median)", value: func(s stats.Stats) float64 { return float64(s.MedianTimeToFirstReview) }, format: formatDurationValue, lowerIsBetter: true},
+	{label: "Review Stage (median)", value: func(s stats.Stats) float64 { return float64(s.MedianReviewStageTime) }, format: formatDurationValue, lowerIsBetter: true},
+	{label: "Approval→Merge (median)", value: func(s stats.Stats) float64 { return float64(s.MedianApprovalToMerge) }, format: formatDurationValue, lowerIsBetter: true},
+	{label: "Releases / week", value: func(s stats.Stats) float64 { return s.ReleasesPerWeek }, format: func(v float64) string { return fmt.Sprintf("%.1f", v) }},
+	{label: "Self-merge", value: func(s stats.Stats) float64 { return s.SelfMergeRate }, format: formatPercent, lowerIsBetter: true},
+	{label: "Reopen Rate", value: func(s stats.Stats) float64 { return s.ReopenRate }, format: formatPercent, lowerIsBetter: true},
+}
+
+func formatCount(v float64) string         { return fmt.Sprintf("%.0f", v) }
+func formatPercent(v float64) string       { return fmt.Sprintf("%.1f%%", v) }
+func formatDurationValue(v float64) string { return formatDuration(time.Duration(v)) }
+
+// formatDelta renders the relative change as "▼ 12% (better)".
+func formatDelta(previous, current float64, lowerIsBetter bool) string {
+	if previous == current {
+		return "→ 0%"
+	}
+	if previous == 0 {
+		return "▲ " + i18n.T("new")
+	}
+	change := (current - previous) / math.Abs(previous) * 100
+	arrow := "▲"
+	if change < 0 {
+		arrow = "▼"
+	}
+	verdict := i18n.T("better")
+	if (change > 0) == lowerIsBetter {
+		verdict = i18n.T("worse")
+	}
+	return fmt.Sprintf("%s %.0f%% (%s)", arrow, math.Abs(change), verdict)
+}
+
+// displayHistoryDeltas shows key metrics next to their value in the previous comparable run.
+func displayHistoryDeltas(previous history.Snapshot, current stats.Stats) {
+	fmt.Println("\n" + i18n.Sprintf("📈 Changes vs last run (%s):", previous.RecordedAt.Local().Format("2006-01-02 15:04")))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Current"), i18n.T("Previous"), i18n.T("Change")})
+	table.SetBorder(true)
+	for _, m := range historyMetrics {
+		cur, prev := m.value(current), m.value(previous.Stats)
+		table.Append([]string{i18n.T(m.label), m.format(cur), m.format(prev), formatDelta(prev, cur, m.lowerIsBetter)})
+	}
+	table.Render()
+}
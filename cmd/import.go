@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"visuche/internal/csv"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/json"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <file.csv|file.json>",
+	Short: "Load PRs from a previous --csv/--json export into the local store",
+	Long: `Load PRs exported with --csv or --json into the local store of --repo, so periods from before
+visuche (or its collector) was set up can be analyzed with --provider store. PRs already in the store are
+kept, since they carry reviews and commits that exports don't.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(filename string) {
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	var prs []github.PullRequest
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		prs, err = csv.ReadPullRequestsFromCSV(filename)
+	case ".json":
+		prs, err = json.ReadPullRequestsFromJSON(filename)
+	default:
+		err = fmt.Errorf("unsupported file type %q: use a .csv or .json export", filepath.Ext(filename))
+	}
+	if err != nil {
+		exitWithError("Error reading "+filename, err)
+	}
+
+	st := localStore()
+	added, err := st.ImportPullRequests(repo, prs)
+	if err != nil {
+		exitWithError("Error importing", err)
+	}
+	fmt.Printf(i18n.Sprintf("📥 Imported %d of %d PRs into the store of %s (%d already stored)\n", added, len(prs), repo, len(prs)-added))
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"visuche/internal/github"
+	"visuche/internal/notify"
+	"visuche/internal/stats"
+)
+
+var postToIssue string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&postToIssue, "post-to-issue", "", "Post the Markdown summary as a comment on owner/repo#123 (or #123 in the analyzed repo); owner/repo or \"new\" opens a new issue instead")
+}
+
+// issueTarget is where --post-to-issue publishes; number 0 means a new issue.
+type issueTarget struct {
+	repo   string
+	number int
+}
+
+// parseIssueTarget resolves a --post-to-issue value against the analyzed repository.
+func parseIssueTarget(value, analyzedRepo string) (issueTarget, error) {
+	value = strings.TrimSpace(value)
+	if value == "new" {
+		return issueTarget{repo: analyzedRepo}, nil
+	}
+
+	target := issueTarget{repo: value}
+	if i := strings.LastIndex(value, "#"); i >= 0 {
+		number, err := strconv.Atoi(value[i+1:])
+		if err != nil || number <= 0 {
+			return issueTarget{}, fmt.Errorf("invalid --post-to-issue %q: expected owner/repo#123", value)
+		}
+		target = issueTarget{repo: value[:i], number: number}
+	} else if number, err := strconv.Atoi(value); err == nil && number > 0 {
+		target = issueTarget{number: number}
+	}
+
+	if target.repo == "" {
+		target.repo = analyzedRepo
+	}
+	if len(strings.Split(target.repo, "/")) != 2 {
+		return issueTarget{}, fmt.Errorf("invalid --post-to-issue %q: expected owner/repo#123, #123, owner/repo or new", value)
+	}
+	return target, nil
+}
+
+// validatePostToIssue checks the --post-to-issue value before any data is fetched.
+func validatePostToIssue() error {
+	if postToIssue == "" {
+		return nil
+	}
+	_, err := parseIssueTarget(postToIssue, repo)
+	return err
+}
+
+// runPostToIssue publishes the Markdown summary through gh. Failures are reported but don't fail the run.
+func runPostToIssue(s stats.Stats) {
+	if postToIssue == "" {
+		return
+	}
+	target, err := parseIssueTarget(postToIssue, repo)
+	if err != nil {
+		fmt.Printf("⚠️  %v\n", err)
+		return
+	}
+
+	summary := buildRunSummary(s)
+	body := notify.Markdown(summary)
+	var url string
+	if target.number == 0 {
+		url, err = github.CreateIssue(target.repo, fmt.Sprintf("%s (%s)", summary.Title, summary.Text), body)
+	} else {
+		url, err = github.CommentOnIssue(target.repo, target.number, body)
+	}
+	if err != nil {
+		fmt.Printf("⚠️  Posting the summary to %s failed: %v\n", target.repo, err)
+		return
+	}
+	fmt.Printf("💬 Posted summary: %s\n", url)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/animation"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/jira"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// runJiraReport resolves Jira keys referenced by PRs merged into the default branch and displays
+// issue-to-production lead time. It does nothing unless jira.baseUrl is set in the config.
+func runJiraReport(prs []github.PullRequest, defaultBranch string) {
+	if !cfg.Jira.Enabled() {
+		return
+	}
+
+	keysByPR := make(map[int][]string)
+	var allKeys []string
+	seen := make(map[string]bool)
+	for _, pr := range prs {
+		if !pr.Merged || !stats.IsDefaultBranch(pr.BaseRefName, defaultBranch) {
+			continue
+		}
+		keys := jira.ExtractKeys(cfg.Jira.Projects, pr.Title, pr.HeadRefName)
+		keysByPR[pr.Number] = keys
+		for _, key := range keys {
+			if !seen[key] {
+				seen[key] = true
+				allKeys = append(allKeys, key)
+			}
+		}
+	}
+
+	token := cfg.Jira.Token
+	if env := os.Getenv("VISUCHE_JIRA_TOKEN"); env != "" {
+		token = env
+	}
+	client := jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, token)
+
+	spinner := animation.NewShibaSpinner("Fetching Jira issues...", false)
+	spinner.Start()
+	issues, err := client.FetchIssues(allKeys)
+	spinner.Stop()
+	if err != nil {
+		fmt.Printf("⚠️  Jira lookup failed: %v\n", err)
+		if len(issues) == 0 {
+			return
+		}
+	}
+
+	issuesByPR := make(map[int][]jira.Issue)
+	for number, keys := range keysByPR {
+		for _, key := range keys {
+			if issue, ok := issues[key]; ok {
+				issuesByPR[number] = append(issuesByPR[number], issue)
+			}
+		}
+	}
+
+	displayJiraReport(stats.CalculateJiraReport(prs, issuesByPR, defaultBranch))
+}
+
+// displayJiraReport displays the Jira issue-to-production lead time report.
+func displayJiraReport(report stats.JiraReport) {
+	if report.MergedPRs == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🎫 Jira Lead Time:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Merged PRs with Jira Issue"), fmt.Sprintf("%d / %d (%.1f%%)", report.LinkedPRs, report.MergedPRs, report.LinkRate)})
+	if report.LinkedPRs > 0 {
+		table.Append([]string{i18n.T("Created→In Progress (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageCreatedToStarted), formatDuration(report.MedianCreatedToStarted))})
+		table.Append([]string{i18n.T("In Progress→Merge (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageStartedToMerge), formatDuration(report.MedianStartedToMerge))})
+		table.Append([]string{i18n.T("Issue→Production (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageIssueToMerge), formatDuration(report.MedianIssueToMerge))})
+	}
+	table.Render()
+	fmt.Println(i18n.T("💡 Keys are read from PR titles and branch names; production = merge into the default branch."))
+}
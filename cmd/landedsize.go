@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var landedSizeReport bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&landedSizeReport, "landed-size", false, "Compare merged PR sizes with the net size of the squash/merge commit they landed as (extra GraphQL queries)")
+}
+
+// runLandedSizeReport displays the landed size report when --landed-size is set.
+func runLandedSizeReport(prs []github.PullRequest) {
+	if !landedSizeReport {
+		return
+	}
+	displayLandedSizeReport(stats.CalculateLandedSize(prs))
+}
+
+// displayLandedSizeReport shows the reported PR size next to the size that actually landed on the base branch.
+func displayLandedSizeReport(report stats.LandedSizeReport) {
+	fmt.Println("\n" + i18n.T("🧮 PR Size vs Landed Change (merged PRs):"))
+	if report.Measured == 0 {
+		fmt.Println(i18n.Sprintf("No merge or squash commits to measure (%d rebase merges)", report.Rebase))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("PR"), i18n.T("Landed")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Additions (total)"), fmt.Sprintf("+%d", report.PRAdditions), fmt.Sprintf("+%d", report.LandedAdditions)})
+	table.Append([]string{i18n.T("Deletions (total)"), fmt.Sprintf("-%d", report.PRDeletions), fmt.Sprintf("-%d", report.LandedDeletions)})
+	table.Append([]string{i18n.T("Changed Lines (median)"), fmt.Sprintf("%.0f", report.MedianPRSize), fmt.Sprintf("%.0f", report.MedianLandedSize)})
+	table.Render()
+
+	fmt.Println(i18n.Sprintf("Measured %d PRs (%d squash, %d merge commit); %d rebase merges skipped.",
+		report.Measured, report.Squash, report.Merge, report.Rebase))
+	if report.Overstatement > 0 {
+		fmt.Println(i18n.Sprintf("PR sizes overstate the landed change by %.0f%%; %d PRs by more than 20%%.",
+			report.Overstatement, report.Overstated))
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var latencyReport bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&latencyReport, "latency", false, "Report event-to-start latency per trigger type (one extra API call per run)")
+}
+
+// runLatencyReport reports how long runs took from their trigger to the first job starting, per
+// event type (only with --latency).
+func runLatencyReport(details *ciDetails) {
+	if !latencyReport {
+		return
+	}
+	jobs, ok := details.Jobs()
+	if !ok {
+		fmt.Println("⚠️  --latency is not supported by this provider")
+		return
+	}
+
+	var schedules map[string][]string
+	files, ok, err := details.WorkflowFiles()
+	if ok && err != nil {
+		fmt.Printf("⚠️  Failed to fetch workflow files: %v\n", err)
+	}
+	if ok && err == nil {
+		schedules = actions.WorkflowSchedules(files)
+	}
+
+	displayEventLatency(actions.CalculateEventLatency(details.runs, jobs, schedules))
+}
+
+func displayEventLatency(latencies []actions.EventLatency) {
+	fmt.Println("\n" + i18n.T("⏱️  Event-to-Start Latency:"))
+	if len(latencies) == 0 {
+		fmt.Println(i18n.T("No runs to measure"))
+		return
+	}
+
+	stats := func(s actions.LatencyStats) []string {
+		if s.Samples == 0 {
+			return []string{"-", "-", "-"}
+		}
+		return []string{formatDuration(s.Median), formatDuration(s.P90), formatDuration(s.Max)}
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		i18n.T("Event"), i18n.T("Runs"),
+		i18n.T("Dispatch Median"), i18n.T("Dispatch P90"), i18n.T("Dispatch Max"),
+		i18n.T("Queue Median"), i18n.T("Queue P90"), i18n.T("Queue Max"),
+	})
+	table.SetBorder(true)
+	for _, l := range latencies {
+		row := []string{l.Event, fmt.Sprintf("%d", l.Runs)}
+		row = append(row, stats(l.Dispatch)...)
+		row = append(row, stats(l.Queue)...)
+		table.Append(row)
+	}
+	table.Render()
+	fmt.Println(i18n.T("💡 Dispatch is the delay from the cron tick to the run being created (only derivable for scheduled runs); queue is the wait from the run being created until a runner picked up its first job."))
+}
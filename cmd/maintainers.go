@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var slaMaintainerResponse string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&slaMaintainerResponse, "sla-maintainer-response", "", "First maintainer response target for PRs from forks, e.g. 48h (overrides config; extra GraphQL queries)")
+}
+
+// maintainerResponseTarget resolves the maintainer response target from flags and config. ok is false
+// when no target is configured.
+func maintainerResponseTarget() (target stats.SLATarget, ok bool, err error) {
+	value := cfg.SLA.MaintainerResponse
+	if slaMaintainerResponse != "" {
+		value = slaMaintainerResponse
+	}
+	if value == "" {
+		return target, false, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return target, false, fmt.Errorf("invalid maintainer response SLA %q: use a duration like 48h", value)
+	}
+
+	return stats.SLATarget{
+		MaintainerResponse: d,
+		BusinessHours:      slaUseBusinessHours(),
+		WorkdayStart:       cfg.SLA.WorkdayStart,
+		WorkdayEnd:         cfg.SLA.WorkdayEnd,
+	}, true, nil
+}
+
+// runMaintainerResponseReport calculates and displays the maintainer response SLO when a target is configured.
+func runMaintainerResponseReport(prs []github.PullRequest) {
+	target, ok, err := maintainerResponseTarget()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	displayMaintainerResponseReport(stats.CalculateMaintainerResponse(prs, target, time.Now()))
+}
+
+// displayMaintainerResponseReport displays how fast maintainers respond to PRs from forks, per week
+// and per maintainer, and the PRs that waited longer than the target.
+func displayMaintainerResponseReport(report stats.MaintainerResponseReport) {
+	targetLabel := formatDuration(report.Target.MaintainerResponse)
+	if report.Target.BusinessHours {
+		targetLabel = i18n.Sprintf("%s (business hours)", targetLabel)
+	}
+
+	fmt.Println("\n" + i18n.T("🤝 Maintainer Response SLO (PRs from forks):"))
+	fmt.Println("=" + strings.Repeat("=", 50))
+	fmt.Println(i18n.Sprintf("Target: first maintainer comment or review within %s", targetLabel))
+
+	if report.ForkPRs == 0 {
+		fmt.Println(i18n.T("No PRs from forks in this period"))
+		return
+	}
+
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("PRs from Forks"), fmt.Sprintf("%d", report.ForkPRs)})
+	summaryTable.Append([]string{i18n.T("Answered by a Maintainer"), fmt.Sprintf("%d", report.Responded)})
+	summaryTable.Append([]string{i18n.T("Open, Awaiting Response"), fmt.Sprintf("%d", report.AwaitingOpen)})
+	summaryTable.Append([]string{i18n.T("First Maintainer Response (median)"), formatDuration(report.MedianResponse)})
+	summaryTable.Append([]string{i18n.T("PRs Evaluated"), fmt.Sprintf("%d", report.Evaluated)})
+	summaryTable.Append([]string{i18n.T("Within SLA"), fmt.Sprintf("%d", report.Met)})
+	summaryTable.Append([]string{i18n.T("SLA Attainment"), fmt.Sprintf("%.1f%%", report.Attainment)})
+	summaryTable.Render()
+
+	if len(report.Weekly) > 0 {
+		fmt.Println("\n" + i18n.T("📅 SLA by Week:"))
+		displaySLABuckets(i18n.T("Week"), report.Weekly)
+	}
+
+	if len(report.Responders) > 0 {
+		fmt.Println("\n" + i18n.T("👤 SLA by Maintainer:"))
+		displaySLABuckets(i18n.T("Maintainer"), report.Responders)
+	}
+
+	if len(report.Violations) > 0 {
+		fmt.Println("\n" + i18n.T("🚨 SLA Violations:"))
+		displaySLAViolations(report.Violations, i18n.T("Maintainer"), i18n.T("(awaiting response)"))
+	}
+}
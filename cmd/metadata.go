@@ -0,0 +1,11 @@
+package cmd
+
+import (
+	"time"
+	"visuche/internal/metadata"
+)
+
+// exportMetadata returns the metadata block for an export of the current repository and period.
+func exportMetadata(export string) metadata.Metadata {
+	return metadata.New(export, metadata.Scope{Repo: repo, Since: since, Until: until}, time.Now())
+}
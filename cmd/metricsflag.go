@@ -0,0 +1,12 @@
+package cmd
+
+import (
+	"strings"
+	"visuche/internal/stats"
+)
+
+var selectedMetrics []string
+
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&selectedMetrics, "metrics", nil, "Compute and display only these PR metrics (and the ones they need): "+strings.Join(stats.MetricNames(), ", "))
+}
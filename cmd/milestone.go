@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var milestoneChart bool
+
+var milestoneCmd = &cobra.Command{
+	Use:   "milestone <number|title>",
+	Short: "Show the burndown and projected completion of a milestone",
+	Long: `Report the open and closed issues and PRs of a milestone over time, and project its completion
+date from the closures of the last two weeks.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runMilestone(args[0])
+	},
+}
+
+func init() {
+	milestoneCmd.Flags().BoolVar(&milestoneChart, "chart", false, "Also print the burndown as a bar chart")
+	rootCmd.AddCommand(milestoneCmd)
+}
+
+func runMilestone(ref string) {
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	fetcher, ok := p.(provider.MilestoneFetcher)
+	if !ok {
+		exitWithError("Error", fmt.Errorf("the %s provider does not support the milestone command", p.Name()))
+	}
+
+	milestones, err := fetcher.FetchMilestones(repo)
+	if err != nil {
+		exitWithError("Error fetching milestones", err)
+	}
+	m, err := github.FindMilestone(milestones, ref)
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	items, err := fetcher.FetchMilestoneItems(repo, m.Number)
+	if err != nil {
+		exitWithError("Error fetching milestone items", err)
+	}
+
+	now := time.Now()
+	if m.State == "closed" && !m.ClosedAt.IsZero() {
+		now = m.ClosedAt
+	}
+	displayBurndown(m, stats.CalculateBurndown(items, m.CreatedAt, now))
+}
+
+func displayBurndown(m github.Milestone, b stats.Burndown) {
+	fmt.Printf("\n%s #%d %s (%s)\n", i18n.T("🏁 Milestone"), m.Number, m.Title, i18n.T(m.State))
+
+	summary := tablewriter.NewWriter(os.Stdout)
+	summary.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summary.SetBorder(true)
+	summary.Append([]string{i18n.T("Issues (open / closed)"), fmt.Sprintf("%d / %d", b.OpenIssues, b.ClosedIssues)})
+	summary.Append([]string{i18n.T("PRs (open / closed)"), fmt.Sprintf("%d / %d", b.OpenPRs, b.ClosedPRs)})
+	if total := b.OpenIssues + b.ClosedIssues + b.OpenPRs + b.ClosedPRs; total > 0 {
+		closed := b.ClosedIssues + b.ClosedPRs
+		summary.Append([]string{i18n.T("Progress"), fmt.Sprintf("%.0f%%", float64(closed)/float64(total)*100)})
+	}
+	summary.Append([]string{i18n.Sprintf("Velocity (closed per day, last %d days)", stats.BurndownVelocityDays), fmt.Sprintf("%.2f", b.Velocity)})
+	if !m.DueOn.IsZero() {
+		summary.Append([]string{i18n.T("Due"), m.DueOn.Format("2006-01-02")})
+	}
+	open := b.OpenIssues + b.OpenPRs
+	switch {
+	case open == 0:
+		summary.Append([]string{i18n.T("Projected completion"), i18n.T("done")})
+	case b.Projected.IsZero():
+		summary.Append([]string{i18n.T("Projected completion"), i18n.T("unknown (nothing closed recently)")})
+	default:
+		summary.Append([]string{i18n.T("Projected completion"), b.Projected.Format("2006-01-02")})
+	}
+	summary.Render()
+
+	if open > 0 && !m.DueOn.IsZero() && (b.Projected.IsZero() || b.Projected.After(truncateToDay(m.DueOn))) {
+		fmt.Printf(i18n.Sprintf("⚠️  At the current velocity the milestone will miss its due date (%s)\n", m.DueOn.Format("2006-01-02")))
+	}
+
+	if len(b.Days) == 0 {
+		return
+	}
+
+	// Long milestones are shown one row per week, always ending with the latest day
+	days := b.Days
+	if len(days) > 31 {
+		var weekly []stats.BurndownDay
+		for i := 0; i < len(days); i += 7 {
+			weekly = append(weekly, days[i])
+		}
+		if last := days[len(days)-1]; weekly[len(weekly)-1].Date != last.Date {
+			weekly = append(weekly, last)
+		}
+		days = weekly
+	}
+
+	fmt.Println("\n" + i18n.T("📉 Burndown (end of day, UTC):"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Date"), i18n.T("Open"), i18n.T("Closed")})
+	table.SetBorder(true)
+	for _, day := range days {
+		table.Append([]string{day.Date.Format("2006-01-02"), fmt.Sprintf("%d", day.Open), fmt.Sprintf("%d", day.Closed)})
+	}
+	table.Render()
+
+	if milestoneChart {
+		fmt.Println(i18n.T("Open (█) and closed (░) items:"))
+		for _, day := range days {
+			fmt.Printf("  %s %3d %s%s\n", day.Date.Format("2006-01-02"), day.Open,
+				strings.Repeat("█", day.Open), strings.Repeat("░", day.Closed))
+		}
+	}
+}
+
+// truncateToDay returns the start of t's day in UTC.
+func truncateToDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
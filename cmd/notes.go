@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+
+	"github.com/spf13/cobra"
+)
+
+// notesLookbackDays is how long before the tag PRs may have been opened and still be merged after it.
+const notesLookbackDays = 90
+
+var notesFromTag string
+var notesOut string
+
+var notesCmd = &cobra.Command{
+	Use:   "notes --from-tag <tag>",
+	Short: "Draft release notes from the PRs merged since a tag",
+	Long: `List the PRs merged into the default branch since a tag, grouped by conventional-commit type
+(from the title prefix, or labels such as bug/enhancement/documentation), as CHANGELOG-ready Markdown.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runNotes()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(notesCmd)
+	notesCmd.Flags().StringVar(&notesFromTag, "from-tag", "", "List PRs merged after this tag (required)")
+	notesCmd.Flags().StringVar(&notesOut, "out", "", "Write the Markdown to this file instead of stdout")
+	notesCmd.MarkFlagRequired("from-tag")
+}
+
+func runNotes() {
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	tags, ok := p.(provider.TagFetcher)
+	if !ok {
+		exitWithError("Error", fmt.Errorf("the %s provider does not support the notes command", p.Name()))
+	}
+
+	tagDate, err := tags.FetchTagDate(repo, notesFromTag)
+	if err != nil {
+		exitWithError("Error resolving tag", err)
+	}
+
+	prs, err := p.FetchPullRequests(repo, tagDate.AddDate(0, 0, -notesLookbackDays).Format("2006-01-02"), "", "", label, false)
+	if err != nil {
+		exitWithError("Error fetching pull requests", err)
+	}
+
+	base, err := p.DefaultBranch(repo)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %s: %v\n", i18n.T("Could not determine the default branch, including PRs into any branch"), err)
+	}
+	var released []github.PullRequest
+	for _, pr := range prs {
+		if pr.Merged && pr.MergedAt.After(tagDate) && (base == "" || pr.BaseRefName == base) {
+			released = append(released, pr)
+		}
+	}
+
+	notes := formatReleaseNotes(notesFromTag, time.Now(), stats.BuildReleaseNotes(released, prTypeRules()))
+	if notesOut == "" {
+		fmt.Print(notes)
+		return
+	}
+	if err := os.WriteFile(notesOut, []byte(notes), 0644); err != nil {
+		exitWithError("Error writing release notes", err)
+	}
+	fmt.Printf(i18n.Sprintf("📝 Release notes for %d PRs written to %s\n", len(released), notesOut))
+}
+
+// formatReleaseNotes renders the sections as a CHANGELOG entry: one "###" heading per section and
+// one bullet per PR with its scope, subject, number and author.
+func formatReleaseNotes(fromTag string, date time.Time, sections []stats.ReleaseNoteSection) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Unreleased (changes since %s, %s)\n", fromTag, date.Format("2006-01-02"))
+	if len(sections) == 0 {
+		b.WriteString("\nNo merged pull requests.\n")
+		return b.String()
+	}
+	for _, s := range sections {
+		fmt.Fprintf(&b, "\n### %s\n\n", s.Title)
+		for _, n := range s.Notes {
+			b.WriteString("- ")
+			if n.Scope != "" {
+				fmt.Fprintf(&b, "**%s:** ", n.Scope)
+			}
+			fmt.Fprintf(&b, "%s (#%d)", n.Subject, n.PR.Number)
+			if n.PR.Author.Login != "" && !github.IsBotLogin(n.PR.Author.Login) {
+				fmt.Fprintf(&b, " @%s", n.PR.Author.Login)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
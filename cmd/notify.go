@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"visuche/internal/i18n"
+	"visuche/internal/notify"
+	"visuche/internal/stats"
+)
+
+var notifyOutput bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&notifyOutput, "notify", false, "Send a summary to the webhooks listed under \"notifications\" in the config (slack, teams, discord)")
+}
+
+// notificationDestinations converts the configured webhooks.
+func notificationDestinations() []notify.Destination {
+	var destinations []notify.Destination
+	for _, n := range cfg.Notifications {
+		destinations = append(destinations, notify.Destination{Type: n.Type, URL: n.URL})
+	}
+	return destinations
+}
+
+// validateNotify checks the configured webhooks before any data is fetched.
+func validateNotify() error {
+	if !notifyOutput {
+		return nil
+	}
+	destinations := notificationDestinations()
+	if len(destinations) == 0 {
+		return fmt.Errorf("--notify requires a \"notifications\" list in the config file")
+	}
+	for _, d := range destinations {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportPeriod describes the analyzed date range.
+func reportPeriod() string {
+	switch {
+	case since != "" && until != "":
+		return fmt.Sprintf("%s – %s", since, until)
+	case since != "":
+		return i18n.Sprintf("since %s", since)
+	case until != "":
+		return i18n.Sprintf("until %s", until)
+	default:
+		return i18n.T("all time")
+	}
+}
+
+// buildRunSummary is the common summary shared by every notification format.
+func buildRunSummary(s stats.Stats) notify.Summary {
+	return notify.Summary{
+		Title: i18n.Sprintf("visuche report: %s", repo),
+		Text:  reportPeriod(),
+		Fields: []notify.Field{
+			{Label: i18n.T("PRs (merged)"), Value: fmt.Sprintf("%d (%d)", s.TotalPRs, s.MergedPRs)},
+			{Label: i18n.T("Lead Time (median)"), Value: formatDuration(s.MedianLeadTime)},
+			{Label: i18n.T("First Review (median)"), Value: formatDuration(s.MedianTimeToFirstReview)},
+			{Label: i18n.T("Review Stage (median)"), Value: formatDuration(s.MedianReviewStageTime)},
+			{Label: i18n.T("Approval→Merge (median)"), Value: formatDuration(s.MedianApprovalToMerge)},
+			{Label: i18n.T("Releases / week"), Value: fmt.Sprintf("%.1f", s.ReleasesPerWeek)},
+			{Label: i18n.T("Self-merge"), Value: fmt.Sprintf("%.1f%%", s.SelfMergeRate)},
+			{Label: i18n.T("Reopen Rate"), Value: fmt.Sprintf("%.1f%%", s.ReopenRate)},
+		},
+	}
+}
+
+// runNotify delivers the run summary to every configured webhook. Failures are reported but don't fail the run.
+func runNotify(s stats.Stats) {
+	if !notifyOutput {
+		return
+	}
+	summary := buildRunSummary(s)
+	for _, d := range notificationDestinations() {
+		if err := notify.Send(d, summary); err != nil {
+			fmt.Printf("⚠️  %s notification failed: %v\n", d.Type, err)
+			continue
+		}
+		fmt.Printf("🔔 Sent summary to %s\n", d.Type)
+	}
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var busFactorReport bool
+var busFactorDepth int
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&busFactorReport, "bus-factor", false, "Report per-directory contributor concentration (one extra API call per merged PR)")
+	rootCmd.PersistentFlags().IntVar(&busFactorDepth, "bus-factor-depth", 2, "Directory depth used to group files into components for --bus-factor")
+}
+
+// needsChangedFiles reports whether any requested report needs the files changed by each PR.
+func needsChangedFiles() bool {
+	return busFactorReport || reworkReport || sizeCorrelationReport
+}
+
+// runOwnershipReport displays the bus-factor report when --bus-factor is set.
+func runOwnershipReport(prs []github.PullRequest) {
+	if !busFactorReport {
+		return
+	}
+	displayOwnershipReport(stats.CalculateOwnership(prs, busFactorDepth))
+}
+
+// displayOwnershipReport displays knowledge concentration per component, riskiest first.
+func displayOwnershipReport(report stats.OwnershipReport) {
+	fmt.Println("\n" + i18n.T("🚌 Bus Factor by Directory:"))
+	if len(report.Components) == 0 {
+		fmt.Println(i18n.T("No changed-file data available for merged PRs"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Directory"), i18n.T("Bus Factor"), i18n.T("Top Author (share)"), i18n.T("Contributors"), i18n.T("PRs"), i18n.T("Changed Lines")})
+	table.SetBorder(true)
+	const maxRows = 15
+	for i, c := range report.Components {
+		if i >= maxRows {
+			break
+		}
+		table.Append([]string{
+			c.Path,
+			fmt.Sprintf("%d", c.BusFactor),
+			fmt.Sprintf("%s (%.0f%%)", c.TopAuthor, c.TopShare),
+			fmt.Sprintf("%d", c.Contributors),
+			fmt.Sprintf("%d", c.PRs),
+			fmt.Sprintf("%d", c.ChangedLines),
+		})
+	}
+	table.Render()
+
+	if len(report.Components) > maxRows {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(report.Components)-maxRows))
+	}
+	fmt.Println(i18n.Sprintf("⚠️  %d of %d directories have bus factor 1 (one author wrote more than half of the changes)", report.BusFactorOne, len(report.Components)))
+}
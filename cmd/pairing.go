@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayPairingReport shows pairing/mobbing frequency and how co-authored PRs are reviewed compared to solo PRs.
+func displayPairingReport(prs []github.PullRequest) {
+	report := stats.CalculatePairingReport(prs)
+	if report.AnalyzedPRs == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🤝 Pairing & Co-authorship:"))
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("Co-authored PRs"), fmt.Sprintf("%d / %d (%.1f%%)", report.PairedPRs+report.MobbedPRs, report.AnalyzedPRs, report.CoAuthoredRate)})
+	summaryTable.Append([]string{i18n.T("Pairing (2 people)"), fmt.Sprintf("%d", report.PairedPRs)})
+	summaryTable.Append([]string{i18n.T("Mobbing (3+ people)"), fmt.Sprintf("%d", report.MobbedPRs)})
+	summaryTable.Render()
+
+	if report.CoAuthored.PRs == 0 {
+		return
+	}
+
+	compareTable := tablewriter.NewWriter(os.Stdout)
+	compareTable.SetHeader([]string{"", i18n.T("PRs"), i18n.T("Time to First Review (median)"), i18n.T("Review Stage (median)"), i18n.T("Lead Time (median)"), i18n.T("Reviews/PR")})
+	compareTable.SetBorder(true)
+	for _, row := range []struct {
+		label string
+		group stats.CollaborationGroup
+	}{
+		{i18n.T("Co-authored"), report.CoAuthored},
+		{i18n.T("Solo"), report.Solo},
+	} {
+		compareTable.Append([]string{
+			row.label,
+			fmt.Sprintf("%d", row.group.PRs),
+			formatDuration(row.group.MedianTimeToReview),
+			formatDuration(row.group.MedianReviewStage),
+			formatDuration(row.group.MedianLeadTime),
+			fmt.Sprintf("%.1f", row.group.AverageReviewRounds),
+		})
+	}
+	compareTable.Render()
+
+	if len(report.TopCoAuthors) > 0 {
+		fmt.Println(i18n.T("Most frequent co-authors:"))
+		for _, c := range report.TopCoAuthors {
+			fmt.Printf(i18n.Sprintf("  %s: %d PRs\n", c.Name, c.PRs))
+		}
+	}
+}
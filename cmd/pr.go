@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/mermaid"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var prCmd = &cobra.Command{
+	Use:   "pr <number>",
+	Short: "Show the timeline and stage durations of one pull request",
+	Long: `Drill down into a single pull request: a timeline of its opening, commits, review requests,
+reviews, comments, approval, merge and the CI runs of its branch, followed by its cycle-time stages.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		number, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+		if err != nil || number <= 0 {
+			exitWithError("Error", fmt.Errorf("invalid PR number %q", args[0]))
+		}
+		runPRDetail(number)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(prCmd)
+}
+
+// prDetail is everything the drill-down shows for one PR.
+type prDetail struct {
+	PR       github.PullRequest
+	Timeline []github.TimelineEvent
+	Runs     []actions.WorkflowRun // CI runs of the PR's head branch while it was open
+}
+
+func runPRDetail(number int) {
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	detail, err := fetchPRDetail(p, number)
+	if err != nil {
+		exitWithError("Error fetching pull request", err)
+	}
+	displayPRDetail(detail)
+	if prGantt {
+		fmt.Println("\n" + i18n.T("🗓️  Stages (Mermaid gantt):"))
+		printMermaid(mermaid.PRGantt(fmt.Sprintf("#%d", number), []github.PullRequest{detail.PR}))
+	}
+}
+
+// fetchPRDetail fetches the PR, its timeline and the CI runs of its branch. Missing timeline events
+// and runs only produce warnings.
+func fetchPRDetail(p provider.Provider, number int) (prDetail, error) {
+	fetcher, ok := p.(provider.PRDetailFetcher)
+	if !ok {
+		return prDetail{}, fmt.Errorf("the %s provider does not support the pr command", p.Name())
+	}
+
+	pr, err := fetcher.FetchPullRequest(repo, number)
+	if err != nil {
+		return prDetail{}, err
+	}
+
+	events, err := fetcher.FetchTimelineEvents(repo, number)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch the PR timeline: %v\n", err)
+	}
+	for _, event := range events {
+		if event.Kind == github.EventReadyForReview && event.Time.After(pr.ReadyForReviewAt) {
+			pr.ReadyForReviewAt = event.Time
+		}
+	}
+	pr = stats.CalculateCycleStages([]github.PullRequest{pr})[0]
+
+	detail := prDetail{PR: pr}
+	end := prEnd(pr)
+	runs, err := p.FetchWorkflowRuns(repo, pr.CreatedAt.Format("2006-01-02"), end.Format("2006-01-02"))
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch workflow runs: %v\n", err)
+	}
+	for _, run := range runs {
+		if run.HeadBranch != pr.HeadRefName || run.CreatedAt.Before(pr.CreatedAt) || run.CreatedAt.After(end) {
+			continue
+		}
+		detail.Runs = append(detail.Runs, run)
+		result := run.Conclusion
+		if result == "" {
+			result = run.Status
+		}
+		events = append(events, github.TimelineEvent{
+			Time:   run.CreatedAt,
+			Kind:   github.EventCIRun,
+			Actor:  run.Event,
+			Detail: fmt.Sprintf("%s: %s (%s)", run.WorkflowName, result, formatDuration(run.UpdatedAt.Sub(run.StartedAt))),
+		})
+	}
+
+	detail.Timeline = github.BuildTimeline(pr, events)
+	return detail, nil
+}
+
+// prEnd returns when the PR stopped being open: its merge or close time, or now.
+func prEnd(pr github.PullRequest) time.Time {
+	switch {
+	case pr.Merged && !pr.MergedAt.IsZero():
+		return pr.MergedAt
+	case !pr.ClosedAt.IsZero():
+		return pr.ClosedAt
+	default:
+		return time.Now()
+	}
+}
+
+// timelineLabels names the timeline event kinds.
+var timelineLabels = map[string]string{
+	github.EventOpened:           "Opened",
+	github.EventCommitted:        "Commit",
+	github.EventReviewRequested:  "Review requested",
+	github.EventReviewed:         "Review",
+	github.EventCommented:        "Comment",
+	github.EventReadyForReview:   "Ready for review",
+	github.EventConvertedToDraft: "Converted to draft",
+	github.EventForcePushed:      "Force-pushed",
+	github.EventReopened:         "Reopened",
+	github.EventMerged:           "Merged",
+	github.EventClosed:           "Closed",
+	github.EventCIRun:            "CI run",
+}
+
+func displayPRDetail(d prDetail) {
+	pr := d.PR
+	state := strings.ToLower(pr.State)
+	if pr.IsDraft && pr.State == "OPEN" {
+		state = "draft"
+	}
+	fmt.Printf("\n#%d %s\n", pr.Number, pr.Title)
+	fmt.Printf(i18n.Sprintf("👤 %s · %s → %s · %s · +%d −%d in %d files\n",
+		pr.Author.Login, pr.HeadRefName, pr.BaseRefName, i18n.T(state), pr.Additions, pr.Deletions, pr.ChangedFiles))
+
+	fmt.Println("\n" + i18n.T("🕒 Timeline:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Time"), i18n.T("Since Open"), i18n.T("Event"), i18n.T("Who"), i18n.T("Detail")})
+	table.SetBorder(true)
+	for _, event := range d.Timeline {
+		label := timelineLabels[event.Kind]
+		if label == "" {
+			label = event.Kind
+		}
+		table.Append([]string{
+			event.Time.Local().Format("2006-01-02 15:04"),
+			formatSignedDuration(event.Time.Sub(pr.CreatedAt)),
+			i18n.T(label),
+			event.Actor,
+			event.Detail,
+		})
+	}
+	table.Render()
+
+	fmt.Println("\n" + i18n.T("🔄 Stages:"))
+	stageTable := tablewriter.NewWriter(os.Stdout)
+	stageTable.SetHeader([]string{i18n.T("Stage"), i18n.T("Duration")})
+	stageTable.SetBorder(true)
+	stageTable.Append([]string{i18n.T("Coding (first commit→open)"), formatDuration(pr.CodingTime)})
+	stageTable.Append([]string{i18n.T("Pickup (open→first review)"), formatDuration(pr.PickupTime)})
+	stageTable.Append([]string{i18n.T("Review (first review→approval)"), formatDuration(pr.ReviewTime)})
+	if pr.Merged {
+		stageTable.Append([]string{i18n.T("Merge (approval→merge)"), formatDuration(pr.MergeTime)})
+		stageTable.Append([]string{i18n.T("Lead Time"), formatDuration(pr.LeadTime)})
+	} else {
+		stageTable.Append([]string{i18n.T("Open For"), formatDuration(prEnd(pr).Sub(pr.CreatedAt))})
+	}
+	stageTable.Render()
+
+	if len(d.Runs) > 0 {
+		var failed int
+		var minutes float64
+		for _, run := range d.Runs {
+			if run.Conclusion == "failure" || run.Conclusion == "timed_out" {
+				failed++
+			}
+			if !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+				minutes += run.UpdatedAt.Sub(run.StartedAt).Minutes()
+			}
+		}
+		fmt.Printf(i18n.Sprintf("🔧 CI: %d runs (%d failed), %.0f CI minutes\n", len(d.Runs), failed, minutes))
+	}
+}
+
+// formatSignedDuration formats d like formatDuration, prefixing events before the PR was opened
+// (such as early commits) with a minus sign.
+func formatSignedDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + formatDuration(-d)
+	}
+	return formatDuration(d)
+}
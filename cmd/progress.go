@@ -0,0 +1,14 @@
+package cmd
+
+import "visuche/internal/progress"
+
+var progressFormat string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&progressFormat, "progress", "", "Write machine-readable progress events to stderr: json (one JSON object per line with stage, items done, total and ETA)")
+}
+
+// configureProgress applies --progress to every stage that reports progress.
+func configureProgress() error {
+	return progress.SetFormat(progressFormat)
+}
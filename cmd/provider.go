@@ -0,0 +1,325 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/git"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/json"
+	"visuche/internal/metadata"
+	"visuche/internal/progress"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+	"visuche/internal/transport"
+)
+
+var providerName string
+var fixturesDir string
+var remoteName string
+var excludeDraftTime bool
+var recordDir string
+var replayDir string
+var maxConcurrency int
+var rateLimit int
+
+// newProvider builds the backend used by the commands. It is a variable so that
+// alternative implementations (e.g. the fixture-backed mock) can be injected.
+var newProvider = func() (provider.Provider, error) {
+	if providerName == "store" {
+		return provider.New(providerName, localStore().Dir)
+	}
+	if providerName == "github" {
+		return provider.GitHub{Remote: remoteName}, nil
+	}
+	return provider.New(providerName, fixturesDir)
+}
+
+// analysisTime is the time open PRs are aged at. It is a variable so that the golden tests can pin it.
+var analysisTime = time.Now
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&providerName, "provider", "github", "Repository hosting provider (github/gitlab/mock/store)")
+	rootCmd.PersistentFlags().StringVar(&fixturesDir, "fixtures", "", "Fixture directory for the mock provider")
+	rootCmd.PersistentFlags().BoolVar(&excludeDraftTime, "exclude-draft-time", false, "Measure lead/review time from ready-for-review instead of creation")
+	rootCmd.PersistentFlags().StringVar(&remoteName, "remote", "", "Git remote to detect the repository from (default: upstream, then origin)")
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "", "Record every gh/glab response to this directory")
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "", "Serve gh/glab responses from a --record directory instead of the network")
+	rootCmd.PersistentFlags().IntVar(&maxConcurrency, "max-concurrency", transport.DefaultMaxConcurrency, "Maximum gh/glab calls in flight at once, shared by all repositories")
+	rootCmd.PersistentFlags().IntVar(&rateLimit, "rate-limit", 0, "Maximum gh/glab requests per minute, each page of a paginated call counting as one, shared by all repositories (0 = no limit)")
+}
+
+// configureTransport applies --record/--replay and the shared concurrency and rate budget to every gh/glab call.
+func configureTransport() error {
+	if maxConcurrency < 1 {
+		return fmt.Errorf("--max-concurrency must be at least 1")
+	}
+	if rateLimit < 0 {
+		return fmt.Errorf("--rate-limit must not be negative")
+	}
+	transport.SetLimits(maxConcurrency, rateLimit)
+	transport.SetAuthRoutes(authRoutes())
+
+	switch {
+	case recordDir != "" && replayDir != "":
+		return fmt.Errorf("--record and --replay cannot be used together")
+	case recordDir != "":
+		return transport.SetMode(transport.Record, recordDir)
+	case replayDir != "":
+		return transport.SetMode(transport.Replay, replayDir)
+	}
+	return nil
+}
+
+// authRoutes builds the transport routes of the "auth" config entries, reading their token variables.
+func authRoutes() []transport.AuthRoute {
+	var routes []transport.AuthRoute
+	for _, a := range cfg.Auth {
+		route := transport.AuthRoute{Owner: a.Owner, Host: a.Host, Tokens: append([]string(nil), a.Tokens...)}
+		if route.Owner == "" {
+			route.Owner = "*"
+		}
+		for _, name := range a.TokenEnv {
+			if token := os.Getenv(name); token != "" {
+				route.Tokens = append(route.Tokens, token)
+			} else {
+				fmt.Printf("⚠️  %s is not set; skipping it for %s\n", name, route.Owner)
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// detectRepoFromRemote detects the repository path from the git remote for the selected provider.
+func detectRepoFromRemote() (string, error) {
+	if providerName == "gitlab" {
+		return git.GetGitLabProjectFromGitRemote(remoteName)
+	}
+	return git.GetRepoFromGitRemote(remoteName)
+}
+
+// detectRemoteCandidates lists every git remote pointing at the selected provider, preferred first.
+func detectRemoteCandidates() ([]git.Remote, error) {
+	if providerName == "gitlab" {
+		return git.ListGitLabRemotes()
+	}
+	return git.ListGitHubRemotes()
+}
+
+// validateRepoInput validates a manually entered repository path for the selected provider.
+func validateRepoInput(input string) error {
+	parts := strings.Split(strings.TrimSpace(input), "/")
+	if providerName == "gitlab" {
+		// GitLab projects may live in nested subgroups (group/subgroup/project)
+		if len(parts) < 2 {
+			return fmt.Errorf("invalid format, please use 'group/project'")
+		}
+		return nil
+	}
+	if len(parts) != 2 || strings.TrimSpace(input) == "" {
+		return fmt.Errorf("invalid format, please use 'owner/repo'")
+	}
+	return nil
+}
+
+// prAnalysis is the result of the PR pipeline.
+type prAnalysis struct {
+	PRs             []github.PullRequest // Human PRs, used for all PR metrics
+	Stats           stats.Stats
+	DependencyPRs   []github.PullRequest // Dependabot/Renovate PRs, reported separately
+	DependencyStats stats.DependencyStats
+}
+
+// cachedPRs are all PRs of a repository and period, fetched before the analysis (for the interactive
+// author and label pickers) so that the analysis doesn't fetch them again.
+type cachedPRs struct {
+	repo, since, until string
+	prs                []github.PullRequest
+}
+
+var pickerPRs cachedPRs
+
+// takeCachedPRs returns the cached PRs of repo and the period written by author (all when empty) and
+// clears the cache, so later fetches see fresh data.
+func takeCachedPRs(repo, since, until, author string) ([]github.PullRequest, bool) {
+	cached := pickerPRs
+	pickerPRs = cachedPRs{}
+	if cached.prs == nil || cached.repo != repo || cached.since != since || cached.until != until || author == "@me" {
+		return nil, false
+	}
+	var prs []github.PullRequest
+	for _, pr := range cached.prs {
+		if author == "" || strings.EqualFold(pr.Author.Login, author) {
+			prs = append(prs, pr)
+		}
+	}
+	return prs, true
+}
+
+// fetchPullRequests fetches PRs from p, reporting them to --progress and, with --export jsonl, writing
+// each to a JSON Lines file as its page arrives (before any enrichment) when the provider can stream.
+func fetchPullRequests(p provider.PRProvider, repo string, since, until, author, label string) ([]github.PullRequest, error) {
+	stage := progress.Start("fetch_pull_requests", 0)
+	defer stage.End()
+
+	var lines *json.LinesWriter
+	filename := fmt.Sprintf("visuche_%s.jsonl", strings.ReplaceAll(repo, "/", "-"))
+	if exporting("jsonl") {
+		w, err := json.CreateLines(filename, exportMetadata(metadata.PRStream))
+		if err != nil {
+			return nil, err
+		}
+		defer w.Close()
+		lines = w
+	}
+
+	var writeErr error
+	emit := func(pr github.PullRequest) {
+		stage.Add(1)
+		if lines != nil && writeErr == nil {
+			writeErr = lines.WritePullRequest(pr)
+		}
+	}
+	var prs []github.PullRequest
+	var err error
+	if cached, ok := takeCachedPRs(repo, since, until, author); ok {
+		prs = cached
+		for _, pr := range prs {
+			emit(pr)
+		}
+	} else if streamer, ok := p.(provider.PullRequestStreamer); ok {
+		prs, err = streamer.StreamPullRequests(repo, since, until, author, label, true, emit)
+	} else {
+		prs, err = p.FetchPullRequests(repo, since, until, author, label, true)
+		for _, pr := range prs {
+			emit(pr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if writeErr != nil {
+		return nil, writeErr
+	}
+	if lines != nil {
+		fmt.Printf("📁 JSON Lines output: %s (%d PRs)\n", filename, lines.Count)
+	}
+
+	// Check --label client-side too: not every provider filters by label (fixtures, the local store)
+	if label != "" {
+		matching := make([]github.PullRequest, 0, len(prs))
+		for _, pr := range prs {
+			if pr.HasLabel(label) {
+				matching = append(matching, pr)
+			}
+		}
+		if dropped := len(prs) - len(matching); dropped > 0 {
+			fmt.Println(i18n.Sprintf("⚠️  %d PRs without the label %q were dropped", dropped, label))
+		}
+		prs = matching
+	}
+	return prs, nil
+}
+
+// analyzePullRequests runs the fetch → enrichment → lead time → stats pipeline against p.
+func analyzePullRequests(p provider.PRProvider, repo string, since, until, author, label string) (prAnalysis, error) {
+	prs, err := fetchPullRequests(p, repo, since, until, author, label)
+	if err != nil {
+		return prAnalysis{}, err
+	}
+
+	// Keep bots out of the human metrics; dependency updates get their own report
+	prs, botPRs := github.SplitBotPRs(prs)
+	prs = github.DropBotReviews(prs)
+	var dependencyPRs []github.PullRequest
+	for _, pr := range botPRs {
+		if pr.IsDependencyUpdate() {
+			dependencyPRs = append(dependencyPRs, pr)
+		}
+	}
+	if fetcher, ok := p.(provider.AutoMergeFetcher); ok && len(dependencyPRs) > 0 {
+		dependencyPRs = fetcher.FetchAutoMergeEvents(repo, dependencyPRs)
+	}
+
+	// Fetch comment timing and reopen data
+	processedPRs := p.EnrichPullRequests(repo, prs)
+
+	// Resolve the issues merged PRs close for end-to-end traceability
+	if fetcher, ok := p.(provider.LinkedIssueFetcher); ok {
+		processedPRs = fetcher.FetchLinkedIssues(repo, processedPRs)
+	}
+
+	// Changed files are only needed by the file-based reports (extra API call per PR)
+	if needsChangedFiles() {
+		if fetcher, ok := p.(provider.ChangedFilesFetcher); ok {
+			processedPRs = fetcher.FetchChangedFiles(repo, processedPRs)
+		}
+	}
+
+	// Requested reviewer history is only needed by --approval-by-reviewers (extra GraphQL queries)
+	if approvalByReviewers {
+		if fetcher, ok := p.(provider.RequestedReviewersFetcher); ok {
+			processedPRs = fetcher.FetchRequestedReviewers(repo, processedPRs)
+		}
+	}
+
+	// Size of the commit each merged PR landed as is only needed by --landed-size (extra GraphQL queries)
+	if landedSizeReport {
+		if fetcher, ok := p.(provider.MergeCommitFetcher); ok {
+			processedPRs = fetcher.FetchMergeCommits(repo, processedPRs)
+		}
+	}
+
+	// Review comment text is only needed by --comment-categories and --suggestions (extra GraphQL queries)
+	if needsReviewComments() {
+		if fetcher, ok := p.(provider.ReviewCommentFetcher); ok {
+			processedPRs = fetcher.FetchReviewComments(repo, processedPRs)
+		}
+	}
+
+	// Maintainer responses on PRs from forks are only needed by the maintainer response SLO (extra GraphQL queries)
+	if _, ok, _ := maintainerResponseTarget(); ok {
+		if fetcher, ok := p.(provider.MaintainerResponseFetcher); ok {
+			processedPRs = fetcher.FetchMaintainerResponses(repo, processedPRs)
+		} else {
+			fmt.Println("⚠️  The maintainer response SLO is not supported by this provider")
+		}
+	}
+
+	// Measure from ready-for-review instead of creation when requested
+	if excludeDraftTime {
+		if fetcher, ok := p.(provider.ReadyForReviewFetcher); ok {
+			processedPRs = fetcher.FetchReadyForReviewTimes(repo, processedPRs)
+		} else {
+			fmt.Println("⚠️  --exclude-draft-time is not supported by this provider; measuring from creation")
+		}
+	}
+
+	// Calculate lead times
+	processedPRs = CalculateLeadTimes(processedPRs)
+
+	// Split lead time into coding/pickup/review/merge stages
+	processedPRs = stats.CalculateCycleStages(processedPRs)
+
+	// Release/WIP heuristics key off the default branch; fall back to main/master when unknown
+	defaultBranch, err := p.DefaultBranch(repo)
+	if err != nil {
+		fmt.Printf("⚠️  Could not detect default branch, assuming main/master: %v\n", err)
+		defaultBranch = ""
+	}
+
+	statistics, err := stats.CalculateMetrics(processedPRs, defaultBranch, analysisTime(), selectedMetrics)
+	if err != nil {
+		return prAnalysis{}, err
+	}
+
+	return prAnalysis{
+		PRs:             processedPRs,
+		Stats:           statistics,
+		DependencyPRs:   dependencyPRs,
+		DependencyStats: stats.CalculateDependencyStats(dependencyPRs, analysisTime()),
+	}, nil
+}
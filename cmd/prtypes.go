@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// prTypeRules compiles the custom PR type patterns from the config, skipping invalid ones with a warning.
+func prTypeRules() []stats.PRTypeRule {
+	var rules []stats.PRTypeRule
+	for _, c := range cfg.PRTypes {
+		if c.Pattern == "" || c.Type == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Ignoring invalid prTypes pattern %q: %v\n", c.Pattern, err)
+			continue
+		}
+		rules = append(rules, stats.PRTypeRule{Pattern: pattern, Type: strings.ToLower(c.Type)})
+	}
+	return rules
+}
+
+// displayPRTypeReport shows the distribution of PR title types and how their lead times compare.
+// Nothing is shown when no PR title carries a type.
+func displayPRTypeReport(prs []github.PullRequest) {
+	report := stats.CalculatePRTypeReport(prs, prTypeRules())
+	if report.Classified == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🏷️  PR Types (from titles):"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Type"), i18n.T("PRs"), i18n.T("Share"), i18n.T("Merged"), i18n.T("Median Lead Time"), i18n.T("vs. Overall"), i18n.T("Average Size")})
+	table.SetBorder(true)
+	for _, t := range report.Types {
+		name := t.Type
+		if name == stats.UnclassifiedPRType {
+			name = i18n.T("(no type)")
+		}
+		vsOverall := "-"
+		if t.LeadTimeVsOverall > 0 {
+			vsOverall = fmt.Sprintf("%.2f×", t.LeadTimeVsOverall)
+		}
+		table.Append([]string{
+			name,
+			fmt.Sprintf("%d", t.PRs),
+			fmt.Sprintf("%.1f%%", t.Share),
+			fmt.Sprintf("%d", t.Merged),
+			formatDuration(t.MedianLeadTime),
+			vsOverall,
+			fmt.Sprintf("%.0f", t.AverageSize),
+		})
+	}
+	table.Render()
+	fmt.Println(i18n.Sprintf("💡 %d of %d PRs have a typed title; overall median lead time %s. Custom prefixes can be mapped with prTypes in the config.",
+		report.Classified, report.Total, formatDuration(report.MedianLeadTime)))
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+	"visuche/internal/i18n"
+	"visuche/internal/store"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	pruneRawMonths     int
+	pruneHistoryMonths int
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete stored data older than the retention policy",
+	Long: `Apply the retention policy of the "retention" config (or the flags) to the local data:
+
+  --raw-months      PRs closed and workflow runs started longer ago are removed from the collect store
+  --history-months  run history snapshots recorded longer ago are deleted
+
+0 keeps the data forever, so by default raw PR rows age out while the aggregated run history stays.
+visuche collect applies the raw retention on start and once a day.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runPrune()
+	},
+}
+
+func init() {
+	pruneCmd.Flags().IntVar(&pruneRawMonths, "raw-months", 0, "Keep raw PRs and workflow runs this many months (default: retention.rawMonths)")
+	pruneCmd.Flags().IntVar(&pruneHistoryMonths, "history-months", 0, "Keep run history this many months (default: retention.historyMonths)")
+	rootCmd.AddCommand(pruneCmd)
+}
+
+func runPrune() {
+	rawMonths, historyMonths := cfg.Retention.RawMonths, cfg.Retention.HistoryMonths
+	if pruneRawMonths > 0 {
+		rawMonths = pruneRawMonths
+	}
+	if pruneHistoryMonths > 0 {
+		historyMonths = pruneHistoryMonths
+	}
+	if rawMonths <= 0 && historyMonths <= 0 {
+		exitWithError("Error", fmt.Errorf("no retention configured; set retention.rawMonths/historyMonths or pass --raw-months/--history-months"))
+	}
+
+	now := time.Now()
+	if rawMonths > 0 {
+		st := localStore()
+		prs, runs, err := pruneStore(st, now.AddDate(0, -rawMonths, 0))
+		if err != nil {
+			exitWithError("Error pruning store", err)
+		}
+		fmt.Printf(i18n.Sprintf("🧹 Store (%s): removed %d PRs and %d workflow runs older than %d months\n", st.Dir, prs, runs, rawMonths))
+	}
+	if historyMonths > 0 {
+		backend, err := historyStore()
+		if err != nil {
+			exitWithError("Error opening run history", err)
+		}
+		defer backend.Close()
+		n, err := backend.Prune(now.AddDate(0, -historyMonths, 0))
+		if err != nil {
+			exitWithError("Error pruning run history", err)
+		}
+		fmt.Printf(i18n.Sprintf("🧹 Run history: removed %d snapshots older than %d months\n", n, historyMonths))
+	}
+}
+
+// pruneStore removes the data recorded before cutoff from every repository of st.
+func pruneStore(st *store.Store, cutoff time.Time) (prs, runs int, err error) {
+	repos, err := st.Repos()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, r := range repos {
+		p, n, err := st.Prune(r, cutoff)
+		prs += p
+		runs += n
+		if err != nil {
+			return prs, runs, fmt.Errorf("%s: %w", r, err)
+		}
+	}
+	return prs, runs, nil
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/datadog"
+	"visuche/internal/github"
+	"visuche/internal/metrics"
+	"visuche/internal/otlp"
+	"visuche/internal/stats"
+	"visuche/internal/transport"
+	"visuche/internal/warehouse"
+)
+
+var pushTargets []string
+
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&pushTargets, "push", nil, "Push computed metrics after the run (otlp: OpenTelemetry collector configured via OTEL_* env vars, datadog: Datadog metrics API, bigquery:dataset.table, postgres:table)")
+}
+
+// validatePush checks the --push targets before any data is fetched.
+func validatePush() error {
+	for _, target := range pushTargets {
+		kind, dest := splitPushTarget(target)
+		switch kind {
+		case "otlp":
+			if _, err := otlp.FromEnv(); err != nil {
+				return err
+			}
+		case "datadog":
+			if _, err := newDatadogClient(); err != nil {
+				return err
+			}
+		case "bigquery":
+			if _, _, _, err := warehouse.ParseBigQueryTable(dest, bigQueryProject()); err != nil {
+				return err
+			}
+		case "postgres":
+			if _, err := warehouse.NewPostgres(dest, postgresDSN()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported --push target %q (supported: otlp, datadog, bigquery:dataset.table, postgres:table)", target)
+		}
+	}
+	return nil
+}
+
+// runPRPush pushes repository-wide PR metrics, plus one series per team when teams are configured.
+func runPRPush(prs []github.PullRequest, s stats.Stats) {
+	if len(pushTargets) == 0 {
+		return
+	}
+	labels := map[string]string{"repo": repo}
+	gauges := metrics.PRGauges(s, labels)
+	if len(cfg.Teams) > 0 {
+		for _, g := range stats.CalculateGroupStats(prs, cfg.TeamOf, s.DefaultBranch, analysisTime()) {
+			gauges = append(gauges, metrics.PRGauges(g.Stats, metrics.WithLabel(labels, "team", g.Name))...)
+		}
+	}
+	pushGauges(gauges)
+}
+
+// runCIPush pushes workflow run metrics for the repository.
+func runCIPush(analytics actions.WorkflowAnalytics) {
+	if len(pushTargets) == 0 {
+		return
+	}
+	pushGauges(metrics.CIGauges(analytics, map[string]string{"repo": repo}))
+}
+
+// pushGauges sends gauges to every --push target. Failures are reported but don't fail the run.
+func pushGauges(gauges []metrics.Gauge) {
+	for _, target := range pushTargets {
+		var err error
+		kind, dest := splitPushTarget(target)
+		switch kind {
+		case "otlp":
+			var exporter *otlp.Exporter
+			if exporter, err = otlp.FromEnv(); err == nil {
+				err = exporter.Export(gauges)
+			}
+		case "datadog":
+			var client *datadog.Client
+			if client, err = newDatadogClient(); err == nil {
+				err = client.Submit(gauges)
+			}
+		case "bigquery":
+			var client *warehouse.BigQuery
+			if client, err = warehouse.NewBigQuery(dest, bigQueryProject(), bigQueryToken()); err == nil {
+				err = client.Insert(warehouse.Rows(currentWarehouseRun(), gauges))
+			}
+		case "postgres":
+			var client *warehouse.Postgres
+			if client, err = warehouse.NewPostgres(dest, postgresDSN()); err == nil {
+				err = client.Insert(warehouse.Rows(currentWarehouseRun(), gauges))
+			}
+		}
+		if err != nil {
+			fmt.Printf("⚠️  Pushing metrics to %s failed: %v\n", target, err)
+			continue
+		}
+		fmt.Printf("📤 Pushed %d metrics to %s\n", len(gauges), target)
+	}
+}
+
+// newDatadogClient builds a Datadog client from the config; DD_API_KEY and DD_SITE override it and DD_TAGS adds tags.
+func newDatadogClient() (*datadog.Client, error) {
+	apiKey := cfg.Datadog.APIKey
+	if env := os.Getenv("DD_API_KEY"); env != "" {
+		apiKey = env
+	}
+	site := cfg.Datadog.Site
+	if env := os.Getenv("DD_SITE"); env != "" {
+		site = env
+	}
+	tags := append(append([]string(nil), cfg.Datadog.Tags...), datadog.ParseTags(os.Getenv("DD_TAGS"))...)
+	return datadog.NewClient(site, apiKey, tags)
+}
+
+// splitPushTarget splits a --push target such as bigquery:dataset.table into its kind and destination.
+func splitPushTarget(target string) (kind, dest string) {
+	kind, dest, _ = strings.Cut(target, ":")
+	if kind == "otlp" || kind == "datadog" {
+		return target, "" // No destination; anything after a colon makes the target unsupported
+	}
+	return kind, dest
+}
+
+// warehouseRun is shared by every warehouse push of this invocation, so PR and CI rows get one run ID.
+var warehouseRun *warehouse.Run
+
+func currentWarehouseRun() warehouse.Run {
+	if warehouseRun == nil {
+		run := warehouse.NewRun(since, until, time.Now())
+		warehouseRun = &run
+	}
+	return *warehouseRun
+}
+
+// bigQueryProject returns the project of dataset.table destinations: GOOGLE_CLOUD_PROJECT or the config.
+func bigQueryProject() string {
+	if env := os.Getenv("GOOGLE_CLOUD_PROJECT"); env != "" {
+		return env
+	}
+	return cfg.Warehouse.BigQueryProject
+}
+
+// bigQueryToken returns BIGQUERY_ACCESS_TOKEN, or the access token of the gcloud login.
+func bigQueryToken() string {
+	if env := os.Getenv("BIGQUERY_ACCESS_TOKEN"); env != "" {
+		return env
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	cmd := transport.CommandContext(ctx, "gcloud", "auth", "print-access-token")
+	cmd.Credential = true
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// postgresDSN returns VISUCHE_POSTGRES_DSN or the configured connection string.
+func postgresDSN() string {
+	if env := os.Getenv("VISUCHE_POSTGRES_DSN"); env != "" {
+		return env
+	}
+	return cfg.Warehouse.PostgresDSN
+}
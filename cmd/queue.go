@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var queueReviewer string
+var queueIncludeDrafts bool
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "List open PRs waiting for review, grouped by requested reviewer",
+	Long:  `Show a "what should I review right now" view: currently open PRs grouped by requested reviewer, with age and size, most stale first.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runQueue()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(queueCmd)
+	queueCmd.Flags().StringVar(&queueReviewer, "reviewer", "", "Only show the queue of this reviewer (login, or team:<slug>)")
+	queueCmd.Flags().BoolVar(&queueIncludeDrafts, "include-drafts", false, "Include draft PRs")
+}
+
+func runQueue() {
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	prs, err := p.FetchOpenPullRequests(repo)
+	if err != nil {
+		exitWithError("Error fetching pull requests", err)
+	}
+
+	queues := stats.BuildReviewQueues(prs, time.Now(), queueIncludeDrafts)
+	if queueReviewer != "" {
+		var filtered []stats.ReviewQueue
+		for _, q := range queues {
+			if strings.EqualFold(q.Reviewer, queueReviewer) {
+				filtered = append(filtered, q)
+			}
+		}
+		queues = filtered
+	}
+
+	displayReviewQueues(queues)
+}
+
+// displayReviewQueues displays one table per reviewer, most stale PR first.
+func displayReviewQueues(queues []stats.ReviewQueue) {
+	fmt.Println(i18n.Sprintf("📋 Review Queue: %s", repo))
+	if len(queues) == 0 {
+		fmt.Println(i18n.T("🎉 No open PRs waiting for review"))
+		return
+	}
+
+	for _, q := range queues {
+		reviewer := q.Reviewer
+		if reviewer == stats.UnrequestedReviewer {
+			reviewer = i18n.T(reviewer)
+		}
+		fmt.Printf("\n👀 %s (%d)\n", reviewer, len(q.Items))
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{i18n.T("PR"), i18n.T("Title"), i18n.T("Author"), i18n.T("Waiting"), i18n.T("Age"), i18n.T("Size"), "URL"})
+		table.SetBorder(true)
+		for _, item := range q.Items {
+			title := item.PR.Title
+			if item.PR.IsDraft {
+				title = "[draft] " + title
+			}
+			if len([]rune(title)) > 50 {
+				title = string([]rune(title)[:47]) + "..."
+			}
+			table.Append([]string{
+				fmt.Sprintf("#%d", item.PR.Number),
+				title,
+				item.PR.Author.Login,
+				formatDuration(item.Waiting),
+				formatDuration(item.Age),
+				fmt.Sprintf("%s (+%d/-%d)", item.Size, item.PR.Additions, item.PR.Deletions),
+				item.PR.URL,
+			})
+		}
+		table.Render()
+	}
+	fmt.Println(i18n.T("💡 Waiting = time since the last review, or since the PR was opened when nobody has reviewed it yet."))
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/provider"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// maxRedIntervals is how many of the longest red intervals are listed.
+const maxRedIntervals = 5
+
+// runRedTimeReport reports how long the default branch was red during the period. runs may
+// include runs before --since; they establish whether the branch was already red at the start.
+func runRedTimeReport(p provider.CIProvider, runs []actions.WorkflowRun) {
+	start, end, ok := actionsPeriod(runs)
+	if !ok {
+		return
+	}
+
+	branch := "main"
+	if prProvider, ok := p.(provider.PRProvider); ok {
+		if detected, err := prProvider.DefaultBranch(repo); err == nil && detected != "" {
+			branch = detected
+		}
+	}
+
+	displayRedTime(actions.CalculateRedTime(runs, branch, start, end))
+}
+
+// actionsPeriod returns the analyzed period as [start, end): --since/--until when given, otherwise
+// from the first run until now. The end never lies in the future.
+func actionsPeriod(runs []actions.WorkflowRun) (time.Time, time.Time, bool) {
+	var start time.Time
+	for _, run := range runs {
+		if start.IsZero() || run.CreatedAt.Before(start) {
+			start = run.CreatedAt
+		}
+	}
+	if since != "" {
+		if t, err := time.Parse("2006-01-02", since); err == nil {
+			start = t
+		}
+	}
+	end := time.Now()
+	if until != "" {
+		if t, err := time.Parse("2006-01-02", until); err == nil && t.AddDate(0, 0, 1).Before(end) {
+			end = t.AddDate(0, 0, 1)
+		}
+	}
+	return start, end, !start.IsZero() && end.After(start)
+}
+
+func displayRedTime(rt actions.RedTime) {
+	fmt.Println("\n" + i18n.Sprintf("🚦 Default Branch Red Time (%s):", rt.Branch))
+	if rt.Workflows == 0 {
+		fmt.Println(i18n.T("No completed runs on the default branch in this period"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Red Time"), fmt.Sprintf("%s (%.1f%%)", formatDuration(rt.Red), rt.Fraction*100)})
+	table.Append([]string{i18n.T("Red Intervals"), fmt.Sprintf("%d", len(rt.Intervals))})
+	table.Append([]string{i18n.T("Workflows on Branch"), fmt.Sprintf("%d", rt.Workflows)})
+	table.Render()
+
+	if len(rt.Intervals) == 0 {
+		return
+	}
+	fmt.Println(i18n.T("Longest red intervals:"))
+	intervalTable := tablewriter.NewWriter(os.Stdout)
+	intervalTable.SetHeader([]string{i18n.T("Workflow"), i18n.T("Broken At"), i18n.T("Red For"), i18n.T("Breaking Run")})
+	intervalTable.SetBorder(true)
+	for i, interval := range rt.Intervals {
+		if i >= maxRedIntervals {
+			break
+		}
+		duration := formatDuration(interval.Duration())
+		if !interval.Fixed {
+			duration += " " + i18n.T("(still red)")
+		}
+		intervalTable.Append([]string{
+			interval.Workflow,
+			interval.Start.Format("2006-01-02 15:04"),
+			duration,
+			interval.BreakingRun.URL,
+		})
+	}
+	intervalTable.Render()
+	fmt.Println(i18n.T("💡 A workflow is red from the completion of a failing run until its next successful run; cancelled runs are ignored."))
+}
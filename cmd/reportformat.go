@@ -0,0 +1,9 @@
+package cmd
+
+import "visuche/internal/report"
+
+var reportFormat string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&reportFormat, "report-format", report.FormatTable, "Format of the PR statistics report on stdout: table, markdown or json")
+}
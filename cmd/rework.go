@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var reworkReport bool
+var reworkWeeks int
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&reworkReport, "rework", false, "Report how much merged code is modified again soon after (one extra API call per merged PR)")
+	rootCmd.PersistentFlags().IntVar(&reworkWeeks, "rework-weeks", 3, "Window in weeks within which a later change counts as rework")
+}
+
+// runReworkReport displays the rework report when --rework is set.
+func runReworkReport(prs []github.PullRequest) {
+	if !reworkReport {
+		return
+	}
+	if reworkWeeks <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --rework-weeks must be positive\n")
+		return
+	}
+	displayReworkReport(stats.CalculateRework(prs, time.Duration(reworkWeeks)*7*24*time.Hour))
+}
+
+// displayReworkReport displays the rework rate and the most reworked files.
+func displayReworkReport(report stats.ReworkReport) {
+	fmt.Println("\n" + i18n.Sprintf("♻️  Rework (changed again within %d weeks):", int(report.Window.Hours()/24/7)))
+	if report.AnalyzedPRs == 0 {
+		fmt.Println(i18n.T("No changed-file data available for merged PRs"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Rework Rate (lines)"), fmt.Sprintf("%.1f%% (%d / %d)", report.ReworkRate, report.ReworkedLines, report.ChangedLines)})
+	table.Append([]string{i18n.T("PRs with Reworked Files"), fmt.Sprintf("%.1f%% (%d / %d)", report.PRReworkRate, report.ReworkedPRs, report.AnalyzedPRs)})
+	table.Render()
+
+	if len(report.TopFiles) > 0 {
+		fileTable := tablewriter.NewWriter(os.Stdout)
+		fileTable.SetHeader([]string{i18n.T("File"), i18n.T("Reworks")})
+		fileTable.SetBorder(true)
+		for _, f := range report.TopFiles {
+			fileTable.Append([]string{f.Path, fmt.Sprintf("%d", f.Reworks)})
+		}
+		fileTable.Render()
+	}
+	fmt.Println(i18n.T("💡 File-level approximation: any later change to a file counts all earlier lines in it. PRs merged near the end of the period have less time to be reworked."))
+}
@@ -4,17 +4,19 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 	"visuche/internal/csv"
-	"visuche/internal/git"
 	"visuche/internal/github"
 	"visuche/internal/i18n"
+	"visuche/internal/json"
+	"visuche/internal/metadata"
+	"visuche/internal/report"
 	"visuche/internal/stats"
 
 	"github.com/manifoldco/promptui"
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +26,7 @@ var until string
 var author string
 var label string
 var csvOutput bool
+var jsonOutput bool
 var lang string
 var langJP bool
 
@@ -31,6 +34,22 @@ var rootCmd = &cobra.Command{
 	Use:   "visuche",
 	Short: "A visualization tool for GitHub repository metrics and CI/CD analytics.",
 	Long:  `visuche (visualization check) analyzes GitHub repositories to provide insights on PR metrics, lead times, and CI/CD performance.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if err := configureTransport(); err != nil {
+			exitWithError("Error", err)
+		}
+		if err := configureCSV(); err != nil {
+			exitWithError("Error", err)
+		}
+		if err := configureProgress(); err != nil {
+			exitWithError("Error", err)
+		}
+		applyHeuristics()
+		// Flexible dates and period presets in --since/--until become plain YYYY-MM-DD dates
+		if err := resolveDateFlags(); err != nil {
+			exitWithError("Error", err)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		// If no arguments provided, use interactive mode
 		if repo == "" && since == "" && until == "" {
@@ -48,7 +67,7 @@ func getTargetRepo() (string, error) {
 		return repo, nil
 	}
 
-	detectedRepo, err := git.GetRepoFromGitRemote()
+	detectedRepo, err := detectRepoFromRemote()
 	if err == nil {
 		prompt := promptui.Select{
 			Label: fmt.Sprintf("? Found repository '%s'. Use this one?", detectedRepo),
@@ -66,13 +85,8 @@ func getTargetRepo() (string, error) {
 
 	// Ask for manual input
 	prompt := promptui.Prompt{
-		Label: "Please enter the repository in 'owner/repo' format",
-		Validate: func(input string) error {
-			if len(strings.Split(input, "/")) != 2 || strings.TrimSpace(input) == "" {
-				return fmt.Errorf("invalid format, please use 'owner/repo'")
-			}
-			return nil
-		},
+		Label:    "Please enter the repository in 'owner/repo' format",
+		Validate: validateRepoInput,
 	}
 	result, err := prompt.Run()
 	if err != nil {
@@ -85,16 +99,18 @@ func init() {
 	cobra.OnInitialize(applyLanguageSetting)
 
 	rootCmd.PersistentFlags().StringVar(&repo, "repo", "", "Specify the GitHub repository in 'owner/repo' format")
-	rootCmd.PersistentFlags().StringVar(&since, "since", "", "Fetch PRs created after this date (YYYY-MM-DD)")
-	rootCmd.PersistentFlags().StringVar(&until, "until", "", "Fetch PRs created before this date (YYYY-MM-DD)")
+	rootCmd.PersistentFlags().StringVar(&since, "since", "", "Fetch PRs created after this date (YYYY-MM-DD, \"30 days ago\", \"2024-Q1\", \"last quarter\", ...)")
+	rootCmd.PersistentFlags().StringVar(&until, "until", "", "Fetch PRs created before this date (same formats as --since)")
 	rootCmd.PersistentFlags().StringVar(&author, "author", "", "Filter PRs by author username")
 	rootCmd.PersistentFlags().StringVar(&label, "label", "", "Filter PRs by label name")
 	rootCmd.PersistentFlags().BoolVar(&csvOutput, "csv", false, "Export results to CSV file")
+	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Export results to JSON file")
 	rootCmd.PersistentFlags().StringVar(&lang, "lang", "en", "Output language (en/jp)")
 	rootCmd.PersistentFlags().BoolVar(&langJP, "jp", false, "Use Japanese output (shortcut for --lang=jp)")
 }
 
 func Execute() {
+	registerFlagCompletions()
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Whoops. There was an error while executing your CLI '%s'", err)
 		os.Exit(1)
@@ -110,19 +126,14 @@ func applyLanguageSetting() {
 }
 
 // CalculateLeadTimes calculates the lead time for each pull request.
-// It returns a new slice containing only closed or merged PRs with their lead time calculated.
+// Lead time starts when the PR became reviewable (creation, or ready-for-review when draft time is excluded).
+// Only merged PRs get a lead time; PRs closed without merging are covered by the abandoned PR report.
 func CalculateLeadTimes(prs []github.PullRequest) []github.PullRequest {
 	processedPRs := make([]github.PullRequest, 0, len(prs))
 	for _, pr := range prs {
-		var endAt time.Time
+		pr.LeadTime = 0
 		if pr.Merged && !pr.MergedAt.IsZero() {
-			endAt = pr.MergedAt
-		} else if !pr.ClosedAt.IsZero() {
-			endAt = pr.ClosedAt
-		}
-
-		if !endAt.IsZero() {
-			pr.LeadTime = endAt.Sub(pr.CreatedAt)
+			pr.LeadTime = pr.MergedAt.Sub(pr.ReviewableAt())
 		}
 
 		// Keep open PRs as well so metrics like TotalPRs/WIP are accurate.
@@ -131,163 +142,288 @@ func CalculateLeadTimes(prs []github.PullRequest) []github.PullRequest {
 	return processedPRs
 }
 
-// displayStatsTable displays PR statistics in a formatted table
-func displayStatsTable(statistics stats.Stats) {
-	fmt.Println("\n" + i18n.T("📊 Pull Request Statistics"))
-	fmt.Println("=" + strings.Repeat("=", 50))
+// reportSelection chooses which report groups a PR analysis displays.
+type reportSelection struct {
+	PRMetrics     bool // Throughput, timing, code change and stability metrics plus the PR reports
+	ReviewMetrics bool // Collaboration, review comments and the review reports
+	PerAuthor     bool // PR and review metrics per author
+}
+
+// allPRReports is what a non-interactive run displays.
+var allPRReports = reportSelection{PRMetrics: true, ReviewMetrics: true}
+
+// displayStatsTable displays the selected groups of PR statistics in the --report-format
+func displayStatsTable(statistics stats.Stats, sel reportSelection) {
+	if !sel.PRMetrics && !sel.ReviewMetrics {
+		return
+	}
+	if err := report.Render(os.Stdout, reportFormat, statsReport(statistics, sel)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error rendering report: %v\n", err)
+	}
+}
+
+// statsReport builds the report of the selected groups of PR statistics.
+func statsReport(statistics stats.Stats, sel reportSelection) report.Report {
+	r := report.Report{Title: i18n.T("📊 Pull Request Statistics")}
+	if sel.PRMetrics {
+		addCoreMetrics(&r, statistics)
+	}
+	if sel.ReviewMetrics {
+		addCollaborationMetrics(&r, statistics)
+	}
+	if sel.PRMetrics {
+		addStabilityMetrics(&r, statistics)
+		addBenchmarks(&r, statistics)
+	}
+	if sel.ReviewMetrics {
+		addReviewCommentMetrics(&r, statistics)
+	}
+	if sel.PRMetrics {
+		addMergeTypes(&r, statistics)
+	}
+
+	// With --metrics, drop the tables left without rows
+	sections := r.Sections[:0]
+	for _, s := range r.Sections {
+		if len(s.Header) == 0 || len(s.Rows) > 0 || len(s.Notes) > 0 {
+			sections = append(sections, s)
+		}
+	}
+	r.Sections = sections
+	return r
+}
 
+// addCoreMetrics adds the basic, timing, cycle time and code change tables.
+func addCoreMetrics(r *report.Report, statistics stats.Stats) {
 	// Basic Statistics Table
-	fmt.Println("\n" + i18n.T("🔢 Basic Metrics:"))
-	basicTable := tablewriter.NewWriter(os.Stdout)
-	basicTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
-	basicTable.SetBorder(true)
-	basicTable.Append([]string{i18n.T("Total PRs"), fmt.Sprintf("%d", statistics.TotalPRs)})
-	basicTable.Append([]string{i18n.T("Merged PRs"), fmt.Sprintf("%d", statistics.MergedPRs)})
-	basicTable.Append([]string{i18n.T("WIP PRs"), fmt.Sprintf("%d", statistics.WIPPRCount)})
-	basicTable.Append([]string{i18n.T("Releases (main/master merges)"), fmt.Sprintf("%d", statistics.ReleaseCount)})
-	if statistics.TotalPRs > 0 {
-		basicTable.Append([]string{i18n.T("Merge Rate"), fmt.Sprintf("%.1f%%", float64(statistics.MergedPRs)/float64(statistics.TotalPRs)*100)})
-	}
-	basicTable.Render()
-
-	// Timing Statistics Table
-	fmt.Println("\n" + i18n.T("⏱️ Timing Metrics:"))
-	timingTable := tablewriter.NewWriter(os.Stdout)
-	timingTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Average"), i18n.T("Median")})
-	timingTable.SetBorder(true)
-	timingTable.Append([]string{
-		i18n.T("Lead Time"),
-		formatDuration(statistics.AverageLeadTime),
-		formatDuration(statistics.MedianLeadTime),
-	})
-	timingTable.Append([]string{
-		i18n.T("Review Time"),
-		formatDuration(statistics.AverageReviewTime),
-		formatDuration(statistics.MedianReviewTime),
-	})
-	timingTable.Append([]string{
-		i18n.T("Merge Wait Time"),
-		formatDuration(statistics.AverageMergeWaitTime),
-		formatDuration(statistics.MedianMergeWaitTime),
-	})
-	timingTable.Append([]string{
-		i18n.T("Approval→Merge Time"),
-		formatDuration(statistics.AverageApprovalToMerge),
-		formatDuration(statistics.MedianApprovalToMerge),
-	})
-	timingTable.Append([]string{
-		i18n.T("Commit→PR Time"),
-		formatDuration(statistics.AverageCommitToPRTime),
-		"-",
-	})
-	timingTable.Render()
+	has := statistics.Computed
+	basic := r.AddSection(i18n.T("🔢 Basic Metrics:"), i18n.T("Metric"), i18n.T("Value"))
+	if has("volume") {
+		basic.Append(i18n.T("Total PRs"), fmt.Sprintf("%d", statistics.TotalPRs))
+		basic.Append(i18n.T("Merged PRs"), fmt.Sprintf("%d", statistics.MergedPRs))
+	}
+	if has("wip") {
+		basic.Append(i18n.T("WIP PRs"), fmt.Sprintf("%d", statistics.WIPPRCount))
+	}
+	releaseLabel := i18n.T("Releases (main/master merges)")
+	if branches := cfg.Heuristics.ReleaseBranches; len(branches) > 0 {
+		releaseLabel = i18n.Sprintf("Releases (%s merges)", strings.Join(branches, "/"))
+	} else if statistics.DefaultBranch != "" {
+		releaseLabel = i18n.Sprintf("Releases (%s merges)", statistics.DefaultBranch)
+	}
+	if has("releases") {
+		basic.Append(releaseLabel, fmt.Sprintf("%d", statistics.ReleaseCount))
+	}
+	if has("volume") && statistics.TotalPRs > 0 {
+		basic.Append(i18n.T("Merge Rate"), fmt.Sprintf("%.1f%%", float64(statistics.MergedPRs)/float64(statistics.TotalPRs)*100))
+	}
+
+	// Open PR aging
+	if has("open-prs") {
+		aging := r.AddSection(i18n.T("⏳ Open PRs:"), i18n.T("Metric"), i18n.T("Value"))
+		aging.Append(i18n.T("Open PRs"), fmt.Sprintf("%d", statistics.OpenPRs))
+		if statistics.OpenPRs > 0 {
+			aging.Append(i18n.T("Draft"), fmt.Sprintf("%d", statistics.OpenDraftPRs))
+			aging.Append(i18n.T("Ready for Review"), fmt.Sprintf("%d", statistics.OpenReadyPRs))
+			aging.Append(i18n.T("Approved, Awaiting Merge"), fmt.Sprintf("%d", statistics.OpenApprovedPRs))
+			aging.Append(i18n.T("Open < 1 day"), fmt.Sprintf("%d", statistics.OpenPRsUnder1Day))
+			aging.Append(i18n.T("Open 1–3 days"), fmt.Sprintf("%d", statistics.OpenPRs1To3Days))
+			aging.Append(i18n.T("Open 3–7 days"), fmt.Sprintf("%d", statistics.OpenPRs3To7Days))
+			aging.Append(i18n.T("Open > 7 days"), fmt.Sprintf("%d", statistics.OpenPRsOver7Days))
+			aging.Append(i18n.T("Average Open PR Age"), formatDuration(statistics.AverageOpenPRAge))
+			aging.Append(i18n.T("Oldest Open PR"), fmt.Sprintf("#%d (%s)", statistics.OldestOpenPR, formatDuration(statistics.OldestOpenPRAge)))
+			aging.Note(i18n.T("💡 Open PRs created before --since are not included"))
+		}
+	}
+
+	// Merge blockers (only providers with mergeability data)
+	if has("merge-blockers") && statistics.MergeStatePRs > 0 {
+		blockers := r.AddSection(i18n.T("🚧 Merge Blockers:"), i18n.T("Metric"), i18n.T("PRs"))
+		blockers.Append(i18n.T("Open PRs Checked"), fmt.Sprintf("%d", statistics.MergeStatePRs))
+		blockers.Append(i18n.T("Merge Conflicts"), fmt.Sprintf("%d", statistics.BlockedByConflicts))
+		blockers.Append(i18n.T("Failing or Pending Checks"), fmt.Sprintf("%d", statistics.BlockedByChecks))
+		blockers.Append(i18n.T("Needs Review or Changes"), fmt.Sprintf("%d", statistics.BlockedByReviews))
+		blockers.Append(i18n.T("Behind Base Branch"), fmt.Sprintf("%d", statistics.BehindBasePRs))
+		blockers.Append(i18n.T("Ready to Merge"), fmt.Sprintf("%d", statistics.ReadyToMergePRs))
+		blockers.Append(i18n.T("Mergeability Unknown"), fmt.Sprintf("%d", statistics.UnknownMergeStatePRs))
+		blockers.Note(i18n.T("💡 Open, non-draft PRs; a PR can have several blockers"))
+	}
+
+	timing := r.AddSection(i18n.T("⏱️ Timing Metrics:"), distributionHeader(i18n.T("Metric"))...)
+	if has("lead-time") {
+		timing.Append(distributionRow(i18n.T("Lead Time"), statistics.AverageLeadTime, statistics.LeadTimeSummary)...)
+	}
+	if has("review-time") {
+		timing.Append(distributionRow(i18n.T("Review Time"), statistics.AverageReviewTime, statistics.ReviewTimeSummary)...)
+	}
+	if has("merge-wait") {
+		timing.Append(distributionRow(i18n.T("Merge Wait Time"), statistics.AverageMergeWaitTime, statistics.MergeWaitSummary)...)
+	}
+	if has("approval-to-merge") {
+		timing.Append(distributionRow(i18n.T("Approval→Merge Time"), statistics.AverageApprovalToMerge, statistics.ApprovalToMergeSummary)...)
+	}
+	if statistics.AutoMergedPRs+statistics.BotMergedPRs > 0 {
+		timing.Append(distributionRow(i18n.T("Approval→Merge (automated)"), statistics.AverageAutomatedApprovalToMerge, statistics.AutomatedApprovalToMergeSummary)...)
+	}
+	if has("reopens") {
+		timing.Append(distributionRow(i18n.T("Reopen→Merge Time"), statistics.AverageReopenToMerge, statistics.ReopenToMergeSummary)...)
+	}
+	if has("hotfixes") {
+		timing.Append(distributionRow(i18n.T("Hotfix→Release Gap"), statistics.AverageHotfixAfterRelease, statistics.HotfixAfterReleaseSummary)...)
+	}
+	if has("code-change") {
+		timing.Append(i18n.T("Commit→PR Time"), formatDuration(statistics.AverageCommitToPRTime), "-", "-", "-", "-", "-", "-")
+	}
+
+	// Cycle-time stage breakdown
+	if has("cycle-stages") {
+		cycle := r.AddSection(i18n.T("🔄 Cycle Time Stages:"), distributionHeader(i18n.T("Stage"))...)
+		cycle.Append(distributionRow(i18n.T("Coding (first commit→open)"), statistics.AverageCodingTime, statistics.CodingTimeSummary)...)
+		cycle.Append(distributionRow(i18n.T("Pickup (open→first review)"), statistics.AveragePickupTime, statistics.PickupTimeSummary)...)
+		cycle.Append(distributionRow(i18n.T("Review (first review→approval)"), statistics.AverageReviewStageTime, statistics.ReviewStageSummary)...)
+		cycle.Append(distributionRow(i18n.T("Merge (approval→merge)"), statistics.AverageMergeStageTime, statistics.MergeStageSummary)...)
+	}
 
 	// Code Change Statistics Table
-	fmt.Println("\n" + i18n.T("💻 Code Change Metrics:"))
-	codeTable := tablewriter.NewWriter(os.Stdout)
-	codeTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Average")})
-	codeTable.SetBorder(true)
-	codeTable.Append([]string{i18n.T("Files Changed"), fmt.Sprintf("%.1f", statistics.AverageFilesChanged)})
-	codeTable.Append([]string{i18n.T("Lines Added"), fmt.Sprintf("%.1f", statistics.AverageAdditions)})
-	codeTable.Append([]string{i18n.T("Lines Deleted"), fmt.Sprintf("%.1f", statistics.AverageDeletions)})
-	codeTable.Append([]string{i18n.T("Commits per PR"), fmt.Sprintf("%.1f", statistics.AverageCommitsPerPR)})
-	codeTable.Append([]string{i18n.T("Commit Frequency/Week"), fmt.Sprintf("%.1f", statistics.CommitFrequencyPerWeek)})
-	codeTable.Render()
-
-	// Collaboration Statistics Table
-	fmt.Println("\n" + i18n.T("👥 Collaboration Metrics:"))
-	collabTable := tablewriter.NewWriter(os.Stdout)
-	collabTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
-	collabTable.SetBorder(true)
-	collabTable.Append([]string{i18n.T("Avg Reviewers per PR"), fmt.Sprintf("%.1f", statistics.AverageReviewersPerPR)})
-	collabTable.Append([]string{i18n.T("Self-Merge Rate"), fmt.Sprintf("%.1f%%", statistics.SelfMergeRate)})
-	collabTable.Render()
-
-	// Stability / quality metrics
-	fmt.Println("\n" + i18n.T("Stability Metrics:"))
-	stabilityTable := tablewriter.NewWriter(os.Stdout)
-	stabilityTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
-	stabilityTable.SetBorder(true)
-	stabilityTable.Append([]string{i18n.T("Reopened PRs"), fmt.Sprintf("%d", statistics.ReopenedPRs)})
-	stabilityTable.Append([]string{i18n.T("Reopen Rate"), fmt.Sprintf("%.1f%%", statistics.ReopenRate)})
-	stabilityTable.Append([]string{i18n.T("Reopen→Merge Time"), fmt.Sprintf("%s / %s", formatDuration(statistics.AverageReopenToMerge), formatDuration(statistics.MedianReopenToMerge))})
-	stabilityTable.Append([]string{i18n.T("Revert-like Merges"), fmt.Sprintf("%d", statistics.RevertLikeMerges)})
-	stabilityTable.Append([]string{i18n.T("Hotfix Merges"), fmt.Sprintf("%d", statistics.HotfixMerges)})
-	stabilityTable.Append([]string{i18n.T("Hotfix→Release Gap (avg/median)"), fmt.Sprintf("%s avg / %s median", formatDuration(statistics.AverageHotfixAfterRelease), formatDuration(statistics.MedianHotfixAfterRelease))})
+	code := r.AddSection(i18n.T("💻 Code Change Metrics:"), i18n.T("Metric"), i18n.T("Average"), i18n.T("Median"), i18n.T("P90"))
+	if has("code-change") {
+		code.Append(countRow(i18n.T("Files Changed"), statistics.FilesChangedSummary)...)
+		code.Append(countRow(i18n.T("Lines Added"), statistics.AdditionsSummary)...)
+		code.Append(countRow(i18n.T("Lines Deleted"), statistics.DeletionsSummary)...)
+		code.Append(countRow(i18n.T("Commits per PR"), statistics.CommitsPerPRSummary)...)
+	}
+	if has("commit-frequency") {
+		code.Append(i18n.T("Commit Frequency/Week"), fmt.Sprintf("%.1f", statistics.CommitFrequencyPerWeek), "-", "-")
+	}
+}
+
+// distributionHeader is the header of the timing tables: the average followed by the duration summary.
+func distributionHeader(label string) []string {
+	return []string{label, i18n.T("Average"), i18n.T("Median"), i18n.T("P90"), i18n.T("P95"), i18n.T("Min"), i18n.T("Max"), i18n.T("Std Dev")}
+}
+
+// distributionRow is a distributionHeader row. average is passed separately as some metrics average
+// over more PRs than the summary covers (see stats.Stats.MergeWaitSummary).
+func distributionRow(label string, average time.Duration, summary stats.DurationSummary) []string {
+	return []string{
+		label,
+		formatDuration(average),
+		formatDuration(summary.Median),
+		formatDuration(summary.P90),
+		formatDuration(summary.P95),
+		formatDuration(summary.Min),
+		formatDuration(summary.Max),
+		formatDuration(summary.StdDev),
+	}
+}
+
+// countRow is a row of the code change table: average, median and 90th percentile.
+func countRow(label string, summary stats.CountSummary) []string {
+	return []string{label, fmt.Sprintf("%.1f", summary.Mean), fmt.Sprintf("%.1f", summary.Median), fmt.Sprintf("%.1f", summary.P90)}
+}
+
+// addCollaborationMetrics adds reviewer and merge automation metrics.
+func addCollaborationMetrics(r *report.Report, statistics stats.Stats) {
+	has := statistics.Computed
+	collab := r.AddSection(i18n.T("👥 Collaboration Metrics:"), i18n.T("Metric"), i18n.T("Value"))
+	if has("reviewers") {
+		collab.Append(i18n.T("Avg Reviewers per PR"), fmt.Sprintf("%.1f", statistics.AverageReviewersPerPR))
+	}
+	if has("self-merge") {
+		collab.Append(i18n.T("Self-Merge Rate"), fmt.Sprintf("%.1f%%", statistics.SelfMergeRate))
+	}
+	if has("merge-automation") {
+		collab.Append(i18n.T("Auto-merged PRs"), fmt.Sprintf("%d", statistics.AutoMergedPRs))
+		collab.Append(i18n.T("Bot-merged PRs"), fmt.Sprintf("%d", statistics.BotMergedPRs))
+		collab.Append(i18n.T("Automated Merge Rate"), fmt.Sprintf("%.1f%%", statistics.AutomatedMergeRate))
+	}
+}
+
+// addStabilityMetrics adds reopen, revert and hotfix metrics.
+func addStabilityMetrics(r *report.Report, statistics stats.Stats) {
+	has := statistics.Computed
+	stability := r.AddSection(i18n.T("Stability Metrics:"), i18n.T("Metric"), i18n.T("Value"))
+	if has("reopens") {
+		stability.Append(i18n.T("Reopened PRs"), fmt.Sprintf("%d", statistics.ReopenedPRs))
+		stability.Append(i18n.T("Reopen Rate"), fmt.Sprintf("%.1f%%", statistics.ReopenRate))
+	}
+	if has("reverts") {
+		stability.Append(i18n.T("Revert-like Merges"), fmt.Sprintf("%d", statistics.RevertLikeMerges))
+	}
+	if has("hotfixes") {
+		stability.Append(i18n.T("Hotfix Merges"), fmt.Sprintf("%d", statistics.HotfixMerges))
+	}
 	if statistics.HotfixWithoutReleaseContext > 0 {
-		stabilityTable.Append([]string{i18n.T("Hotfix w/o prior release"), fmt.Sprintf("%d", statistics.HotfixWithoutReleaseContext)})
+		stability.Append(i18n.T("Hotfix w/o prior release"), fmt.Sprintf("%d", statistics.HotfixWithoutReleaseContext))
 	}
-	stabilityTable.Render()
+}
 
+// addReviewCommentMetrics adds review comment volume, coverage and density.
+func addReviewCommentMetrics(r *report.Report, statistics stats.Stats) {
+	if !statistics.Computed("review-comments") {
+		return
+	}
 	// Review Comment Analysis (focus on code review comments only)
-	if statistics.PRsWithReviewComments > 0 {
-		fmt.Println("\n" + i18n.T("💬 Code Review Analysis:"))
-		reviewTable := tablewriter.NewWriter(os.Stdout)
-		reviewTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Average"), i18n.T("Median"), i18n.T("Max")})
-		reviewTable.SetBorder(true)
-
-		reviewTable.Append([]string{
-			i18n.T("Review Comments per PR"),
-			fmt.Sprintf("%.1f", statistics.AverageReviewCommentsPerPR),
-			fmt.Sprintf("%.1f", statistics.MedianReviewCommentsPerPR),
-			fmt.Sprintf("%d", statistics.MaxReviewCommentsInPR),
-		})
-		reviewTable.Render()
-
-		// Review Coverage Statistics
-		fmt.Println("\n" + i18n.T("📈 Review Coverage:"))
-		coverageTable := tablewriter.NewWriter(os.Stdout)
-		coverageTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Count"), i18n.T("Percentage")})
-		coverageTable.SetBorder(true)
-
-		if statistics.TotalPRs > 0 {
-			reviewCommentCoverage := float64(statistics.PRsWithReviewComments) / float64(statistics.TotalPRs) * 100.0
-
-			coverageTable.Append([]string{i18n.T("PRs with Review Comments"), fmt.Sprintf("%d", statistics.PRsWithReviewComments), fmt.Sprintf("%.1f%%", reviewCommentCoverage)})
-			coverageTable.Append([]string{i18n.T("PRs without Review Comments"), fmt.Sprintf("%d", statistics.PRsWithoutReviewComments), fmt.Sprintf("%.1f%%", 100.0-reviewCommentCoverage)})
-		}
-
-		coverageTable.Render()
-
-		// Review Density Analysis
-		fmt.Println("\n" + i18n.T("🔍 Review Quality:"))
-		densityTable := tablewriter.NewWriter(os.Stdout)
-		densityTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
-		densityTable.SetBorder(true)
-
-		// Calculate review density based on review comments only
-		reviewDensity := 0.0
-		totalReviewComments := int(statistics.AverageReviewCommentsPerPR * float64(statistics.TotalPRs))
-		if statistics.AverageAdditions+statistics.AverageDeletions > 0 {
-			totalLines := int((statistics.AverageAdditions + statistics.AverageDeletions) * float64(statistics.TotalPRs))
-			reviewDensity = float64(totalReviewComments) / float64(totalLines) * 100.0
-		}
-
-		densityTable.Append([]string{i18n.T("Review Comment Density"), i18n.Sprintf("%.2f comments/100 lines", reviewDensity)})
-		densityTable.Render()
-	} else {
-		// Show a message when no review comments are found
-		fmt.Println("\n" + i18n.T("💬 Code Review Analysis:"))
-		fmt.Printf(i18n.Sprintf("📝 No code review comments found in this period (%d PRs analyzed)", statistics.TotalPRs) + "\n")
-		fmt.Printf(i18n.T("💡 This could indicate:") + "\n")
-		fmt.Printf(i18n.T("   • Code quality is consistently high") + "\n")
-		fmt.Printf(i18n.T("   • Team does reviews via other channels") + "\n")
-		fmt.Printf(i18n.T("   • PRs are small and self-explanatory") + "\n")
+	if statistics.PRsWithReviewComments == 0 {
+		// Explain the empty result instead of showing zeros
+		empty := r.AddSection(i18n.T("💬 Code Review Analysis:"))
+		empty.Note(i18n.Sprintf("📝 No code review comments found in this period (%d PRs analyzed)", statistics.TotalPRs))
+		empty.Note(i18n.T("💡 This could indicate:"))
+		empty.Note(i18n.T("   • Code quality is consistently high"))
+		empty.Note(i18n.T("   • Team does reviews via other channels"))
+		empty.Note(i18n.T("   • PRs are small and self-explanatory"))
+		return
 	}
 
-	// Merge Type Statistics Table
-	if len(statistics.MergeTypeTrend) > 0 {
-		fmt.Println("\n" + i18n.T("🔀 Merge Type Distribution:"))
-		mergeTable := tablewriter.NewWriter(os.Stdout)
-		mergeTable.SetHeader([]string{i18n.T("Merge Type"), i18n.T("Percentage")})
-		mergeTable.SetBorder(true)
-		for mergeType, percentage := range statistics.MergeTypeTrend {
-			mergeTable.Append([]string{mergeType, fmt.Sprintf("%.1f%%", percentage)})
-		}
-		mergeTable.Render()
+	review := r.AddSection(i18n.T("💬 Code Review Analysis:"), i18n.T("Metric"), i18n.T("Average"), i18n.T("Median"), i18n.T("Max"))
+	review.Append(
+		i18n.T("Review Comments per PR"),
+		fmt.Sprintf("%.1f", statistics.AverageReviewCommentsPerPR),
+		fmt.Sprintf("%.1f", statistics.MedianReviewCommentsPerPR),
+		fmt.Sprintf("%d", statistics.MaxReviewCommentsInPR),
+	)
+
+	// Review Coverage Statistics
+	coverage := r.AddSection(i18n.T("📈 Review Coverage:"), i18n.T("Metric"), i18n.T("Count"), i18n.T("Percentage"))
+	if statistics.TotalPRs > 0 {
+		reviewCommentCoverage := float64(statistics.PRsWithReviewComments) / float64(statistics.TotalPRs) * 100.0
+		coverage.Append(i18n.T("PRs with Review Comments"), fmt.Sprintf("%d", statistics.PRsWithReviewComments), fmt.Sprintf("%.1f%%", reviewCommentCoverage))
+		coverage.Append(i18n.T("PRs without Review Comments"), fmt.Sprintf("%d", statistics.PRsWithoutReviewComments), fmt.Sprintf("%.1f%%", 100.0-reviewCommentCoverage))
 	}
 
-	fmt.Println()
+	// Review Density Analysis, based on review comments only
+	reviewDensity := 0.0
+	totalReviewComments := int(statistics.AverageReviewCommentsPerPR * float64(statistics.TotalPRs))
+	if statistics.AverageAdditions+statistics.AverageDeletions > 0 {
+		totalLines := int((statistics.AverageAdditions + statistics.AverageDeletions) * float64(statistics.TotalPRs))
+		reviewDensity = float64(totalReviewComments) / float64(totalLines) * 100.0
+	}
+	if statistics.Computed("code-change") {
+		density := r.AddSection(i18n.T("🔍 Review Quality:"), i18n.T("Metric"), i18n.T("Value"))
+		density.Append(i18n.T("Review Comment Density"), i18n.Sprintf("%.2f comments/100 lines", reviewDensity))
+	}
+}
+
+// addMergeTypes adds the merge type distribution, most frequent first.
+func addMergeTypes(r *report.Report, statistics stats.Stats) {
+	if len(statistics.MergeTypeTrend) == 0 {
+		return
+	}
+	mergeTypes := make([]string, 0, len(statistics.MergeTypeTrend))
+	for mergeType := range statistics.MergeTypeTrend {
+		mergeTypes = append(mergeTypes, mergeType)
+	}
+	sort.Slice(mergeTypes, func(i, j int) bool {
+		a, b := statistics.MergeTypeTrend[mergeTypes[i]], statistics.MergeTypeTrend[mergeTypes[j]]
+		if a != b {
+			return a > b
+		}
+		return mergeTypes[i] < mergeTypes[j]
+	})
+	merges := r.AddSection(i18n.T("🔀 Merge Type Distribution:"), i18n.T("Merge Type"), i18n.T("Percentage"))
+	for _, mergeType := range mergeTypes {
+		merges.Append(mergeType, fmt.Sprintf("%.1f%%", statistics.MergeTypeTrend[mergeType]))
+	}
 }
 
 // formatDuration formats a time.Duration into a human-readable string
@@ -308,6 +444,14 @@ func formatDuration(d time.Duration) string {
 	}
 }
 
+// linkSuffix returns url as a " <url>" suffix for PR listings, or "" when the URL is unknown (e.g. imported PRs).
+func linkSuffix(url string) string {
+	if url == "" {
+		return ""
+	}
+	return " " + url
+}
+
 // runInteractiveMode runs the interactive mode for repository and date selection
 func runInteractiveMode() {
 	fmt.Println("🎯 Welcome to visuche - Interactive GitHub Analytics")
@@ -316,36 +460,34 @@ func runInteractiveMode() {
 	// Step 1: Repository selection
 	targetRepo, err := getInteractiveRepo()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error", err)
 	}
 	repo = targetRepo
 
-	// Step 2: Analysis type selection
-	analysisType, err := selectAnalysisType()
+	// Step 2: Analyses to run (PR-based ones share a single fetch)
+	selection, withCI, err := selectAnalyses()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error", err)
 	}
+	withPRs := selection.PRMetrics || selection.ReviewMetrics || selection.PerAuthor
 
 	// Step 3: Date range selection
 	startDate, endDate, err := selectDateRange()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error", err)
 	}
 	since = startDate
 	until = endDate
 
 	// Step 4: Optional filters
-	if analysisType == "PR Analysis" {
+	if withPRs {
 		author, label = selectOptionalFilters()
 	}
 
 	// Step 5: Run analysis
 	fmt.Printf("\n✅ Configuration:\n")
 	fmt.Printf("  Repository: %s\n", repo)
-	fmt.Printf("  Analysis: %s\n", analysisType)
+	fmt.Printf("  Analysis: %s\n", strings.Join(selectedAnalysisNames(selection, withCI), ", "))
 	fmt.Printf("  Period: %s to %s\n", since, until)
 	if author != "" {
 		fmt.Printf("  Author: %s\n", author)
@@ -364,88 +506,269 @@ func runInteractiveMode() {
 		return
 	}
 
-	// Run the appropriate analysis based on type
-	if analysisType == "Actions Analysis" {
+	switch {
+	case withPRs && withCI:
+		runCombinedAnalysis(selection)
+	case withPRs:
+		runPRAnalysis(selection)
+	default:
 		runActionsAnalysis()
-	} else {
-		runAnalysis()
 	}
 }
 
 // runAnalysis performs the actual analysis with current settings
 func runAnalysis() {
+	runPRAnalysis(allPRReports)
+}
+
+// runPRAnalysis fetches the PRs once and displays the selected report groups
+func runPRAnalysis(sel reportSelection) prAnalysis {
 	// Determine the target repository
 	targetRepo, err := getTargetRepo()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error", err)
 	}
 	repo = targetRepo
 
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	if err := validateGroupBy(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validatePush(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateNotify(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validatePostToIssue(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateChartSnippets(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := validateExport(); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := report.Validate(reportFormat); err != nil {
+		exitWithError("Error", err)
+	}
+	if err := stats.ValidateMetrics(selectedMetrics); err != nil {
+		exitWithError("Error", err)
+	}
+
 	fmt.Printf(i18n.Sprintf("✅ Using repository: %s\n", repo))
+	warnLargeRange()
 
-	// Fetch pull requests
+	// Fetch pull requests and calculate stats
 	fmt.Println(i18n.T("📥 Fetching pull requests..."))
-	prs, err := github.FetchPullRequests(repo, since, until, author, label, true)
+	analysis, err := analyzePullRequests(p, repo, since, until, author, label)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error fetching pull requests: %v\n", err)
-		os.Exit(1)
+		exitWithError("Error fetching pull requests", err)
+	}
+	processedPRs := analysis.PRs
+	rememberRepo()
+
+	// Heuristics in effect, then the stats
+	displayEffectiveSettings(analysis.Stats.DefaultBranch)
+	displayStatsTable(analysis.Stats, sel)
+
+	// Changes vs the previous comparable run (recorded in the local history)
+	runHistory(analysis.Stats)
+
+	// Per-team/per-author breakdown (with --group-by, or per-author picked in interactive mode)
+	runGroupReport(processedPRs, analysis.Stats.DefaultBranch)
+	if sel.PerAuthor && groupBy != "author" {
+		displayAuthorStats(processedPRs, analysis.Stats.DefaultBranch)
+	}
+
+	if sel.PRMetrics {
+		// Weeks whose median lead time stands out from the rolling baseline
+		runLeadTimeAnomalies(processedPRs)
+
+		// Dependency update automation (Dependabot/Renovate)
+		displayDependencyStats(analysis.DependencyStats)
+
+		// Security fixes against their time-to-merge target
+		runSecurityReport(processedPRs, analysis.DependencyPRs)
+
+		// PRs closed without merging (wasted effort)
+		displayAbandonedReport(processedPRs)
+
+		// Concurrently open PRs per day/author (only with --wip)
+		runWIPReport(processedPRs)
+
+		// Stage gantt of the slowest merged PRs (only with --gantt-slowest)
+		runSlowestGantt(processedPRs)
+
+		// Description quality vs review outcomes
+		displayDescriptionReport(processedPRs)
+
+		// Conventional-commit title types and their lead times
+		displayPRTypeReport(processedPRs)
+
+		// PRs stacked on other PRs' branches and how their merge times compare
+		displayStackReport(processedPRs, analysis.Stats.DefaultBranch)
+
+		// External contributions from forks against the repository's own branches
+		displayForkReport(processedPRs)
+
+		// Knowledge concentration per directory (only with --bus-factor)
+		runOwnershipReport(processedPRs)
+
+		// Churn: merged code changed again within the window (only with --rework)
+		runReworkReport(processedPRs)
+
+		// Review depth and defects by PR size (only with --size-correlation)
+		runSizeCorrelationReport(processedPRs)
+
+		// Reported PR size vs the squash/merge commit that landed (only with --landed-size)
+		runLandedSizeReport(processedPRs)
+
+		// Issue → PR → merge traceability
+		displayTraceabilityReport(processedPRs)
+
+		// Jira issue-to-production lead time (only when configured)
+		runJiraReport(processedPRs, analysis.Stats.DefaultBranch)
+	}
+
+	if sel.ReviewMetrics {
+		// Co-authored-by trailers (pairing/mobbing)
+		displayPairingReport(processedPRs)
+
+		// Time to approval by number of requested reviewers (only with --approval-by-reviewers)
+		runApprovalByReviewersReport(processedPRs)
+
+		// Question/nit/blocking/approval mix of review comments (only with --comment-categories)
+		runCommentCategoryReport(processedPRs)
+
+		// Suggested-change blocks and how many were applied (only with --suggestions)
+		runSuggestionReport(processedPRs)
+
+		// Review response SLA (only when a target is configured)
+		runSLAReport(processedPRs)
+
+		// First maintainer response on PRs from forks (only when a target is configured)
+		runMaintainerResponseReport(processedPRs)
 	}
 
-	// Calculate lead times
-	processedPRs := CalculateLeadTimes(prs)
+	// Push metrics to external backends (only with --push)
+	runPRPush(processedPRs, analysis.Stats)
+
+	// Post a summary to chat webhooks (only with --notify)
+	runNotify(analysis.Stats)
 
-	// Fetch comment timing data
-	processedPRs = github.FetchPRCommentTiming(repo, processedPRs)
+	// Publish the summary to Confluence (only when configured)
+	runConfluencePublish(analysis.Stats)
 
-	// Fetch reopen events (for reopen rate / reopen→merge metrics)
-	processedPRs = github.FetchReopenEvents(repo, processedPRs)
+	// Comment the summary on a GitHub issue/PR (only with --post-to-issue)
+	runPostToIssue(analysis.Stats)
 
-	// Calculate stats
-	statistics := stats.CalculateStats(processedPRs)
+	// Per-author daily activity export (only with --activity-calendar)
+	runActivityCalendarExport(processedPRs)
 
-	// Display stats
-	displayStatsTable(statistics)
+	// Lead time and PR size charts (only with --chart-out or --chart-snippets)
+	runPRCharts(processedPRs)
 
 	// Output to CSV if requested
 	if csvOutput {
 		repoNameForFile := strings.ReplaceAll(repo, "/", "-")
 		csvFilename := fmt.Sprintf("visuche_%s.csv", repoNameForFile)
-		if err := csv.WritePullRequestsToCSV(csvFilename, processedPRs); err != nil {
+		if err := csv.WritePullRequestsToCSV(csvFilename, processedPRs, exportMetadata(metadata.PullRequests)); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
 			os.Exit(1)
 		}
 		fmt.Printf("📁 CSV output: %s\n", csvFilename)
 	}
+
+	// Output to JSON if requested
+	if jsonOutput {
+		repoNameForFile := strings.ReplaceAll(repo, "/", "-")
+		jsonFilename := fmt.Sprintf("visuche_%s.json", repoNameForFile)
+		if err := json.WritePullRequestsToJSON(jsonFilename, processedPRs, exportMetadata(metadata.PullRequests)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing JSON: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("📁 JSON output: %s\n", jsonFilename)
+	}
+
+	// Output to Parquet if requested
+	runPRExport(processedPRs)
+
+	return analysis
 }
 
 // getInteractiveRepo gets repository interactively
 func getInteractiveRepo() (string, error) {
-	detectedRepo, err := git.GetRepoFromGitRemote()
-	if err == nil {
+	// Recently analyzed repositories first; "Other" falls back to remote detection and manual entry
+	if recent := recentRepos(); len(recent) > 0 {
+		const other = "Other repository..."
+		items := make([]string, 0, len(recent)+1)
+		for _, r := range recent {
+			items = append(items, fmt.Sprintf("%s (last analyzed %s)", r.Repo, r.LastUsed.Format("2006-01-02")))
+		}
+		items = append(items, other)
+
 		prompt := promptui.Select{
-			Label: fmt.Sprintf("Found repository '%s' in current directory. Use this?", detectedRepo),
-			Items: []string{"Yes, use detected repo", "No, enter manually"},
+			Label: "Recently analyzed repositories",
+			Items: items,
+			Size:  len(items),
 		}
-		_, result, err := prompt.Run()
+		idx, _, err := prompt.Run()
 		if err != nil {
 			return "", fmt.Errorf("prompt failed %w", err)
 		}
+		if idx < len(recent) {
+			return recent[idx].Repo, nil
+		}
+	}
 
-		if result == "Yes, use detected repo" {
-			return detectedRepo, nil
+	if remoteName != "" {
+		detectedRepo, err := detectRepoFromRemote()
+		if err == nil {
+			prompt := promptui.Select{
+				Label: fmt.Sprintf("Found repository '%s' in current directory. Use this?", detectedRepo),
+				Items: []string{"Yes, use detected repo", "No, enter manually"},
+			}
+			_, result, err := prompt.Run()
+			if err != nil {
+				return "", fmt.Errorf("prompt failed %w", err)
+			}
+
+			if result == "Yes, use detected repo" {
+				return detectedRepo, nil
+			}
+		}
+	} else if candidates, err := detectRemoteCandidates(); err == nil && len(candidates) > 0 {
+		// List every detected remote so forks can pick upstream vs origin
+		const manualEntry = "Enter manually"
+		items := make([]string, 0, len(candidates)+1)
+		for _, c := range candidates {
+			items = append(items, fmt.Sprintf("%s (%s)", c.Repo, c.Name))
+		}
+		items = append(items, manualEntry)
+
+		prompt := promptui.Select{
+			Label: "Found repositories in current directory. Which one?",
+			Items: items,
+		}
+		idx, _, err := prompt.Run()
+		if err != nil {
+			return "", fmt.Errorf("prompt failed %w", err)
+		}
+
+		if idx < len(candidates) {
+			return candidates[idx].Repo, nil
 		}
 	}
 
 	// Manual entry
 	prompt := promptui.Prompt{
-		Label: "Enter GitHub repository (owner/repo format)",
-		Validate: func(input string) error {
-			if len(strings.Split(input, "/")) != 2 || strings.TrimSpace(input) == "" {
-				return fmt.Errorf("invalid format, please use 'owner/repo'")
-			}
-			return nil
-		},
+		Label:    "Enter GitHub repository (owner/repo format)",
+		Validate: validateRepoInput,
 	}
 	result, err := prompt.Run()
 	if err != nil {
@@ -454,24 +777,75 @@ func getInteractiveRepo() (string, error) {
 	return result, nil
 }
 
-// selectAnalysisType allows user to select analysis type
-func selectAnalysisType() (string, error) {
-	prompt := promptui.Select{
-		Label: "Select analysis type",
-		Items: []string{
-			"PR Analysis - Pull Request metrics and lead times",
-			"Actions Analysis - CI/CD performance and workflow insights",
-		},
+// analysisOption is one entry of the interactive analysis picker.
+type analysisOption struct {
+	Label    string
+	Selected *bool
+}
+
+// selectAnalyses lets the user toggle which analyses to run; PR and review metrics start selected.
+// The returned bool reports whether CI metrics were picked.
+func selectAnalyses() (reportSelection, bool, error) {
+	sel := reportSelection{PRMetrics: true, ReviewMetrics: true}
+	withCI := false
+	options := []analysisOption{
+		{"PR metrics - throughput, lead time, cycle stages, code changes", &sel.PRMetrics},
+		{"Review metrics - reviewers, review comments, review SLA", &sel.ReviewMetrics},
+		{"CI metrics - GitHub Actions success rate and durations", &withCI},
+		{"Per-author - PR and review metrics for each author", &sel.PerAuthor},
 	}
-	_, result, err := prompt.Run()
-	if err != nil {
-		return "", err
+	const run = "▶ Run selected analyses"
+
+	cursor := 0
+	for {
+		items := make([]string, 0, len(options)+1)
+		for _, o := range options {
+			mark := "[ ]"
+			if *o.Selected {
+				mark = "[x]"
+			}
+			items = append(items, mark+" "+o.Label)
+		}
+		items = append(items, run)
+
+		prompt := promptui.Select{
+			Label:     "Select analyses (Enter toggles)",
+			Items:     items,
+			Size:      len(items),
+			CursorPos: cursor,
+		}
+		index, _, err := prompt.Run()
+		if err != nil {
+			return sel, false, err
+		}
+		if index == len(options) {
+			if !sel.PRMetrics && !sel.ReviewMetrics && !sel.PerAuthor && !withCI {
+				fmt.Println("⚠️  Select at least one analysis")
+				continue
+			}
+			return sel, withCI, nil
+		}
+		*options[index].Selected = !*options[index].Selected
+		cursor = index
 	}
+}
 
-	if strings.HasPrefix(result, "Actions Analysis") {
-		return "Actions Analysis", nil
+// selectedAnalysisNames lists the picked analyses for the configuration summary.
+func selectedAnalysisNames(sel reportSelection, withCI bool) []string {
+	var names []string
+	if sel.PRMetrics {
+		names = append(names, "PR metrics")
 	}
-	return "PR Analysis", nil
+	if sel.ReviewMetrics {
+		names = append(names, "Review metrics")
+	}
+	if withCI {
+		names = append(names, "CI metrics")
+	}
+	if sel.PerAuthor {
+		names = append(names, "Per-author")
+	}
+	return names
 }
 
 // selectDateRange allows user to select date range with simplified options
@@ -484,8 +858,13 @@ func selectDateRange() (string, string, error) {
 			"Last 1 week",
 			"Last 2 weeks",
 			"Last 1 month",
+			"Last quarter",
+			"Year to date",
+			"Last sprint",
+			"Last N sprints",
 			"Custom range (flexible input)",
 		},
+		Size: 8,
 	}
 	_, result, err := prompt.Run()
 	if err != nil {
@@ -499,6 +878,24 @@ func selectDateRange() (string, string, error) {
 		return now.AddDate(0, 0, -14).Format("2006-01-02"), now.Format("2006-01-02"), nil
 	case "Last 1 month":
 		return now.AddDate(0, -1, 0).Format("2006-01-02"), now.Format("2006-01-02"), nil
+	case "Last quarter", "Year to date", "Last sprint":
+		return presetDateRange(result, now)
+	case "Last N sprints":
+		countPrompt := promptui.Prompt{
+			Label:   "How many sprints?",
+			Default: "3",
+			Validate: func(input string) error {
+				if n, err := strconv.Atoi(strings.TrimSpace(input)); err != nil || n < 1 {
+					return fmt.Errorf("enter a positive number")
+				}
+				return nil
+			},
+		}
+		count, err := countPrompt.Run()
+		if err != nil {
+			return "", "", err
+		}
+		return presetDateRange(fmt.Sprintf("last %s sprints", strings.TrimSpace(count)), now)
 	case "Custom range (flexible input)":
 		return getEnhancedCustomDateRange()
 	default:
@@ -506,6 +903,16 @@ func selectDateRange() (string, string, error) {
 	}
 }
 
+// presetDateRange resolves a period expression to YYYY-MM-DD bounds.
+func presetDateRange(expr string, now time.Time) (string, string, error) {
+	start, end, _, err := ParseDateRange(expr, now, cfg.Sprint)
+	if err != nil {
+		return "", "", err
+	}
+	fmt.Printf("✅ Selected period: %s to %s\n", start.Format("2006-01-02"), end.Format("2006-01-02"))
+	return start.Format("2006-01-02"), end.Format("2006-01-02"), nil
+}
+
 // getCustomDateRange gets custom date range from user (legacy function)
 func getCustomDateRange() (string, string, error) {
 	return getEnhancedCustomDateRange()
@@ -521,7 +928,8 @@ func getEnhancedCustomDateRange() (string, string, error) {
 	fmt.Println("  • YYYY-MM-DD (e.g., 2024-01-15)")
 	fmt.Println("  • Relative: '30 days ago', '2 weeks ago', '3 months ago'")
 	fmt.Println("  • Keywords: 'today', 'yesterday', 'last monday'")
-	fmt.Println("  • Shortcuts: '2024-01' (whole month), '2024-Q1' (quarter)")
+	fmt.Println("  • Shortcuts: '2024-01' (whole month), '2024-Q1' (quarter), '2024-W15' (ISO week)")
+	fmt.Println("  • Periods: 'last quarter', 'ytd', 'last sprint', 'last 3 sprints'")
 	fmt.Println()
 
 	// Start date input with enhanced parsing
@@ -541,10 +949,15 @@ func getEnhancedCustomDateRange() (string, string, error) {
 	}
 
 	startDate, _ := ParseFlexibleDate(startInput, now)
+	// A period as the start date also suggests its own end date
+	periodEnd := now
+	if _, end, ok, err := ParseDateRange(startInput, now, cfg.Sprint); ok && err == nil {
+		periodEnd = end
+	}
 
 	// End date input with smart defaults
 	endPrompt := promptui.Prompt{
-		Label: fmt.Sprintf("Enter end date (default: today - %s)", now.Format("2006-01-02")),
+		Label: fmt.Sprintf("Enter end date (default: %s)", periodEnd.Format("2006-01-02")),
 		Validate: func(input string) error {
 			if strings.TrimSpace(input) == "" {
 				return nil // Allow empty for default
@@ -563,7 +976,9 @@ func getEnhancedCustomDateRange() (string, string, error) {
 
 	var endDate time.Time
 	if strings.TrimSpace(endInput) == "" {
-		endDate = now // Default to today
+		endDate = periodEnd // Default to today, or the end of the period entered as the start
+	} else if _, end, ok, err := ParseDateRange(endInput, now, cfg.Sprint); ok && err == nil {
+		endDate = end
 	} else {
 		endDate, _ = ParseFlexibleDate(endInput, now)
 	}
@@ -580,37 +995,58 @@ func getEnhancedCustomDateRange() (string, string, error) {
 	return startDate.Format("2006-01-02"), endDate.Format("2006-01-02"), nil
 }
 
-// selectOptionalFilters allows user to set optional filters
+// selectOptionalFilters offers the authors and labels of the PRs in the selected period as searchable lists.
+// The PRs are kept for the analysis, which then doesn't fetch the period again.
 func selectOptionalFilters() (string, string) {
-	var selectedAuthor, selectedLabel string
-
-	// Author filter
-	authorPrompt := promptui.Select{
-		Label: "Filter by author?",
-		Items: []string{"No filter", "Specify author"},
-	}
-	_, authorResult, err := authorPrompt.Run()
-	if err == nil && authorResult == "Specify author" {
-		prompt := promptui.Prompt{
-			Label: "Enter GitHub username",
+	var authors, labels []stats.NamedCount
+	if p, err := newProvider(); err == nil {
+		if prs, err := p.FetchPullRequests(repo, since, until, "", "", true); err == nil {
+			authors = stats.PRAuthors(prs)
+			labels = stats.PRLabels(prs)
+			pickerPRs = cachedPRs{repo: repo, since: since, until: until, prs: prs}
+		} else {
+			fmt.Printf("⚠️  Could not load authors and labels, falling back to free text: %v\n", firstLine(err.Error()))
 		}
-		selectedAuthor, _ = prompt.Run()
 	}
 
-	// Label filter
-	labelPrompt := promptui.Select{
-		Label: "Filter by label?",
-		Items: []string{"No filter", "Specify label"},
+	selectedAuthor := pickFilterValue("Filter by author?", "Enter GitHub username", authors)
+	selectedLabel := pickFilterValue("Filter by label?", "Enter label name", labels)
+	return selectedAuthor, selectedLabel
+}
+
+// pickFilterValue lets the user pick one of options (type to search) or enter a value by hand.
+// It returns "" for no filter.
+func pickFilterValue(label, manualLabel string, options []stats.NamedCount) string {
+	const noFilter = "No filter"
+	const manual = "Enter manually"
+
+	items := []string{noFilter}
+	for _, o := range options {
+		items = append(items, fmt.Sprintf("%s (%d PRs)", o.Name, o.Count))
 	}
-	_, labelResult, err := labelPrompt.Run()
-	if err == nil && labelResult == "Specify label" {
-		prompt := promptui.Prompt{
-			Label: "Enter label name",
-		}
-		selectedLabel, _ = prompt.Run()
+	items = append(items, manual)
+
+	prompt := promptui.Select{
+		Label: label,
+		Items: items,
+		Size:  10,
+		Searcher: func(input string, index int) bool {
+			return strings.Contains(strings.ToLower(items[index]), strings.ToLower(strings.TrimSpace(input)))
+		},
+	}
+	index, _, err := prompt.Run()
+	if err != nil || index == 0 {
+		return ""
+	}
+	if index <= len(options) {
+		return options[index-1].Name
 	}
 
-	return selectedAuthor, selectedLabel
+	manualPrompt := promptui.Prompt{
+		Label: manualLabel,
+	}
+	value, _ := manualPrompt.Run()
+	return strings.TrimSpace(value)
 }
 
 // ParseFlexibleDate parses various date input formats
@@ -681,5 +1117,10 @@ func ParseFlexibleDate(input string, baseDate time.Time) (time.Time, error) {
 		}
 	}
 
+	// Period presets ("last quarter", "2024-W15", ...) stand for their first day
+	if start, _, ok, err := ParseDateRange(input, baseDate, cfg.Sprint); ok {
+		return start, err
+	}
+
 	return time.Time{}, fmt.Errorf("unrecognized date format: %s", input)
 }
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/csv"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/json"
+	"visuche/internal/metadata"
+	"visuche/internal/progress"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scorecardOrg   string
+	scorecardLimit int
+	scorecardSort  string
+	scorecardJobs  int
+)
+
+var scorecardCmd = &cobra.Command{
+	Use:   "scorecard [owner/repo...]",
+	Short: "Show a one-row-per-repository health scorecard",
+	Long: `Summarize many repositories in one compact table: merged PRs, median lead time, merge rate and
+CI success rate for the period (--since/--until, default the last month through today), plus the number
+and median age of currently open PRs. List the repositories as arguments or scan an organization with --org.
+--csv/--json export the scorecard to visuche_scorecard.csv/.json.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runScorecard(args)
+	},
+}
+
+func init() {
+	scorecardCmd.Flags().StringVar(&scorecardOrg, "org", "", "Scan the non-archived repositories of this organization or user")
+	scorecardCmd.Flags().IntVar(&scorecardLimit, "limit", 100, "Maximum number of repositories to scan with --org")
+	scorecardCmd.Flags().IntVar(&scorecardJobs, "parallel", 4, "Repositories fetched at once (all share the --max-concurrency/--rate-limit budget)")
+	scorecardCmd.Flags().StringVar(&scorecardSort, "sort", "repo", "Sort by column: "+strings.Join(stats.ScorecardColumns, ", "))
+	rootCmd.AddCommand(scorecardCmd)
+}
+
+func runScorecard(repos []string) {
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	if err := stats.SortScorecard(nil, scorecardSort); err != nil {
+		exitWithError("Error", err)
+	}
+
+	if scorecardOrg != "" {
+		lister, ok := p.(provider.RepoLister)
+		if !ok {
+			exitWithError("Error", fmt.Errorf("the %s provider cannot list repositories; pass them as arguments", p.Name()))
+		}
+		listed, err := lister.ListRepositories(scorecardOrg, scorecardLimit)
+		if err != nil {
+			exitWithError("Error listing repositories", err)
+		}
+		repos = append(repos, listed...)
+	}
+	if len(repos) == 0 {
+		exitWithError("Error", fmt.Errorf("no repositories: pass owner/repo arguments or --org"))
+	}
+
+	now := time.Now()
+	if since == "" {
+		since = now.AddDate(0, -1, 0).Format("2006-01-02")
+	}
+	if until == "" {
+		until = now.Format("2006-01-02")
+	}
+	fmt.Printf(i18n.Sprintf("📊 Period: %s to %s\n", since, until))
+
+	rows := scanScorecard(p, repos, now)
+	stats.SortScorecard(rows, scorecardSort)
+
+	displayScorecard(rows)
+
+	meta := metadata.New(metadata.Scorecard, metadata.Scope{Since: since, Until: until}, now)
+	if csvOutput {
+		if err := csv.WriteScorecardToCSV("visuche_scorecard.csv", rows, meta); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		} else {
+			fmt.Println("📁 CSV output: visuche_scorecard.csv")
+		}
+	}
+	if jsonOutput {
+		if err := json.WriteScorecardToJSON("visuche_scorecard.json", rows, meta); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing JSON: %v\n", err)
+		} else {
+			fmt.Println("📁 JSON output: visuche_scorecard.json")
+		}
+	}
+}
+
+// scanScorecard builds the rows of repos with up to --parallel repositories in flight. Their calls go
+// through the process-wide transport scheduler, so the API budget is shared rather than multiplied.
+func scanScorecard(p provider.Provider, repos []string, now time.Time) []stats.ScorecardRow {
+	workers := scorecardJobs
+	if workers < 1 {
+		workers = 1
+	}
+	type job struct {
+		index int
+		repo  string
+	}
+	queue := make(chan job, len(repos))
+	results := make(chan job, len(repos))
+	rows := make([]stats.ScorecardRow, len(repos))
+	for w := 0; w < workers; w++ {
+		go func() {
+			for j := range queue {
+				rows[j.index] = scorecardRow(p, j.repo, now)
+				results <- j
+			}
+		}()
+	}
+	for i, r := range repos {
+		queue <- job{i, r}
+	}
+	close(queue)
+	stage := progress.Start("scan_repositories", len(repos))
+	defer stage.End()
+	for done := 1; done <= len(repos); done++ {
+		j := <-results
+		stage.Add(1)
+		fmt.Printf(i18n.Sprintf("📥 [%d/%d] Analyzed %s\n", done, len(repos), j.repo))
+	}
+	return rows
+}
+
+// scorecardRow fetches what the scorecard needs for one repository. The PRs are not enriched, so a scan
+// costs a few API calls per repository; failures are recorded on the row instead of stopping the scan.
+func scorecardRow(p provider.Provider, r string, now time.Time) stats.ScorecardRow {
+	prs, err := p.FetchPullRequests(r, since, until, author, label, true)
+	if err != nil {
+		return stats.ScorecardRow{Repo: r, Err: err.Error()}
+	}
+	prs, _ = github.SplitBotPRs(prs)
+
+	openPRs, err := p.FetchOpenPullRequests(r)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch open PRs of %s: %v\n", r, err)
+	}
+	openPRs, _ = github.SplitBotPRs(openPRs)
+
+	runs, err := p.FetchWorkflowRuns(r, since, until)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch workflow runs of %s: %v\n", r, err)
+	}
+	runs = actions.FilterRunsByDate(runs, since, until)
+
+	return stats.BuildScorecardRow(r, prs, openPRs, runs, now)
+}
+
+func displayScorecard(rows []stats.ScorecardRow) {
+	fmt.Println("\n" + i18n.T("🩺 Repository Scorecard:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Repository"), i18n.T("Merged PRs"), i18n.T("Lead Time (median)"), i18n.T("Merge Rate"),
+		i18n.T("CI Success"), i18n.T("Open PRs"), i18n.T("Open PR Age (median)")})
+	table.SetBorder(true)
+	for _, r := range rows {
+		if r.Err != "" {
+			table.Append([]string{r.Repo, "-", "-", "-", "-", "-", "⚠️  " + firstLine(r.Err)})
+			continue
+		}
+		ci := "-"
+		if r.CIRuns > 0 {
+			ci = fmt.Sprintf("%.1f%%", r.CISuccessRate)
+		}
+		table.Append([]string{
+			r.Repo,
+			fmt.Sprintf("%d", r.MergedPRs),
+			formatDuration(r.MedianLeadTime),
+			fmt.Sprintf("%.1f%%", r.MergeRate),
+			ci,
+			fmt.Sprintf("%d", r.OpenPRs),
+			formatDuration(r.MedianOpenAge),
+		})
+	}
+	table.Render()
+}
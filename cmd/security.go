@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var slaSecurityMerge string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&slaSecurityMerge, "sla-security-merge", "", "Time-to-merge target for security PRs, e.g. 72h (overrides config, default 168h)")
+}
+
+// securityTarget resolves the security PR time-to-merge target from flags and config.
+func securityTarget() (time.Duration, error) {
+	value := cfg.SLA.SecurityMerge
+	if slaSecurityMerge != "" {
+		value = slaSecurityMerge
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid security merge SLA %q: use a duration like 72h", value)
+	}
+	return d, nil
+}
+
+// runSecurityReport reports security-related PRs, human and dependency updates alike, against the target.
+func runSecurityReport(prs, dependencyPRs []github.PullRequest) {
+	target, err := securityTarget()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+	all := append(append([]github.PullRequest{}, prs...), dependencyPRs...)
+	displaySecurityReport(stats.CalculateSecurityStats(all, target, time.Now()))
+}
+
+func displaySecurityReport(s stats.SecurityStats) {
+	if s.Opened == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🔒 Security PRs:"))
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("Opened"), fmt.Sprintf("%d", s.Opened)})
+	summaryTable.Append([]string{i18n.T("Merged"), fmt.Sprintf("%d", s.Merged)})
+	summaryTable.Append([]string{i18n.T("Closed without merge"), fmt.Sprintf("%d", s.Closed)})
+	summaryTable.Append([]string{i18n.T("Still open"), fmt.Sprintf("%d", s.Open)})
+	summaryTable.Append([]string{i18n.T("Time to Merge (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(s.AverageTimeToMerge), formatDuration(s.MedianTimeToMerge))})
+	if s.Merged > 0 {
+		summaryTable.Append([]string{i18n.Sprintf("Merged within %s", formatDuration(s.Target)), fmt.Sprintf("%d / %d (%.1f%%)", s.MergedWithinTarget, s.Merged, s.AttainmentRate())})
+	}
+	summaryTable.Append([]string{i18n.T("SLA breaches"), fmt.Sprintf("%d", s.Breached)})
+	summaryTable.Render()
+
+	prTable := tablewriter.NewWriter(os.Stdout)
+	prTable.SetHeader([]string{i18n.T("PR"), i18n.T("Title"), i18n.T("Source"), i18n.T("State"), i18n.T("Time to Merge / Age"), i18n.T("SLA")})
+	prTable.SetBorder(true)
+	for i, p := range s.PRs {
+		if i >= 10 {
+			break
+		}
+		state := i18n.T("merged")
+		if !p.PR.Merged {
+			state = i18n.T("open")
+		}
+		title := p.PR.Title
+		if len([]rune(title)) > 50 {
+			title = string([]rune(title)[:47]) + "..."
+		}
+		status := "✅"
+		if p.Breached {
+			status = "❌"
+		}
+		prTable.Append([]string{fmt.Sprintf("#%d", p.PR.Number), title, p.Source, state, formatDuration(p.Elapsed), status})
+	}
+	prTable.Render()
+	if len(s.PRs) > 10 {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(s.PRs)-10))
+	}
+}
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	encjson "encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/cache"
+	"visuche/internal/json"
+	"visuche/internal/provider"
+	"visuche/internal/stats"
+	"visuche/internal/web"
+
+	"github.com/spf13/cobra"
+)
+
+var serveAddr string
+var serveCacheTTL time.Duration
+var serveCacheDir string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve a metrics dashboard and HTTP JSON API",
+	Long: `Serve a dashboard at / and REST endpoints so dashboards can query visuche without shelling out to the CLI:
+
+  GET /api/stats?repo=owner/repo&since=YYYY-MM-DD&until=YYYY-MM-DD[&author=][&label=]
+  GET /api/prs?repo=...&since=...&until=...
+  GET /api/actions?repo=...&since=...&until=...
+  GET /api/timeseries?repo=...&metric=lead_time_median_hours  (weekly buckets)
+  /grafana/                                                    (Grafana JSON datasource)
+
+Results are cached on disk for --cache-ttl, so repeated queries don't hit the API.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runServe()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:8080", "Address to listen on")
+	serveCmd.Flags().DurationVar(&serveCacheTTL, "cache-ttl", 15*time.Minute, "How long cached results are served before re-fetching")
+	serveCmd.Flags().StringVar(&serveCacheDir, "cache-dir", "", "Cache directory (default: user cache dir)/visuche")
+}
+
+// apiServer serves the JSON API. Fetches are serialized to stay within API rate limits.
+type apiServer struct {
+	provider provider.Provider
+	cache    *cache.Cache
+	mu       sync.Mutex
+}
+
+// prAnalysisResponse is the cached PR result shared by /api/stats, /api/prs and the time series endpoints.
+type prAnalysisResponse struct {
+	Stats  map[string]interface{}   `json:"stats"`
+	PRs    []json.PullRequestRecord `json:"prs"`
+	Series map[string][]stats.Point `json:"series"`
+}
+
+// actionsAnalysisResponse is the cached CI result shared by /api/actions and the time series endpoints.
+type actionsAnalysisResponse struct {
+	Analytics actions.WorkflowAnalytics `json:"analytics"`
+	Series    map[string][]stats.Point  `json:"series"`
+}
+
+func runServe() {
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	dir := serveCacheDir
+	if dir == "" {
+		dir = cache.DefaultDir()
+	}
+	server := &apiServer{provider: p, cache: cache.New(dir, serveCacheTTL)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/stats", server.handleStats)
+	mux.HandleFunc("/api/prs", server.handlePRs)
+	mux.HandleFunc("/api/actions", server.handleActions)
+	mux.HandleFunc("/api/timeseries", server.handleTimeseries)
+	mux.HandleFunc("/grafana/", server.handleGrafanaHealth)
+	mux.HandleFunc("/grafana/metrics", server.handleGrafanaMetrics)
+	mux.HandleFunc("/grafana/query", server.handleGrafanaQuery)
+	mux.Handle("/", http.FileServer(http.FS(web.Static())))
+
+	fmt.Printf("🌐 Dashboard and API on http://%s (cache: %s, ttl %s)\n", serveAddr, dir, serveCacheTTL)
+	if err := http.ListenAndServe(serveAddr, mux); err != nil {
+		exitWithError("Error", err)
+	}
+}
+
+func (s *apiServer) handleStats(w http.ResponseWriter, r *http.Request) {
+	query, err := parseAPIQuery(r)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	result, err := s.prAnalysis(query)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, result.Stats)
+}
+
+func (s *apiServer) handlePRs(w http.ResponseWriter, r *http.Request) {
+	query, err := parseAPIQuery(r)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	result, err := s.prAnalysis(query)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, result.PRs)
+}
+
+func (s *apiServer) handleActions(w http.ResponseWriter, r *http.Request) {
+	query, err := parseAPIQuery(r)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	result, err := s.actionsAnalysis(query)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, result.Analytics)
+}
+
+// actionsAnalysis returns the cached workflow analysis for query, fetching runs on a miss.
+func (s *apiServer) actionsAnalysis(query apiQuery) (actionsAnalysisResponse, error) {
+	var result actionsAnalysisResponse
+	key := strings.Join([]string{"actions", s.provider.Name(), query.repo, query.since, query.until}, "|")
+	if s.cache.Get(key, &result) {
+		return result, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs, err := s.provider.FetchWorkflowRuns(query.repo, query.since, query.until)
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch workflow runs: %w", err)
+	}
+	result.Analytics = actions.AnalyzeWorkflowRuns(runs, query.since, query.until)
+	result.Analytics.FailureDetails = s.provider.FetchFailureDetails(query.repo, runs, result.Analytics.FailureDetails, failureDetailLimit)
+	result.Series = stats.WeeklyCISeries(actions.FilterRunsByDate(runs, query.since, query.until))
+
+	if err := s.cache.Put(key, result); err != nil {
+		log.Printf("cache write failed: %v", err)
+	}
+	return result, nil
+}
+
+// prAnalysis returns the cached PR analysis for the request, running the pipeline on a miss.
+func (s *apiServer) prAnalysis(query apiQuery) (prAnalysisResponse, error) {
+	var result prAnalysisResponse
+	key := strings.Join([]string{"prs", s.provider.Name(), query.repo, query.since, query.until, query.author, query.label}, "|")
+	if s.cache.Get(key, &result) {
+		return result, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	analysis, err := analyzePullRequests(s.provider, query.repo, query.since, query.until, query.author, query.label)
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch pull requests: %w", err)
+	}
+	result = prAnalysisResponse{
+		Stats:  json.StatsRecord(analysis.Stats),
+		PRs:    json.PullRequestRecords(analysis.PRs),
+		Series: stats.WeeklyPRSeries(analysis.PRs),
+	}
+
+	if err := s.cache.Put(key, result); err != nil {
+		log.Printf("cache write failed: %v", err)
+	}
+	return result, nil
+}
+
+// apiQuery holds the common query parameters.
+type apiQuery struct {
+	repo, since, until, author, label string
+}
+
+// apiError is an error caused by the request rather than the server.
+type apiError struct{ msg string }
+
+func (e apiError) Error() string { return e.msg }
+
+// parseAPIQuery reads and validates the common query parameters. repo falls back to --repo.
+func parseAPIQuery(r *http.Request) (apiQuery, error) {
+	values := r.URL.Query()
+	query := apiQuery{
+		repo:   values.Get("repo"),
+		since:  values.Get("since"),
+		until:  values.Get("until"),
+		author: values.Get("author"),
+		label:  values.Get("label"),
+	}
+	if query.repo == "" {
+		query.repo = repo
+	}
+	if query.repo == "" {
+		return query, apiError{"repo is required"}
+	}
+	if err := validateRepoInput(query.repo); err != nil {
+		return query, apiError{err.Error()}
+	}
+	for _, date := range []string{query.since, query.until} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return query, apiError{fmt.Sprintf("invalid date %q: use YYYY-MM-DD", date)}
+		}
+	}
+	return query, nil
+}
+
+// writeAPIJSON writes v as a JSON response.
+func writeAPIJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := encjson.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
+
+// writeAPIError writes err as a JSON error response: 400 for bad requests, 502 for upstream failures.
+func writeAPIError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	if _, ok := err.(apiError); ok {
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encjson.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var sizeCorrelationReport bool
+var sizeThreshold int
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&sizeCorrelationReport, "size-correlation", false, "Report review depth and defect proxies by PR size (one extra API call per merged PR)")
+	rootCmd.PersistentFlags().IntVar(&sizeThreshold, "size-threshold", 400, "Changed-line guideline the size report splits PRs at")
+}
+
+// runSizeCorrelationReport displays the size correlation report when --size-correlation is set.
+func runSizeCorrelationReport(prs []github.PullRequest) {
+	if !sizeCorrelationReport {
+		return
+	}
+	if sizeThreshold <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --size-threshold must be positive\n")
+		return
+	}
+	displaySizeCorrelationReport(stats.CalculateSizeCorrelation(prs, sizeThreshold))
+}
+
+// displaySizeCorrelationReport shows review depth per size bucket, the guideline split and the correlations.
+func displaySizeCorrelationReport(report stats.SizeCorrelationReport) {
+	if len(report.Buckets) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("📏 Review Depth by PR Size (merged PRs):"))
+	header := []string{
+		i18n.T("Size"),
+		i18n.T("PRs"),
+		i18n.T("Review Comments (avg)"),
+		i18n.T("Review Rounds (avg)"),
+		i18n.T("Time to Approval (avg)"),
+		i18n.T("Later Revert/Hotfix"),
+	}
+	row := func(b stats.SizeBucket) []string {
+		defects := "-"
+		if report.HasFileData {
+			defects = fmt.Sprintf("%d (%.1f%%)", b.Defects, b.DefectRate)
+		}
+		return []string{
+			b.Size,
+			fmt.Sprintf("%d", b.PRs),
+			fmt.Sprintf("%.1f", b.AverageReviewComments),
+			fmt.Sprintf("%.1f", b.AverageReviewRounds),
+			formatDuration(b.AverageTimeToApproval),
+			defects,
+		}
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetBorder(true)
+	for _, b := range report.Buckets {
+		table.Append(row(b))
+	}
+	table.Render()
+
+	fmt.Println(i18n.Sprintf("Split at the %d-line guideline:", sizeThreshold))
+	split := tablewriter.NewWriter(os.Stdout)
+	split.SetHeader(header)
+	split.SetBorder(true)
+	for _, b := range report.Threshold {
+		split.Append(row(b))
+	}
+	split.Render()
+
+	defectCorrelation := "-"
+	if report.HasFileData {
+		defectCorrelation = fmt.Sprintf("%.2f", report.DefectCorrelation)
+	}
+	fmt.Println(i18n.Sprintf("Correlation with changed lines (r): review comments %.2f, review rounds %.2f, later revert/hotfix %s",
+		report.CommentsCorrelation, report.RoundsCorrelation, defectCorrelation))
+	fmt.Println(i18n.T("Sizes: XS <10, S <100, M <500, L <1000, XL ≥1000 changed lines. Rounds = change requests + 1."))
+}
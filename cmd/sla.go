@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+)
+
+var slaFirstReview string
+var slaBusinessHours bool
+
+// slaBusinessHoursFlag tells whether --sla-business-hours was given explicitly.
+var slaBusinessHoursFlag *pflag.Flag
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&slaFirstReview, "sla-first-review", "", "First review SLA target, e.g. 4h (overrides config)")
+	rootCmd.PersistentFlags().BoolVar(&slaBusinessHours, "sla-business-hours", false, "Measure the first review SLA in business hours (overrides config)")
+	slaBusinessHoursFlag = rootCmd.PersistentFlags().Lookup("sla-business-hours")
+}
+
+// slaUseBusinessHours reports whether SLAs are measured in business hours. An explicit --sla-business-hours
+// wins either way, so --sla-business-hours=false turns off sla.businessHours from the config.
+func slaUseBusinessHours() bool {
+	if slaBusinessHoursFlag.Changed {
+		return slaBusinessHours
+	}
+	return cfg.SLA.BusinessHours
+}
+
+// slaTarget resolves the SLA target from flags and config. ok is false when no target is configured.
+func slaTarget() (target stats.SLATarget, ok bool, err error) {
+	firstReview := cfg.SLA.FirstReview
+	if slaFirstReview != "" {
+		firstReview = slaFirstReview
+	}
+	if firstReview == "" {
+		return target, false, nil
+	}
+
+	d, err := time.ParseDuration(firstReview)
+	if err != nil || d <= 0 {
+		return target, false, fmt.Errorf("invalid first review SLA %q: use a duration like 4h or 30m", firstReview)
+	}
+
+	return stats.SLATarget{
+		FirstReview:   d,
+		BusinessHours: slaUseBusinessHours(),
+		WorkdayStart:  cfg.SLA.WorkdayStart,
+		WorkdayEnd:    cfg.SLA.WorkdayEnd,
+	}, true, nil
+}
+
+// runSLAReport calculates and displays the SLA report when a target is configured.
+func runSLAReport(prs []github.PullRequest) {
+	target, ok, err := slaTarget()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	displaySLAReport(stats.CalculateSLA(prs, target, time.Now()))
+}
+
+// displaySLAReport displays first review SLA attainment per week, per reviewer, and the violating PRs.
+func displaySLAReport(report stats.SLAReport) {
+	targetLabel := formatDuration(report.Target.FirstReview)
+	if report.Target.BusinessHours {
+		targetLabel = i18n.Sprintf("%s (business hours)", targetLabel)
+	}
+
+	fmt.Println("\n" + i18n.T("⏰ Review Response SLA:"))
+	fmt.Println("=" + strings.Repeat("=", 50))
+	fmt.Println(i18n.Sprintf("Target: first review within %s", targetLabel))
+
+	if report.Evaluated == 0 {
+		fmt.Println(i18n.T("No PRs to evaluate in this period"))
+		return
+	}
+
+	summaryTable := tablewriter.NewWriter(os.Stdout)
+	summaryTable.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summaryTable.SetBorder(true)
+	summaryTable.Append([]string{i18n.T("PRs Evaluated"), fmt.Sprintf("%d", report.Evaluated)})
+	summaryTable.Append([]string{i18n.T("Within SLA"), fmt.Sprintf("%d", report.Met)})
+	summaryTable.Append([]string{i18n.T("SLA Attainment"), fmt.Sprintf("%.1f%%", report.Attainment)})
+	summaryTable.Render()
+
+	fmt.Println("\n" + i18n.T("📅 SLA by Week:"))
+	displaySLABuckets(i18n.T("Week"), report.Weekly)
+
+	if len(report.Reviewers) > 0 {
+		fmt.Println("\n" + i18n.T("👤 SLA by Reviewer:"))
+		displaySLABuckets(i18n.T("Reviewer"), report.Reviewers)
+	}
+
+	if len(report.Violations) > 0 {
+		fmt.Println("\n" + i18n.T("🚨 SLA Violations:"))
+		displaySLAViolations(report.Violations, i18n.T("Reviewer"), i18n.T("(awaiting review)"))
+	}
+}
+
+// displaySLAViolations lists the 20 longest waits; responderHeader and awaiting label the person
+// who responded and the PRs still waiting.
+func displaySLAViolations(violations []stats.SLAViolation, responderHeader, awaiting string) {
+	violationTable := tablewriter.NewWriter(os.Stdout)
+	violationTable.SetHeader([]string{"PR", i18n.T("Title"), i18n.T("Author"), responderHeader, i18n.T("Created"), i18n.T("Wait"), "URL"})
+	violationTable.SetBorder(true)
+	for i, v := range violations {
+		if i >= 20 { // Limit to the 20 longest waits
+			break
+		}
+		responder := v.Reviewer
+		if responder == "" {
+			responder = awaiting
+		}
+		violationTable.Append([]string{
+			fmt.Sprintf("#%d", v.Number),
+			v.Title,
+			v.Author,
+			responder,
+			v.CreatedAt.Format("2006-01-02 15:04"),
+			formatDuration(v.Wait),
+			v.URL,
+		})
+	}
+	violationTable.Render()
+	if len(violations) > 20 {
+		fmt.Printf(i18n.Sprintf("... and %d more violations\n", len(violations)-20))
+	}
+}
+
+// displaySLABuckets renders SLA buckets keyed by week or reviewer.
+func displaySLABuckets(keyHeader string, buckets []stats.SLABucket) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{keyHeader, i18n.T("Evaluated"), i18n.T("Within SLA"), i18n.T("SLA Attainment")})
+	table.SetBorder(true)
+	for _, b := range buckets {
+		table.Append([]string{b.Key, fmt.Sprintf("%d", b.Evaluated), fmt.Sprintf("%d", b.Met), fmt.Sprintf("%.1f%%", b.Attainment)})
+	}
+	table.Render()
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// maxStacks is how many stacks the stacked PR report lists.
+const maxStacks = 10
+
+// displayStackReport compares stacked PRs with standalone ones and lists the largest stacks. Nothing
+// is shown when no PR is stacked on another.
+func displayStackReport(prs []github.PullRequest, defaultBranch string) {
+	report := stats.CalculateStackReport(prs, defaultBranch)
+	if len(report.Stacks) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🥞 Stacked PRs:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Stacked"), i18n.T("Standalone")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("PRs"), fmt.Sprintf("%d", report.Stacked.PRs), fmt.Sprintf("%d", report.Standalone.PRs)})
+	table.Append([]string{i18n.T("Merged"), fmt.Sprintf("%d", report.Stacked.Merged), fmt.Sprintf("%d", report.Standalone.Merged)})
+	table.Append([]string{i18n.T("Median Lead Time"), formatDuration(report.Stacked.MedianLeadTime), formatDuration(report.Standalone.MedianLeadTime)})
+	table.Append([]string{i18n.T("Median Approval→Merge Time"), formatDuration(report.Stacked.MedianMergeTime), formatDuration(report.Standalone.MedianMergeTime)})
+	table.Render()
+
+	sizes := make([]int, 0, len(report.StackSizes))
+	for size := range report.StackSizes {
+		sizes = append(sizes, size)
+	}
+	sort.Ints(sizes)
+	var parts []string
+	for _, size := range sizes {
+		parts = append(parts, i18n.Sprintf("%d PRs × %d", size, report.StackSizes[size]))
+	}
+	fmt.Println(i18n.Sprintf("%d stacks by size: %s", len(report.Stacks), strings.Join(parts, ", ")))
+
+	stackTable := tablewriter.NewWriter(os.Stdout)
+	stackTable.SetHeader([]string{i18n.T("PRs"), i18n.T("Depth"), i18n.T("Merged"), i18n.T("Open"), i18n.T("Stack")})
+	stackTable.SetBorder(true)
+	for i, s := range report.Stacks {
+		if i >= maxStacks {
+			break
+		}
+		numbers := make([]string, 0, len(s.PRs))
+		for _, pr := range s.PRs {
+			numbers = append(numbers, fmt.Sprintf("#%d", pr.Number))
+		}
+		stackTable.Append([]string{
+			fmt.Sprintf("%d", len(s.PRs)),
+			fmt.Sprintf("%d", s.Depth),
+			fmt.Sprintf("%d", s.Merged),
+			fmt.Sprintf("%d", s.Open),
+			fmt.Sprintf("%s (%s)", strings.Join(numbers, " → "), s.PRs[0].HeadRefName),
+		})
+	}
+	stackTable.Render()
+	if len(report.Stacks) > maxStacks {
+		fmt.Print(i18n.Sprintf("... and %d more\n", len(report.Stacks)-maxStacks))
+	}
+	fmt.Println(i18n.T("💡 A PR is stacked when its base branch is another open PR's head branch; PRs retargeted after their parent merged count as standalone."))
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var slowStepsReport bool
+
+// maxSlowSteps is the length of the slowest-step leaderboard.
+const maxSlowSteps = 20
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&slowStepsReport, "slow-steps", false, "Report the 20 workflow steps that consumed the most CI time (one extra API call per run)")
+}
+
+// runSlowStepsReport ranks workflow steps by the total time they consumed across the runs (only with --slow-steps).
+func runSlowStepsReport(details *ciDetails) {
+	if !slowStepsReport {
+		return
+	}
+	jobs, ok := details.Jobs()
+	if !ok {
+		fmt.Println("⚠️  --slow-steps is not supported by this provider")
+		return
+	}
+
+	displaySlowSteps(actions.CalculateStepStats(details.runs, jobs))
+}
+
+func displaySlowSteps(steps []actions.StepStats) {
+	fmt.Println("\n" + i18n.T("🐢 Slowest Steps (total time):"))
+	if len(steps) == 0 {
+		fmt.Println(i18n.T("No step timings available for these runs"))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", i18n.T("Workflow"), i18n.T("Job"), i18n.T("Step"), i18n.T("Total Minutes"), i18n.T("Runs"), i18n.T("Avg Duration"), i18n.T("Max")})
+	table.SetBorder(true)
+	for i, s := range steps {
+		if i >= maxSlowSteps {
+			break
+		}
+		table.Append([]string{
+			fmt.Sprintf("%d", i+1),
+			s.Workflow,
+			s.Job,
+			s.Step,
+			fmt.Sprintf("%.1f", s.Total.Minutes()),
+			fmt.Sprintf("%d", s.Runs),
+			formatDuration(s.Average),
+			formatDuration(s.Max),
+		})
+	}
+	table.Render()
+	if len(steps) > maxSlowSteps {
+		fmt.Printf(i18n.Sprintf("... and %d more steps\n", len(steps)-maxSlowSteps))
+	}
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var suggestionReport bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&suggestionReport, "suggestions", false, "Report how many review comments carry suggested changes and how many were applied (shares the review comment fetch with --comment-categories)")
+}
+
+// runSuggestionReport displays suggestion usage when --suggestions is set.
+func runSuggestionReport(prs []github.PullRequest) {
+	if !suggestionReport {
+		return
+	}
+	displaySuggestionReport(stats.CalculateSuggestions(prs))
+}
+
+// displaySuggestionReport shows how often reviewers use suggestion blocks and how often authors apply them.
+func displaySuggestionReport(report stats.SuggestionReport) {
+	fmt.Println("\n" + i18n.T("✏️  Suggested Changes:"))
+	if report.Suggestions == 0 {
+		fmt.Println(i18n.Sprintf("No suggestion blocks in %d review comments", report.ReviewComments))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Review comments with suggestions"), fmt.Sprintf("%d / %d (%.1f%%)", report.Suggestions, report.ReviewComments, report.SuggestionShare)})
+	table.Append([]string{i18n.T("PRs with suggestions"), fmt.Sprintf("%d", report.PRsWithSuggestions)})
+	table.Append([]string{i18n.T("Suggestions applied"), fmt.Sprintf("%d / %d (%.1f%%)", report.Applied, report.Suggestions, report.AdoptionRate)})
+	if report.Applied > 0 {
+		table.Append([]string{i18n.T("Time to apply (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageTimeToApply), formatDuration(report.MedianTimeToApply))})
+	}
+	table.Render()
+	fmt.Println(i18n.T("Merged PRs only. Applied means a later commit credits the reviewer as co-author, as GitHub's \"Apply suggestion\" button does; each commit accounts for one suggestion, a batch for those posted since the previous commit."))
+}
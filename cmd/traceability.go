@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// displayTraceabilityReport shows how many merged PRs link to an issue and the issue→PR→merge cycle time.
+func displayTraceabilityReport(prs []github.PullRequest) {
+	report := stats.CalculateTraceability(prs)
+	if report.MergedPRs == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🔗 Issue Traceability:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	table.SetBorder(true)
+	table.Append([]string{i18n.T("Merged PRs Linked to an Issue"), fmt.Sprintf("%d / %d (%.1f%%)", report.LinkedPRs, report.MergedPRs, report.LinkRate)})
+	if report.LinkedPRs > 0 {
+		table.Append([]string{i18n.T("Issue→PR (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageIssueToPR), formatDuration(report.MedianIssueToPR))})
+		table.Append([]string{i18n.T("PR→Merge (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AveragePRToMerge), formatDuration(report.MedianPRToMerge))})
+		table.Append([]string{i18n.T("Issue→Merge (avg/median)"), fmt.Sprintf("%s / %s", formatDuration(report.AverageIssueToMerge), formatDuration(report.MedianIssueToMerge))})
+	}
+	table.Render()
+}
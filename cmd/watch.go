@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var watchInterval int
+var watchStuckAfter time.Duration
+var watchOnce bool
+
+// watchLookback is how far back completed workflow runs count towards the CI summary.
+const watchLookback = 24 * time.Hour
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Live view of open PRs and CI status, refreshed periodically",
+	Long:  `Re-fetch open PRs and recent workflow runs every few minutes and redraw a live terminal view (open PRs, CI status, stuck PRs). Press Ctrl+C to exit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runWatch()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().IntVar(&watchInterval, "interval", 5, "Refresh interval in minutes")
+	watchCmd.Flags().DurationVar(&watchStuckAfter, "stuck-after", 48*time.Hour, "Flag open PRs waiting longer than this as stuck")
+	watchCmd.Flags().BoolVar(&watchOnce, "once", false, "Render a single snapshot and exit")
+}
+
+func runWatch() {
+	if watchInterval <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --interval must be positive\n")
+		os.Exit(1)
+	}
+
+	targetRepo, err := getTargetRepo()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+	repo = targetRepo
+
+	p, err := newProvider()
+	if err != nil {
+		exitWithError("Error", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	ticker := time.NewTicker(time.Duration(watchInterval) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		prs, prErr := p.FetchOpenPullRequests(repo)
+		runs, runErr := p.FetchWorkflowRuns(repo, "", "")
+		snapshot := stats.BuildWatchSnapshot(prs, runs, time.Now(), watchStuckAfter, watchLookback)
+
+		if !watchOnce {
+			// Clear the screen and move the cursor home before redrawing
+			fmt.Print("\033[H\033[2J")
+		}
+		displayWatchSnapshot(snapshot)
+		if prErr != nil {
+			fmt.Printf("⚠️  %s: %v\n", i18n.T("Failed to fetch pull requests"), prErr)
+		}
+		if runErr != nil {
+			fmt.Printf("⚠️  %s: %v\n", i18n.T("Failed to fetch workflow runs"), runErr)
+		}
+
+		if watchOnce {
+			return
+		}
+		fmt.Println(i18n.Sprintf("🔄 Next refresh in %d min (Ctrl+C to exit)", watchInterval))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// displayWatchSnapshot renders one refresh of the live view.
+func displayWatchSnapshot(s stats.WatchSnapshot) {
+	fmt.Println(i18n.Sprintf("📺 visuche watch: %s  (%s)", repo, s.TakenAt.Format("2006-01-02 15:04")))
+
+	prTable := tablewriter.NewWriter(os.Stdout)
+	prTable.SetHeader([]string{i18n.T("Open PRs"), i18n.T("Awaiting Review"), i18n.T("Changes Requested"), i18n.T("Approved"), i18n.T("Draft"), i18n.T("Stuck")})
+	prTable.SetBorder(true)
+	prTable.Append([]string{
+		fmt.Sprintf("%d", s.OpenPRs),
+		fmt.Sprintf("%d", s.AwaitingReview),
+		fmt.Sprintf("%d", s.ChangesRequested),
+		fmt.Sprintf("%d", s.Approved),
+		fmt.Sprintf("%d", s.DraftPRs),
+		fmt.Sprintf("%d", len(s.StuckPRs)),
+	})
+	prTable.Render()
+
+	fmt.Println("\n" + i18n.Sprintf("🔧 CI (last %dh): %d runs, %d failed, %d in progress", int(watchLookback.Hours()), s.RecentRuns, s.RecentFailures, len(s.RunsInProgress)))
+	if len(s.LatestRuns) > 0 || len(s.RunsInProgress) > 0 {
+		ciTable := tablewriter.NewWriter(os.Stdout)
+		ciTable.SetHeader([]string{i18n.T("Workflow"), i18n.T("Status"), i18n.T("Branch"), i18n.T("Started")})
+		ciTable.SetBorder(true)
+		for _, run := range s.RunsInProgress {
+			ciTable.Append([]string{run.WorkflowName, "⏳ " + run.Status, run.HeadBranch, formatDuration(s.TakenAt.Sub(run.CreatedAt)) + i18n.T(" ago")})
+		}
+		for _, run := range s.LatestRuns {
+			ciTable.Append([]string{run.WorkflowName, runStatusIcon(run) + " " + run.Conclusion, run.HeadBranch, formatDuration(s.TakenAt.Sub(run.CreatedAt)) + i18n.T(" ago")})
+		}
+		ciTable.Render()
+	}
+
+	if len(s.StuckPRs) > 0 {
+		fmt.Println("\n" + i18n.Sprintf("⏳ Stuck PRs (waiting > %s):", formatDuration(watchStuckAfter)))
+		for i, item := range s.StuckPRs {
+			if i >= 10 {
+				fmt.Print(i18n.Sprintf("... and %d more\n", len(s.StuckPRs)-10))
+				break
+			}
+			fmt.Printf("  #%d %s (@%s, %s)%s\n", item.PR.Number, item.PR.Title, item.PR.Author.Login, formatDuration(item.Waiting), linkSuffix(item.PR.URL))
+		}
+	}
+}
+
+// runStatusIcon returns an icon for a completed run's conclusion.
+func runStatusIcon(run actions.WorkflowRun) string {
+	switch run.Conclusion {
+	case "success":
+		return "✅"
+	case "failure", "timed_out":
+		return "❌"
+	case "cancelled":
+		return "⏹️"
+	default:
+		return "➖"
+	}
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/i18n"
+	"visuche/internal/stats"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+var wipReport bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&wipReport, "wip", false, "Report concurrently open PRs per day and per author (WIP limits)")
+}
+
+// runWIPReport displays the concurrently-open-PR report when --wip is set.
+// The window is --since/--until, defaulting to the first PR's creation through today.
+func runWIPReport(prs []github.PullRequest) {
+	if !wipReport || len(prs) == 0 {
+		return
+	}
+
+	now := time.Now()
+	from, to := prs[0].CreatedAt, now
+	for _, pr := range prs {
+		if pr.CreatedAt.Before(from) {
+			from = pr.CreatedAt
+		}
+	}
+	if t, err := time.Parse("2006-01-02", since); err == nil {
+		from = t
+	}
+	if t, err := time.Parse("2006-01-02", until); err == nil && t.Before(now) {
+		to = t
+	}
+	displayWIPReport(stats.CalculateWIP(prs, from, to, now))
+}
+
+// displayWIPReport shows the WIP summary, the busiest authors and a daily bar chart.
+func displayWIPReport(report stats.WIPReport) {
+	if len(report.Days) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("🚧 Concurrently Open PRs (WIP):"))
+	summary := tablewriter.NewWriter(os.Stdout)
+	summary.SetHeader([]string{i18n.T("Metric"), i18n.T("Value")})
+	summary.SetBorder(true)
+	summary.Append([]string{i18n.T("Open PRs per day (avg)"), fmt.Sprintf("%.1f", report.AverageOpen)})
+	summary.Append([]string{i18n.T("Peak open PRs"), fmt.Sprintf("%d (%s)", report.PeakOpen, report.PeakDate.Format("2006-01-02"))})
+	summary.Append([]string{i18n.T("Open PRs per author (avg)"), fmt.Sprintf("%.2f", report.AveragePerAuthor)})
+	summary.Render()
+
+	if len(report.Authors) > 0 {
+		authors := tablewriter.NewWriter(os.Stdout)
+		authors.SetHeader([]string{i18n.T("Author"), i18n.T("Open at once (avg)"), i18n.T("Peak"), i18n.T("Days with open PRs")})
+		authors.SetBorder(true)
+		for i, a := range report.Authors {
+			if i >= 10 {
+				break
+			}
+			authors.Append([]string{a.Author, fmt.Sprintf("%.2f", a.Average), fmt.Sprintf("%d", a.Peak), fmt.Sprintf("%d", a.ActiveDays)})
+		}
+		authors.Render()
+	}
+
+	fmt.Println(i18n.T("Open PRs at the end of each day (UTC):"))
+	for _, day := range report.Days {
+		fmt.Printf("  %s %3d %s\n", day.Date.Format("2006-01-02"), day.Open, strings.Repeat("█", day.Open))
+	}
+}
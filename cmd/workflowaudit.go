@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"visuche/internal/actions"
+	"visuche/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// runWorkflowAudit checks the repository's workflow files against the runs of the period. Providers
+// without workflow files (GitLab) are skipped, and fetch errors only produce a warning.
+func runWorkflowAudit(details *ciDetails) {
+	files, ok, err := details.WorkflowFiles()
+	if !ok {
+		return
+	}
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch workflow files: %v\n", err)
+		return
+	}
+	if len(files) == 0 {
+		return
+	}
+	displayWorkflowAudit(actions.AuditWorkflows(files, details.runs))
+}
+
+func displayWorkflowAudit(audits []actions.WorkflowAudit) {
+	fmt.Println("\n" + i18n.T("🧹 Workflow Hygiene:"))
+
+	yesNo := func(ok bool) string {
+		if ok {
+			return i18n.T("yes")
+		}
+		return i18n.T("no")
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{i18n.T("Workflow"), i18n.T("File"), i18n.T("Runs"), i18n.T("Concurrency"), i18n.T("Jobs without Timeout"), i18n.T("Unpinned Actions")})
+	table.SetBorder(true)
+	withIssues := 0
+	for _, a := range audits {
+		if a.Issues() > 0 {
+			withIssues++
+		}
+		if a.ParseError != "" {
+			table.Append([]string{a.Name, a.Path, "-", "-", "-", i18n.T("invalid YAML")})
+			continue
+		}
+		table.Append([]string{
+			a.Name,
+			a.Path,
+			fmt.Sprintf("%d", a.Runs),
+			yesNo(a.HasConcurrency),
+			fmt.Sprintf("%d / %d", len(a.JobsWithoutTimeout), a.Jobs),
+			fmt.Sprintf("%d", len(a.MutableRefs)),
+		})
+	}
+	table.Render()
+
+	var idle []string
+	for _, a := range audits {
+		if a.ParseError == "" && a.Runs == 0 {
+			idle = append(idle, a.Path)
+		}
+	}
+	if len(idle) > 0 {
+		fmt.Println(i18n.T("Not run in this period (remove, or check the triggers):"))
+		fmt.Printf("  %s\n", strings.Join(idle, ", "))
+	}
+
+	printedHeader := false
+	for _, a := range audits {
+		if len(a.JobsWithoutTimeout) == 0 {
+			continue
+		}
+		if !printedHeader {
+			fmt.Println(i18n.T("Jobs without timeout-minutes (GitHub's default is 6 hours):"))
+			printedHeader = true
+		}
+		fmt.Printf("  %s: %s\n", a.Path, strings.Join(a.JobsWithoutTimeout, ", "))
+	}
+
+	printedHeader = false
+	for _, a := range audits {
+		if len(a.MutableRefs) == 0 {
+			continue
+		}
+		if !printedHeader {
+			fmt.Println(i18n.T("Actions pinned to a tag or branch (can change under you; pin a commit SHA):"))
+			printedHeader = true
+		}
+		fmt.Printf("  %s: %s\n", a.Path, strings.Join(a.MutableRefs, ", "))
+	}
+
+	for _, a := range audits {
+		if a.ParseError != "" {
+			fmt.Printf("  ⚠️  %s: %s\n", a.Path, a.ParseError)
+		}
+	}
+	fmt.Printf(i18n.Sprintf("%d of %d workflows have hygiene issues\n", withIssues, len(audits)))
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"sync"
 	"time"
 	"visuche/internal/animation"
+	"visuche/internal/progress"
+	"visuche/internal/summary"
+	"visuche/internal/transport"
 )
 
 // WorkflowRun represents a GitHub Actions workflow run
@@ -26,6 +28,11 @@ type WorkflowRun struct {
 	UpdatedAt     time.Time `json:"updatedAt"`
 	WorkflowName  string    `json:"workflowName"`
 	URL           string    `json:"url"`
+	Actor         string    `json:"actor,omitempty"` // Login that triggered the run; not part of gh run list, see FetchRunActors
+
+	// Inputs of a workflow_dispatch run. The API doesn't return them, so they are only known for runs
+	// recorded by the collector from workflow_dispatch webhooks (or given in fixtures).
+	Inputs map[string]string `json:"inputs,omitempty"`
 }
 
 // WorkflowJob represents a job within a workflow run
@@ -56,6 +63,9 @@ type WorkflowStats struct {
 	Successes         int
 	Failures          int
 	AverageDurationMs int64
+	P50Duration       time.Duration // Percentiles of the completed runs' durations, interpolated like the PR metrics
+	P90Duration       time.Duration
+	P99Duration       time.Duration
 }
 
 // EventStats represents statistics for a specific trigger event
@@ -105,7 +115,7 @@ func FetchWorkflowRuns(repo string, since, until string) ([]WorkflowRun, error)
 	spinner.Start()
 	defer spinner.Stop()
 
-	cmd := exec.Command("gh", args...)
+	cmd := transport.Command("gh", args...)
 	
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -115,17 +125,21 @@ func FetchWorkflowRuns(repo string, since, until string) ([]WorkflowRun, error)
 		return nil, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
 	}
 
+	return ParseWorkflowRuns(stdout.Bytes())
+}
+
+// ParseWorkflowRuns decodes `gh run list --json` output.
+func ParseWorkflowRuns(data []byte) ([]WorkflowRun, error) {
 	var runs []WorkflowRun
-	if err := json.Unmarshal(stdout.Bytes(), &runs); err != nil {
+	if err := json.Unmarshal(data, &runs); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
 
 	return runs, nil
 }
 
-// AnalyzeWorkflowRuns analyzes the fetched workflow runs
-func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalytics {
-	// Filter runs by date range if provided
+// FilterRunsByDate keeps the runs created within the inclusive YYYY-MM-DD range.
+func FilterRunsByDate(runs []WorkflowRun, since, until string) []WorkflowRun {
 	var filteredRuns []WorkflowRun
 	for _, run := range runs {
 		include := true
@@ -148,8 +162,14 @@ func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalyt
 			filteredRuns = append(filteredRuns, run)
 		}
 	}
-	
-	runs = filteredRuns
+
+	return filteredRuns
+}
+
+// AnalyzeWorkflowRuns analyzes the fetched workflow runs
+func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalytics {
+	// Filter runs by date range if provided
+	runs = FilterRunsByDate(runs, since, until)
 	analytics := WorkflowAnalytics{
 		WorkflowStats:  make(map[string]WorkflowStats),
 		EventStats:     make(map[string]EventStats),
@@ -158,6 +178,7 @@ func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalyt
 
 	var totalDuration time.Duration
 	var completedRuns int
+	workflowDurations := make(map[string][]time.Duration)
 
 	for _, run := range runs {
 		analytics.TotalRuns++
@@ -201,9 +222,7 @@ func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalyt
 		}
 		
 		if run.Status == "completed" && !run.StartedAt.IsZero() && !run.UpdatedAt.IsZero() {
-			duration := run.UpdatedAt.Sub(run.StartedAt)
-			// Update average duration (simple approach)
-			workflowStats.AverageDurationMs = (workflowStats.AverageDurationMs + duration.Milliseconds()) / 2
+			workflowDurations[run.WorkflowName] = append(workflowDurations[run.WorkflowName], run.UpdatedAt.Sub(run.StartedAt))
 		}
 		
 		analytics.WorkflowStats[run.WorkflowName] = workflowStats
@@ -225,59 +244,63 @@ func AnalyzeWorkflowRuns(runs []WorkflowRun, since, until string) WorkflowAnalyt
 	if completedRuns > 0 {
 		analytics.AverageDurationMs = totalDuration.Milliseconds() / int64(completedRuns)
 	}
-
-	// Fetch detailed failure information for recent failures
-	if len(analytics.FailureDetails) > 0 {
-		analytics.FailureDetails = fetchFailureDetails(runs, analytics.FailureDetails)
+	for workflow, ds := range workflowDurations {
+		workflowStats := analytics.WorkflowStats[workflow]
+		sorted := summary.SortedDurations(ds)
+		workflowStats.AverageDurationMs = summary.Durations(sorted).Mean.Milliseconds()
+		workflowStats.P50Duration = summary.Percentile(sorted, 50)
+		workflowStats.P90Duration = summary.Percentile(sorted, 90)
+		workflowStats.P99Duration = summary.Percentile(sorted, 99)
+		analytics.WorkflowStats[workflow] = workflowStats
 	}
 
 	return analytics
 }
 
-// fetchFailureDetails fetches detailed job and step information for failures
-func fetchFailureDetails(runs []WorkflowRun, failures []FailureDetail) []FailureDetail {
-	// Limit to first 5 failures for performance
-	limit := 5
-	if len(failures) < limit {
+// FetchFailureDetails looks up the failed job and step of each failure through a pool of workers, the
+// calls paced by the shared transport scheduler. limit caps the lookups to the first (most recent)
+// failures; 0 looks up all of them.
+func FetchFailureDetails(repo string, runs []WorkflowRun, failures []FailureDetail, limit int) []FailureDetail {
+	if limit <= 0 || limit > len(failures) {
 		limit = len(failures)
 	}
+	if limit == 0 {
+		return failures
+	}
+
+	runByURL := make(map[string]WorkflowRun, len(runs))
+	for _, run := range runs {
+		runByURL[run.URL] = run
+	}
+
+	fmt.Printf("🔍 Fetching failed jobs for %d of %d failures...\n", limit, len(failures))
+	stage := progress.Start("fetch_failure_details", limit)
+	defer stage.End()
 
+	queue := make(chan int, limit)
 	var wg sync.WaitGroup
-	var mu sync.Mutex
+	const workers = 4
 
-	for i := 0; i < limit; i++ {
+	for w := 0; w < workers; w++ {
 		wg.Add(1)
-		go func(index int) {
+		go func() {
 			defer wg.Done()
-			
-			// Find the corresponding run
-			var targetRun *WorkflowRun
-			for _, run := range runs {
-				if run.DisplayTitle == failures[index].DisplayTitle && 
-				   run.WorkflowName == failures[index].WorkflowName {
-					targetRun = &run
-					break
+			for i := range queue {
+				// Each worker writes only its own failures[i], so no locking is needed
+				if run, ok := runByURL[failures[i].URL]; ok {
+					info := FailedJob(fetchRunJobs(repo, run.DatabaseId))
+					failures[i].FailedJob = info.FailedJob
+					failures[i].FailedStep = info.FailedStep
 				}
+				stage.Add(1)
 			}
-			
-			if targetRun == nil {
-				return
-			}
-
-			// Fetch job details
-			jobInfo := fetchJobDetails(targetRun.DatabaseId)
-			
-			mu.Lock()
-			if jobInfo.FailedJob != "" {
-				failures[index].FailedJob = jobInfo.FailedJob
-			}
-			if jobInfo.FailedStep != "" {
-				failures[index].FailedStep = jobInfo.FailedStep
-			}
-			mu.Unlock()
-		}(i)
+		}()
 	}
 
+	for i := 0; i < limit; i++ {
+		queue <- i
+	}
+	close(queue)
 	wg.Wait()
 	return failures
 }
@@ -288,48 +311,24 @@ type JobInfo struct {
 	FailedStep string
 }
 
-// fetchJobDetails fetches job details for a specific run
-func fetchJobDetails(runId int64) JobInfo {
-	args := []string{
-		"run", "view", fmt.Sprintf("%d", runId),
-		"--json", "jobs",
-	}
-
-	cmd := exec.Command("gh", args...)
-	
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		// Silently ignore errors for job details
-		return JobInfo{}
-	}
-
-	var runDetails struct {
-		Jobs []WorkflowJob `json:"jobs"`
-	}
-	
-	if err := json.Unmarshal(stdout.Bytes(), &runDetails); err != nil {
-		return JobInfo{}
-	}
-
-	// Find failed job and step
-	for _, job := range runDetails.Jobs {
-		if job.Conclusion == "failure" || job.Conclusion == "cancelled" || job.Conclusion == "timed_out" {
-			jobInfo := JobInfo{FailedJob: job.Name}
-			
-			// Find failed step
+// FailedJob returns the first failed job of a run and its first failed step; empty when no job failed.
+func FailedJob(jobs []WorkflowJob) JobInfo {
+	for _, job := range jobs {
+		if isFailure(job.Conclusion) {
+			info := JobInfo{FailedJob: job.Name}
 			for _, step := range job.Steps {
-				if step.Conclusion == "failure" || step.Conclusion == "cancelled" || step.Conclusion == "timed_out" {
-					jobInfo.FailedStep = step.Name
+				if isFailure(step.Conclusion) {
+					info.FailedStep = step.Name
 					break
 				}
 			}
-			
-			return jobInfo
+			return info
 		}
 	}
-
 	return JobInfo{}
-}
\ No newline at end of file
+}
+
+// isFailure reports whether a run, job or step conclusion counts as a failure.
+func isFailure(conclusion string) bool {
+	return conclusion == "failure" || conclusion == "cancelled" || conclusion == "timed_out"
+}
@@ -0,0 +1,139 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// Actor kinds.
+const (
+	ActorUser     = "user"
+	ActorBot      = "bot"
+	ActorSchedule = "schedule"
+)
+
+// ScheduleActor and UnknownActor stand in for the actor of scheduled runs (GitHub reports whoever last
+// edited the cron, which says nothing about the trigger) and of runs whose actor is unknown.
+const (
+	ScheduleActor = "(schedule)"
+	UnknownActor  = "(unknown)"
+)
+
+// ActorStats summarizes the runs triggered by one actor.
+type ActorStats struct {
+	Actor       string
+	Kind        string // ActorUser, ActorBot or ActorSchedule
+	Runs        int
+	Failures    int
+	Time        time.Duration // Total run time of the completed runs
+	Share       float64       // Percentage of the CI time of all runs
+	TopWorkflow string        // Workflow the actor's runs spent the most time in
+}
+
+// FetchRunActors fills in the login that triggered each run, which gh run list does not expose, from
+// the REST run list of the runs' date range (one paginated call, 100 runs per page). Runs missing from
+// the list keep an empty actor.
+func FetchRunActors(repo string, runs []WorkflowRun) []WorkflowRun {
+	if len(runs) == 0 {
+		return runs
+	}
+	from, to := runs[0].CreatedAt, runs[0].CreatedAt
+	for _, run := range runs {
+		if run.CreatedAt.Before(from) {
+			from = run.CreatedAt
+		}
+		if run.CreatedAt.After(to) {
+			to = run.CreatedAt
+		}
+	}
+
+	fmt.Println("🔍 Fetching the actors of the workflow runs...")
+	endpoint := fmt.Sprintf("repos/%s/actions/runs?per_page=100&created=%s..%s", repo, from.UTC().Format("2006-01-02"), to.UTC().Format("2006-01-02"))
+	out, err := ghAPI(endpoint, "--paginate", "--jq", ".workflow_runs[] | {id, actor: (.triggering_actor.login // .actor.login // \"\")}")
+	if err != nil {
+		fmt.Printf("⚠️  Failed to fetch run actors: %v\n", err)
+		return runs
+	}
+
+	actors := make(map[int64]string)
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for decoder.More() {
+		var entry struct {
+			ID    int64  `json:"id"`
+			Actor string `json:"actor"`
+		}
+		if err := decoder.Decode(&entry); err != nil {
+			break
+		}
+		actors[entry.ID] = entry.Actor
+	}
+	for i := range runs {
+		if actor, ok := actors[runs[i].DatabaseId]; ok {
+			runs[i].Actor = actor
+		}
+	}
+	return runs
+}
+
+// CalculateActorStats breaks the runs down by the actor that triggered them, biggest CI time consumer
+// first. Scheduled runs are grouped under ScheduleActor.
+func CalculateActorStats(runs []WorkflowRun) []ActorStats {
+	byActor := make(map[string]*ActorStats)
+	workflowTime := make(map[string]map[string]time.Duration)
+	var total time.Duration
+	for _, run := range runs {
+		actor, kind := run.Actor, ActorUser
+		switch {
+		case run.Event == "schedule":
+			actor, kind = ScheduleActor, ActorSchedule
+		case actor == "":
+			actor = UnknownActor
+		case github.IsBotLogin(actor):
+			kind = ActorBot
+		}
+		a, ok := byActor[actor]
+		if !ok {
+			a = &ActorStats{Actor: actor, Kind: kind}
+			byActor[actor] = a
+			workflowTime[actor] = make(map[string]time.Duration)
+		}
+		a.Runs++
+		if isFailure(run.Conclusion) {
+			a.Failures++
+		}
+		if run.Status == "completed" && !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+			d := run.UpdatedAt.Sub(run.StartedAt)
+			a.Time += d
+			total += d
+			workflowTime[actor][runWorkflow(run)] += d
+		}
+	}
+
+	stats := make([]ActorStats, 0, len(byActor))
+	for actor, a := range byActor {
+		if total > 0 {
+			a.Share = float64(a.Time) / float64(total) * 100
+		}
+		var top time.Duration
+		for workflow, d := range workflowTime[actor] {
+			if d > top || (d == top && workflow < a.TopWorkflow) {
+				a.TopWorkflow, top = workflow, d
+			}
+		}
+		stats = append(stats, *a)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Time != stats[j].Time {
+			return stats[i].Time > stats[j].Time
+		}
+		if stats[i].Runs != stats[j].Runs {
+			return stats[i].Runs > stats[j].Runs
+		}
+		return stats[i].Actor < stats[j].Actor
+	})
+	return stats
+}
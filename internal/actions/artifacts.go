@@ -0,0 +1,199 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+	"visuche/internal/summary"
+)
+
+// Artifact is an artifact uploaded by a workflow run, as returned by the GitHub REST API.
+type Artifact struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	SizeInBytes int64     `json:"size_in_bytes"`
+	Expired     bool      `json:"expired"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// LargeArtifactFactor flags workflows whose average upload per run is at least this many times the
+// median upload of all runs with artifacts.
+const LargeArtifactFactor = 3
+
+// minLargeArtifactBytes keeps small uploads from being flagged in repositories with tiny artifacts.
+const minLargeArtifactBytes = 10 << 20
+
+// WorkflowArtifactUsage is the artifact storage produced by one workflow.
+type WorkflowArtifactUsage struct {
+	Workflow  string
+	Runs      int // Runs that uploaded artifacts
+	Artifacts int
+	Total     int64 // Bytes
+	PerRun    int64 // Average bytes per run that uploaded artifacts
+	Largest   Artifact
+	Large     bool // Uploads unusually large artifacts (see LargeArtifactFactor)
+}
+
+// WeeklyArtifactUsage is the artifact storage produced in one week (starting Monday, UTC).
+type WeeklyArtifactUsage struct {
+	Week      time.Time
+	Artifacts int
+	Total     int64
+}
+
+// ArtifactUsage summarizes the artifact storage produced by the analyzed runs.
+type ArtifactUsage struct {
+	Artifacts    int
+	Total        int64
+	MedianPerRun int64 // Median bytes uploaded by a run with artifacts
+	Workflows    []WorkflowArtifactUsage
+	Weeks        []WeeklyArtifactUsage
+}
+
+// FetchRunArtifacts fetches the artifacts of each completed run, keyed by run ID. Runs whose
+// artifacts cannot be fetched are left out.
+func FetchRunArtifacts(repo string, runs []WorkflowRun) map[int64][]Artifact {
+	var targets []WorkflowRun
+	for _, run := range runs {
+		if run.Status == "completed" {
+			targets = append(targets, run)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	fmt.Printf("🔍 Fetching artifacts for %d runs...\n", len(targets))
+
+	type result struct {
+		id        int64
+		artifacts []Artifact
+	}
+
+	queue := make(chan WorkflowRun, len(targets))
+	results := make(chan result, len(targets))
+	const workers = 4
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for run := range queue {
+				results <- result{id: run.DatabaseId, artifacts: fetchRunArtifacts(repo, run.DatabaseId)}
+			}
+		}()
+	}
+
+	for _, run := range targets {
+		queue <- run
+	}
+	close(queue)
+
+	artifactsByRun := make(map[int64][]Artifact, len(targets))
+	for i := 0; i < len(targets); i++ {
+		r := <-results
+		if len(r.artifacts) > 0 {
+			artifactsByRun[r.id] = r.artifacts
+		}
+	}
+	return artifactsByRun
+}
+
+// fetchRunArtifacts returns the artifacts of a run, or nil on errors.
+func fetchRunArtifacts(repo string, runID int64) []Artifact {
+	out, err := ghAPI(fmt.Sprintf("repos/%s/actions/runs/%d/artifacts", repo, runID), "--paginate", "--jq", ".artifacts[]")
+	if err != nil {
+		return nil
+	}
+
+	var artifacts []Artifact
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for decoder.More() {
+		var artifact Artifact
+		if err := decoder.Decode(&artifact); err != nil {
+			return nil
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts
+}
+
+// CalculateArtifactUsage totals artifact sizes per workflow (largest first) and per week, and flags
+// workflows uploading unusually large artifacts. Expired artifacts still count: they were stored
+// until they expired.
+func CalculateArtifactUsage(runs []WorkflowRun, artifactsByRun map[int64][]Artifact) ArtifactUsage {
+	var usage ArtifactUsage
+	byWorkflow := make(map[string]*WorkflowArtifactUsage)
+	byWeek := make(map[time.Time]*WeeklyArtifactUsage)
+	var perRun []int
+
+	for _, run := range runs {
+		artifacts := artifactsByRun[run.DatabaseId]
+		if len(artifacts) == 0 {
+			continue
+		}
+		workflow := run.WorkflowName
+		if workflow == "" {
+			workflow = run.Name
+		}
+		w, ok := byWorkflow[workflow]
+		if !ok {
+			w = &WorkflowArtifactUsage{Workflow: workflow}
+			byWorkflow[workflow] = w
+		}
+		w.Runs++
+
+		var runTotal int64
+		for _, artifact := range artifacts {
+			created := artifact.CreatedAt
+			if created.IsZero() {
+				created = run.CreatedAt
+			}
+			week := artifactWeek(created)
+			wk, ok := byWeek[week]
+			if !ok {
+				wk = &WeeklyArtifactUsage{Week: week}
+				byWeek[week] = wk
+			}
+			wk.Artifacts++
+			wk.Total += artifact.SizeInBytes
+
+			w.Artifacts++
+			w.Total += artifact.SizeInBytes
+			if artifact.SizeInBytes > w.Largest.SizeInBytes {
+				w.Largest = artifact
+			}
+			runTotal += artifact.SizeInBytes
+		}
+		perRun = append(perRun, int(runTotal))
+		usage.Artifacts += len(artifacts)
+		usage.Total += runTotal
+	}
+
+	usage.MedianPerRun = int64(summary.Counts(perRun).Median)
+
+	for _, w := range byWorkflow {
+		w.PerRun = w.Total / int64(w.Runs)
+		w.Large = len(byWorkflow) > 1 && w.PerRun >= minLargeArtifactBytes && w.PerRun >= LargeArtifactFactor*usage.MedianPerRun
+		usage.Workflows = append(usage.Workflows, *w)
+	}
+	sort.Slice(usage.Workflows, func(i, j int) bool {
+		if usage.Workflows[i].Total != usage.Workflows[j].Total {
+			return usage.Workflows[i].Total > usage.Workflows[j].Total
+		}
+		return usage.Workflows[i].Workflow < usage.Workflows[j].Workflow
+	})
+
+	for _, wk := range byWeek {
+		usage.Weeks = append(usage.Weeks, *wk)
+	}
+	sort.Slice(usage.Weeks, func(i, j int) bool { return usage.Weeks[i].Week.Before(usage.Weeks[j].Week) })
+	return usage
+}
+
+// artifactWeek returns midnight UTC of the Monday of t's week.
+func artifactWeek(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
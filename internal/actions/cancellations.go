@@ -0,0 +1,161 @@
+package actions
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/summary"
+)
+
+// supersedeSlack is how long after a run's cancellation a newer run may be created and still be
+// considered the run that superseded it (the cancellation is recorded before the new run at times).
+const supersedeSlack = time.Minute
+
+// LateCancellationFraction marks a cancelled run as cancelled late when it had already run for at
+// least this fraction of its workflow's typical (median successful) duration.
+const LateCancellationFraction = 0.5
+
+// CancelledRun is a cancelled workflow run and why it was most likely cancelled.
+type CancelledRun struct {
+	Run          WorkflowRun
+	Workflow     string
+	Superseded   bool          // Cancelled by a concurrency group when a newer run started; otherwise manual
+	SupersededBy *WorkflowRun  // The newer run of the same workflow, branch and event
+	Elapsed      time.Duration // Runtime until the cancellation (zero when cancelled while queued)
+	Typical      time.Duration // Median duration of successful runs of the workflow, zero when unknown
+	Late         bool
+}
+
+// Saved is the runtime a superseded run did not consume thanks to the cancellation.
+func (c CancelledRun) Saved() time.Duration {
+	if !c.Superseded || c.Typical <= c.Elapsed {
+		return 0
+	}
+	return c.Typical - c.Elapsed
+}
+
+// CancellationStats summarizes the cancellations of one workflow.
+type CancellationStats struct {
+	Workflow   string
+	Superseded int
+	Manual     int
+	Late       int
+	Saved      time.Duration
+	Wasted     time.Duration // Runtime consumed by runs cancelled late
+}
+
+// CancellationAnalysis summarizes why runs were cancelled and what the cancellations cost or saved.
+type CancellationAnalysis struct {
+	Runs      []CancelledRun // Late cancellations first, longest runtime first
+	Workflows []CancellationStats
+	Total     CancellationStats
+}
+
+// AnalyzeCancellations classifies cancelled runs as superseded (a newer run of the same workflow
+// for the same branch and event was created before the run was cancelled, as concurrency groups
+// with cancel-in-progress do) or manual, and totals the runtime saved by superseding and the
+// runtime wasted on runs cancelled late.
+func AnalyzeCancellations(runs []WorkflowRun) CancellationAnalysis {
+	typical := typicalDurations(runs)
+
+	var analysis CancellationAnalysis
+	byWorkflow := make(map[string]*CancellationStats)
+	for i := range runs {
+		run := runs[i]
+		if run.Conclusion != "cancelled" {
+			continue
+		}
+		workflow := runWorkflow(run)
+
+		cancelled := CancelledRun{Run: run, Workflow: workflow, Typical: typical[workflow]}
+		if !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+			cancelled.Elapsed = run.UpdatedAt.Sub(run.StartedAt)
+		}
+		cancelled.Late = cancelled.Typical > 0 &&
+			float64(cancelled.Elapsed) >= LateCancellationFraction*float64(cancelled.Typical)
+		cancelled.SupersededBy = supersedingRun(run, runs)
+		cancelled.Superseded = cancelled.SupersededBy != nil
+
+		stats, ok := byWorkflow[workflow]
+		if !ok {
+			stats = &CancellationStats{Workflow: workflow}
+			byWorkflow[workflow] = stats
+		}
+		for _, s := range []*CancellationStats{stats, &analysis.Total} {
+			if cancelled.Superseded {
+				s.Superseded++
+			} else {
+				s.Manual++
+			}
+			if cancelled.Late {
+				s.Late++
+				s.Wasted += cancelled.Elapsed
+			}
+			s.Saved += cancelled.Saved()
+		}
+		analysis.Runs = append(analysis.Runs, cancelled)
+	}
+
+	sort.SliceStable(analysis.Runs, func(i, j int) bool {
+		if analysis.Runs[i].Late != analysis.Runs[j].Late {
+			return analysis.Runs[i].Late
+		}
+		return analysis.Runs[i].Elapsed > analysis.Runs[j].Elapsed
+	})
+	for _, stats := range byWorkflow {
+		analysis.Workflows = append(analysis.Workflows, *stats)
+	}
+	sort.Slice(analysis.Workflows, func(i, j int) bool {
+		a, b := analysis.Workflows[i], analysis.Workflows[j]
+		if a.Superseded+a.Manual != b.Superseded+b.Manual {
+			return a.Superseded+a.Manual > b.Superseded+b.Manual
+		}
+		return a.Workflow < b.Workflow
+	})
+	return analysis
+}
+
+// supersedingRun returns the earliest newer run of the same workflow, branch and event created
+// before run was cancelled, or nil.
+func supersedingRun(run WorkflowRun, runs []WorkflowRun) *WorkflowRun {
+	var newer *WorkflowRun
+	for i := range runs {
+		other := &runs[i]
+		if other.DatabaseId == run.DatabaseId || runWorkflow(*other) != runWorkflow(run) ||
+			other.HeadBranch != run.HeadBranch || other.Event != run.Event {
+			continue
+		}
+		if !other.CreatedAt.After(run.CreatedAt) || other.CreatedAt.After(run.UpdatedAt.Add(supersedeSlack)) {
+			continue
+		}
+		if newer == nil || other.CreatedAt.Before(newer.CreatedAt) {
+			newer = other
+		}
+	}
+	return newer
+}
+
+// typicalDurations returns the median duration of the successful runs of each workflow.
+func typicalDurations(runs []WorkflowRun) map[string]time.Duration {
+	durations := make(map[string][]time.Duration)
+	for _, run := range runs {
+		if run.Conclusion != "success" || run.StartedAt.IsZero() || !run.UpdatedAt.After(run.StartedAt) {
+			continue
+		}
+		workflow := runWorkflow(run)
+		durations[workflow] = append(durations[workflow], run.UpdatedAt.Sub(run.StartedAt))
+	}
+
+	typical := make(map[string]time.Duration, len(durations))
+	for workflow, ds := range durations {
+		typical[workflow] = summary.Durations(ds).Median
+	}
+	return typical
+}
+
+// runWorkflow returns the workflow name of run, falling back to the run name.
+func runWorkflow(run WorkflowRun) string {
+	if run.WorkflowName != "" {
+		return run.WorkflowName
+	}
+	return run.Name
+}
@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DispatchStats summarizes the workflow_dispatch runs of one workflow started with the same inputs.
+type DispatchStats struct {
+	Workflow    string
+	Inputs      string // FormatInputs of the inputs; empty when they are unknown
+	Runs        int
+	Successes   int
+	Failures    int
+	SuccessRate float64
+	LastRun     time.Time
+}
+
+// FormatInputs renders dispatch inputs as "key=value" pairs sorted by key, so the same combination
+// always renders the same way.
+func FormatInputs(inputs map[string]string) string {
+	keys := make([]string, 0, len(inputs))
+	for k := range inputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+inputs[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// CalculateDispatchStats groups the workflow_dispatch runs by workflow and input combination, most
+// used combination first within each workflow. Runs whose inputs are unknown share one group.
+func CalculateDispatchStats(runs []WorkflowRun) []DispatchStats {
+	type key struct{ workflow, inputs string }
+	byKey := make(map[key]*DispatchStats)
+	for _, run := range runs {
+		if run.Event != "workflow_dispatch" {
+			continue
+		}
+		k := key{runWorkflow(run), FormatInputs(run.Inputs)}
+		s, ok := byKey[k]
+		if !ok {
+			s = &DispatchStats{Workflow: k.workflow, Inputs: k.inputs}
+			byKey[k] = s
+		}
+		s.Runs++
+		if run.Conclusion == "success" {
+			s.Successes++
+		} else if isFailure(run.Conclusion) {
+			s.Failures++
+		}
+		if run.CreatedAt.After(s.LastRun) {
+			s.LastRun = run.CreatedAt
+		}
+	}
+
+	stats := make([]DispatchStats, 0, len(byKey))
+	for _, s := range byKey {
+		s.SuccessRate = float64(s.Successes) / float64(s.Runs) * 100
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Workflow != stats[j].Workflow {
+			return stats[i].Workflow < stats[j].Workflow
+		}
+		if stats[i].Runs != stats[j].Runs {
+			return stats[i].Runs > stats[j].Runs
+		}
+		return stats[i].Inputs < stats[j].Inputs
+	})
+	return stats
+}
@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/summary"
+)
+
+// scheduleLookback bounds the search for the cron tick that triggered a scheduled run.
+const scheduleLookback = 8 * 24 * time.Hour
+
+// LatencyStats summarizes a set of latencies.
+type LatencyStats struct {
+	Samples int
+	Median  time.Duration
+	P90     time.Duration
+	Max     time.Duration
+}
+
+// EventLatency is the latency from a trigger to the start of work for runs of one event type.
+type EventLatency struct {
+	Event    string
+	Runs     int
+	Dispatch LatencyStats // Trigger to run creation; only derivable for scheduled runs
+	Queue    LatencyStats // Run creation to the first job picked up by a runner
+}
+
+// CalculateEventLatency breaks the time from trigger to start down per event type into GitHub's
+// dispatch delay and runner queue time. The dispatch delay of scheduled runs is measured from the
+// cron tick in schedules (see WorkflowSchedules); other events carry no trigger timestamp. The
+// queue time needs the runs' jobs. Re-run attempts are skipped as they keep the original creation
+// time.
+func CalculateEventLatency(runs []WorkflowRun, jobsByRun map[int64][]WorkflowJob, schedules map[string][]string) []EventLatency {
+	crons := make(map[string][]cronSchedule, len(schedules))
+	for workflow, exprs := range schedules {
+		for _, expr := range exprs {
+			if c, err := parseCron(expr); err == nil {
+				crons[workflow] = append(crons[workflow], c)
+			}
+		}
+	}
+
+	type samples struct {
+		runs            int
+		dispatch, queue []time.Duration
+	}
+	byEvent := make(map[string]*samples)
+	for _, run := range runs {
+		if run.Attempt > 1 || run.CreatedAt.IsZero() {
+			continue
+		}
+		s, ok := byEvent[run.Event]
+		if !ok {
+			s = &samples{}
+			byEvent[run.Event] = s
+		}
+		s.runs++
+
+		if run.Event == "schedule" {
+			if tick, ok := latestTick(crons[runWorkflow(run)], run.CreatedAt); ok {
+				s.dispatch = append(s.dispatch, run.CreatedAt.Sub(tick))
+			}
+		}
+
+		var firstStart time.Time
+		for _, job := range jobsByRun[run.DatabaseId] {
+			if !job.StartedAt.IsZero() && (firstStart.IsZero() || job.StartedAt.Before(firstStart)) {
+				firstStart = job.StartedAt
+			}
+		}
+		if !firstStart.IsZero() && !firstStart.Before(run.CreatedAt) {
+			s.queue = append(s.queue, firstStart.Sub(run.CreatedAt))
+		}
+	}
+
+	latencies := make([]EventLatency, 0, len(byEvent))
+	for event, s := range byEvent {
+		latencies = append(latencies, EventLatency{
+			Event:    event,
+			Runs:     s.runs,
+			Dispatch: latencyStats(s.dispatch),
+			Queue:    latencyStats(s.queue),
+		})
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		if latencies[i].Runs != latencies[j].Runs {
+			return latencies[i].Runs > latencies[j].Runs
+		}
+		return latencies[i].Event < latencies[j].Event
+	})
+	return latencies
+}
+
+// latestTick returns the latest tick of any of crons at or before t.
+func latestTick(crons []cronSchedule, t time.Time) (time.Time, bool) {
+	var latest time.Time
+	for _, c := range crons {
+		if tick, ok := c.prev(t, scheduleLookback); ok && tick.After(latest) {
+			latest = tick
+		}
+	}
+	return latest, !latest.IsZero()
+}
+
+func latencyStats(ds []time.Duration) LatencyStats {
+	s := summary.Durations(ds)
+	return LatencyStats{Samples: s.Count, Median: s.Median, P90: s.P90, Max: s.Max}
+}
@@ -0,0 +1,50 @@
+package actions
+
+import (
+	"sort"
+	"time"
+)
+
+// FailureGroup is a recurring failure: the failed runs sharing a workflow, failed job and failed step.
+type FailureGroup struct {
+	Workflow   string
+	FailedJob  string // Empty when the failed job was not looked up
+	FailedStep string
+	Count      int
+	LastSeen   time.Time
+	LastURL    string // URL of the most recent failed run
+}
+
+// GroupFailures groups failures by workflow, failed job and failed step, most frequent first (most
+// recent first on ties), so systemic problems stand out from one-off failures.
+func GroupFailures(failures []FailureDetail) []FailureGroup {
+	type key struct{ workflow, job, step string }
+	byKey := make(map[key]*FailureGroup)
+	var order []key
+	for _, f := range failures {
+		k := key{f.WorkflowName, f.FailedJob, f.FailedStep}
+		g, ok := byKey[k]
+		if !ok {
+			g = &FailureGroup{Workflow: f.WorkflowName, FailedJob: f.FailedJob, FailedStep: f.FailedStep}
+			byKey[k] = g
+			order = append(order, k)
+		}
+		g.Count++
+		if g.Count == 1 || f.CreatedAt.After(g.LastSeen) {
+			g.LastSeen = f.CreatedAt
+			g.LastURL = f.URL
+		}
+	}
+
+	groups := make([]FailureGroup, 0, len(order))
+	for _, k := range order {
+		groups = append(groups, *byKey[k])
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].LastSeen.After(groups[j].LastSeen)
+	})
+	return groups
+}
@@ -0,0 +1,137 @@
+package actions
+
+import (
+	"sort"
+	"time"
+)
+
+// RedInterval is a stretch of time during which a workflow's latest run on the default branch was failing.
+type RedInterval struct {
+	Workflow    string
+	Start       time.Time   // Completion of the breaking run
+	End         time.Time   // Completion of the next successful run, or the period end while still red
+	Fixed       bool        // False when the workflow was still red at the end of the period
+	BreakingRun WorkflowRun // First failing run of the interval
+}
+
+// Duration returns how long the interval lasted.
+func (r RedInterval) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// RedTime summarizes how long the default branch was red during a period.
+type RedTime struct {
+	Branch    string
+	Period    time.Duration
+	Red       time.Duration // Time during which at least one workflow was red
+	Fraction  float64       // Red / Period
+	Workflows int           // Workflows with completed runs on the branch
+	Intervals []RedInterval // Longest first
+}
+
+// failingConclusions are the run conclusions that turn a workflow red. Cancelled and skipped
+// runs say nothing about the branch and leave its state unchanged.
+var failingConclusions = map[string]bool{
+	"failure":         true,
+	"timed_out":       true,
+	"startup_failure": true,
+}
+
+// CalculateRedTime reconstructs from the run history when the latest run of each workflow on
+// branch was failing, and how much of [start, end) the branch spent red. Runs before start only
+// establish the state at the start of the period. Pull request runs are ignored.
+func CalculateRedTime(runs []WorkflowRun, branch string, start, end time.Time) RedTime {
+	result := RedTime{Branch: branch, Period: end.Sub(start)}
+	if !end.After(start) {
+		return result
+	}
+
+	var branchRuns []WorkflowRun
+	for _, run := range runs {
+		if run.HeadBranch != branch || run.Event == "pull_request" || run.Status != "completed" {
+			continue
+		}
+		if run.Conclusion != "success" && !failingConclusions[run.Conclusion] {
+			continue
+		}
+		if !run.UpdatedAt.Before(end) {
+			continue
+		}
+		branchRuns = append(branchRuns, run)
+	}
+	sort.SliceStable(branchRuns, func(i, j int) bool { return branchRuns[i].UpdatedAt.Before(branchRuns[j].UpdatedAt) })
+
+	open := make(map[string]*RedInterval)
+	var order []string // Workflow names in first-seen order, so still-red intervals come out deterministically
+	var intervals []RedInterval
+	for _, run := range branchRuns {
+		name := run.WorkflowName
+		if name == "" {
+			name = run.Name
+		}
+		if _, seen := open[name]; !seen {
+			open[name] = nil
+			order = append(order, name)
+		}
+
+		failing := failingConclusions[run.Conclusion]
+		switch current := open[name]; {
+		case failing && current == nil:
+			open[name] = &RedInterval{Workflow: name, Start: run.UpdatedAt, BreakingRun: run}
+		case !failing && current != nil:
+			current.End = run.UpdatedAt
+			current.Fixed = true
+			intervals = append(intervals, *current)
+			open[name] = nil
+		}
+	}
+	for _, name := range order {
+		if current := open[name]; current != nil {
+			current.End = end
+			intervals = append(intervals, *current)
+		}
+	}
+	result.Workflows = len(order)
+
+	// Keep the part of each interval inside the period
+	var clipped []RedInterval
+	for _, interval := range intervals {
+		if interval.Start.Before(start) {
+			interval.Start = start
+		}
+		if interval.End.After(end) {
+			interval.End = end
+			interval.Fixed = false
+		}
+		if interval.End.After(interval.Start) {
+			clipped = append(clipped, interval)
+		}
+	}
+
+	result.Red = unionDuration(clipped)
+	result.Fraction = float64(result.Red) / float64(result.Period)
+	sort.SliceStable(clipped, func(i, j int) bool { return clipped[i].Duration() > clipped[j].Duration() })
+	result.Intervals = clipped
+	return result
+}
+
+// unionDuration returns the total time covered by intervals, counting overlaps once.
+func unionDuration(intervals []RedInterval) time.Duration {
+	sorted := make([]RedInterval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	var total time.Duration
+	var coveredUntil time.Time
+	for _, interval := range sorted {
+		from := interval.Start
+		if from.Before(coveredUntil) {
+			from = coveredUntil
+		}
+		if interval.End.After(from) {
+			total += interval.End.Sub(from)
+			coveredUntil = interval.End
+		}
+	}
+	return total
+}
@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+	"visuche/internal/transport"
+)
+
+// StepStats is the time one step consumed across all runs of a workflow job.
+type StepStats struct {
+	Workflow string
+	Job      string
+	Step     string
+	Runs     int // Executions of the step
+	Total    time.Duration
+	Average  time.Duration
+	Max      time.Duration
+}
+
+// FetchRunJobs fetches the jobs and steps of each completed run, keyed by run ID. Runs whose
+// details cannot be fetched are left out.
+func FetchRunJobs(repo string, runs []WorkflowRun) map[int64][]WorkflowJob {
+	var targets []WorkflowRun
+	for _, run := range runs {
+		if run.Status == "completed" {
+			targets = append(targets, run)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	fmt.Printf("🔍 Fetching job steps for %d runs...\n", len(targets))
+
+	type result struct {
+		id   int64
+		jobs []WorkflowJob
+	}
+
+	queue := make(chan WorkflowRun, len(targets))
+	results := make(chan result, len(targets))
+	const workers = 4
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for run := range queue {
+				results <- result{id: run.DatabaseId, jobs: fetchRunJobs(repo, run.DatabaseId)}
+			}
+		}()
+	}
+
+	for _, run := range targets {
+		queue <- run
+	}
+	close(queue)
+
+	jobsByRun := make(map[int64][]WorkflowJob, len(targets))
+	for i := 0; i < len(targets); i++ {
+		r := <-results
+		if len(r.jobs) > 0 {
+			jobsByRun[r.id] = r.jobs
+		}
+	}
+	return jobsByRun
+}
+
+// fetchRunJobs returns the jobs of a run with their steps, or nil on errors.
+func fetchRunJobs(repo string, runID int64) []WorkflowJob {
+	cmd := transport.Command("gh", "run", "view", fmt.Sprintf("%d", runID), "--repo", repo, "--json", "jobs")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	var details struct {
+		Jobs []WorkflowJob `json:"jobs"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &details); err != nil {
+		return nil
+	}
+	return details.Jobs
+}
+
+// CalculateStepStats aggregates step durations per workflow, job and step, sorted by total time
+// consumed. Steps that were skipped or never finished are ignored.
+func CalculateStepStats(runs []WorkflowRun, jobsByRun map[int64][]WorkflowJob) []StepStats {
+	type key struct{ workflow, job, step string }
+	byKey := make(map[key]*StepStats)
+
+	for _, run := range runs {
+		workflow := run.WorkflowName
+		if workflow == "" {
+			workflow = run.Name
+		}
+		for _, job := range jobsByRun[run.DatabaseId] {
+			for _, step := range job.Steps {
+				if step.Conclusion == "skipped" || step.StartedAt.IsZero() || step.CompletedAt.Before(step.StartedAt) {
+					continue
+				}
+				duration := step.CompletedAt.Sub(step.StartedAt)
+
+				k := key{workflow, job.Name, step.Name}
+				s, ok := byKey[k]
+				if !ok {
+					s = &StepStats{Workflow: workflow, Job: job.Name, Step: step.Name}
+					byKey[k] = s
+				}
+				s.Runs++
+				s.Total += duration
+				if duration > s.Max {
+					s.Max = duration
+				}
+			}
+		}
+	}
+
+	stats := make([]StepStats, 0, len(byKey))
+	for _, s := range byKey {
+		s.Average = s.Total / time.Duration(s.Runs)
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Total != stats[j].Total {
+			return stats[i].Total > stats[j].Total
+		}
+		if stats[i].Workflow != stats[j].Workflow {
+			return stats[i].Workflow < stats[j].Workflow
+		}
+		if stats[i].Job != stats[j].Job {
+			return stats[i].Job < stats[j].Job
+		}
+		return stats[i].Step < stats[j].Step
+	})
+	return stats
+}
@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// restWorkflowRun is a workflow run in the REST API and webhook shape.
+type restWorkflowRun struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	Event        string    `json:"event"`
+	HeadBranch   string    `json:"head_branch"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	RunNumber    int       `json:"run_number"`
+	RunAttempt   int       `json:"run_attempt"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	StartedAt    time.Time `json:"run_started_at"`
+	HTMLURL      string    `json:"html_url"`
+	Actor        struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	TriggeringActor struct {
+		Login string `json:"login"`
+	} `json:"triggering_actor"`
+}
+
+// ParseRESTWorkflowRun converts a run in the REST API shape, such as the workflow_run of a webhook
+// payload, to the gh run list shape used everywhere else.
+func ParseRESTWorkflowRun(data []byte) (WorkflowRun, error) {
+	var r restWorkflowRun
+	if err := json.Unmarshal(data, &r); err != nil {
+		return WorkflowRun{}, fmt.Errorf("failed to unmarshal workflow run: %w", err)
+	}
+	if r.ID == 0 {
+		return WorkflowRun{}, fmt.Errorf("workflow run has no id")
+	}
+	actor := r.TriggeringActor.Login
+	if actor == "" {
+		actor = r.Actor.Login
+	}
+	return WorkflowRun{
+		Attempt:      r.RunAttempt,
+		Conclusion:   r.Conclusion,
+		CreatedAt:    r.CreatedAt,
+		DatabaseId:   r.ID,
+		DisplayTitle: r.DisplayTitle,
+		Event:        r.Event,
+		HeadBranch:   r.HeadBranch,
+		Name:         r.Name,
+		Number:       r.RunNumber,
+		StartedAt:    r.StartedAt,
+		Status:       r.Status,
+		UpdatedAt:    r.UpdatedAt,
+		WorkflowName: r.Name,
+		URL:          r.HTMLURL,
+		Actor:        actor,
+	}, nil
+}
@@ -0,0 +1,221 @@
+package actions
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"visuche/internal/transport"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WorkflowsDir is where GitHub Actions workflow files live in a repository.
+const WorkflowsDir = ".github/workflows"
+
+// WorkflowFile is a workflow definition file of the repository.
+type WorkflowFile struct {
+	Path    string // e.g. ".github/workflows/ci.yml"
+	Content []byte
+}
+
+// WorkflowAudit is the hygiene check result of one workflow file.
+type WorkflowAudit struct {
+	Path               string
+	Name               string   // The workflow's name, or its path when unnamed (as GitHub shows it)
+	Runs               int      // Runs in the analyzed period
+	Jobs               int      // Jobs defined in the file
+	HasConcurrency     bool     // A concurrency group at workflow level or on every job
+	JobsWithoutTimeout []string // Jobs without timeout-minutes (GitHub's default is 360 minutes)
+	MutableRefs        []string // Actions pinned to a tag or branch instead of a commit SHA
+	ParseError         string
+}
+
+// Issues returns how many hygiene problems the workflow has.
+func (a WorkflowAudit) Issues() int {
+	if a.ParseError != "" {
+		return 1
+	}
+	issues := len(a.JobsWithoutTimeout) + len(a.MutableRefs)
+	if a.Runs == 0 {
+		issues++
+	}
+	if !a.HasConcurrency {
+		issues++
+	}
+	return issues
+}
+
+// workflowSpec is the part of a workflow file the audit looks at.
+type workflowSpec struct {
+	Name        string             `yaml:"name"`
+	On          interface{}        `yaml:"on"`
+	Concurrency interface{}        `yaml:"concurrency"`
+	Jobs        map[string]jobSpec `yaml:"jobs"`
+}
+
+type jobSpec struct {
+	Uses           string      `yaml:"uses"` // Reusable workflow call
+	TimeoutMinutes interface{} `yaml:"timeout-minutes"`
+	Concurrency    interface{} `yaml:"concurrency"`
+	Steps          []struct {
+		Uses string `yaml:"uses"`
+	} `yaml:"steps"`
+}
+
+// commitSHA matches a full commit SHA, the only immutable action reference.
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// AuditWorkflows checks each workflow file for missing concurrency groups and timeouts, actions
+// pinned to mutable refs, and whether it ran at all in runs.
+func AuditWorkflows(files []WorkflowFile, runs []WorkflowRun) []WorkflowAudit {
+	runsByName := make(map[string]int)
+	for _, run := range runs {
+		name := run.WorkflowName
+		if name == "" {
+			name = run.Name
+		}
+		runsByName[name]++
+	}
+
+	audits := make([]WorkflowAudit, 0, len(files))
+	for _, file := range files {
+		audit := WorkflowAudit{Path: file.Path, Name: file.Path}
+
+		var spec workflowSpec
+		if err := yaml.Unmarshal(file.Content, &spec); err != nil {
+			audit.ParseError = err.Error()
+			audits = append(audits, audit)
+			continue
+		}
+		if spec.Name != "" {
+			audit.Name = spec.Name
+		}
+		audit.Runs = runsByName[audit.Name]
+		audit.Jobs = len(spec.Jobs)
+
+		jobIDs := make([]string, 0, len(spec.Jobs))
+		for id := range spec.Jobs {
+			jobIDs = append(jobIDs, id)
+		}
+		sort.Strings(jobIDs)
+
+		allJobsConcurrent := len(jobIDs) > 0
+		seenRefs := make(map[string]bool)
+		for _, id := range jobIDs {
+			job := spec.Jobs[id]
+			if job.Concurrency == nil {
+				allJobsConcurrent = false
+			}
+			// Jobs calling a reusable workflow cannot set timeout-minutes; the called workflow does
+			if job.Uses == "" && job.TimeoutMinutes == nil {
+				audit.JobsWithoutTimeout = append(audit.JobsWithoutTimeout, id)
+			}
+
+			refs := []string{job.Uses}
+			for _, step := range job.Steps {
+				refs = append(refs, step.Uses)
+			}
+			for _, ref := range refs {
+				if isMutableRef(ref) && !seenRefs[ref] {
+					seenRefs[ref] = true
+					audit.MutableRefs = append(audit.MutableRefs, ref)
+				}
+			}
+		}
+		audit.HasConcurrency = spec.Concurrency != nil || allJobsConcurrent
+		audits = append(audits, audit)
+	}
+
+	sort.SliceStable(audits, func(i, j int) bool {
+		if audits[i].Issues() != audits[j].Issues() {
+			return audits[i].Issues() > audits[j].Issues()
+		}
+		return audits[i].Path < audits[j].Path
+	})
+	return audits
+}
+
+// WorkflowSchedules returns the `on.schedule` cron expressions of each workflow, keyed by workflow
+// name (or path when unnamed). Files that fail to parse are skipped.
+func WorkflowSchedules(files []WorkflowFile) map[string][]string {
+	schedules := make(map[string][]string)
+	for _, file := range files {
+		var spec workflowSpec
+		if err := yaml.Unmarshal(file.Content, &spec); err != nil {
+			continue
+		}
+		triggers, ok := spec.On.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entries, _ := triggers["schedule"].([]interface{})
+		name := spec.Name
+		if name == "" {
+			name = file.Path
+		}
+		for _, entry := range entries {
+			if fields, ok := entry.(map[string]interface{}); ok {
+				if cron, ok := fields["cron"].(string); ok {
+					schedules[name] = append(schedules[name], cron)
+				}
+			}
+		}
+	}
+	return schedules
+}
+
+// isMutableRef reports whether a `uses:` reference points at a tag or branch of a remote action.
+// Local actions (./...) and Docker images are not checked.
+func isMutableRef(ref string) bool {
+	if ref == "" || strings.HasPrefix(ref, "./") || strings.HasPrefix(ref, "docker://") {
+		return false
+	}
+	at := strings.LastIndex(ref, "@")
+	if at < 0 {
+		return true
+	}
+	return !commitSHA.MatchString(ref[at+1:])
+}
+
+// FetchWorkflowFiles fetches the workflow files of repo from the default branch. A repository
+// without a workflows directory yields no files.
+func FetchWorkflowFiles(repo string) ([]WorkflowFile, error) {
+	list, err := ghAPI(fmt.Sprintf("repos/%s/contents/%s", repo, WorkflowsDir),
+		"--jq", `.[] | select(.type == "file") | .path`)
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var files []WorkflowFile
+	for _, p := range strings.Fields(string(list)) {
+		if ext := path.Ext(p); ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+		content, err := ghAPI(fmt.Sprintf("repos/%s/contents/%s", repo, p), "-H", "Accept: application/vnd.github.raw")
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, WorkflowFile{Path: p, Content: content})
+	}
+	return files, nil
+}
+
+// ghAPI runs `gh api endpoint args...` and returns its output.
+func ghAPI(endpoint string, args ...string) ([]byte, error) {
+	cmd := transport.Command("gh", append([]string{"api", endpoint}, args...)...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,218 @@
+// Package chart renders simple line and bar charts to SVG and PNG in pure Go, so report charts can be
+// embedded in documents and slides without a browser or external tools. Text is drawn with a built-in
+// ASCII bitmap font in PNGs, so titles and labels should be plain ASCII.
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"math"
+	"os"
+)
+
+// Kind selects how the points are drawn.
+type Kind int
+
+const (
+	Line Kind = iota
+	Bar
+)
+
+// Point is one labeled value; labels run along the x axis in order.
+type Point struct {
+	Label string
+	Value float64
+}
+
+// Chart is a single-series chart.
+type Chart struct {
+	Title  string
+	YLabel string // Unit of the values, shown above the y axis
+	Kind   Kind
+	Points []Point
+}
+
+const (
+	width        = 800
+	height       = 400
+	marginLeft   = 70
+	marginRight  = 20
+	marginTop    = 50
+	marginBottom = 50
+	gridLines    = 5
+	maxXLabels   = 10
+)
+
+var (
+	background = color.RGBA{255, 255, 255, 255}
+	foreground = color.RGBA{33, 37, 41, 255}
+	grid       = color.RGBA{222, 226, 230, 255}
+	accent     = color.RGBA{13, 110, 253, 255}
+)
+
+// Text anchors.
+const (
+	anchorStart = iota
+	anchorMiddle
+	anchorEnd
+)
+
+// canvas is what a chart is drawn on; y grows downwards and text is positioned by its baseline.
+type canvas interface {
+	rect(x, y, w, h float64, c color.RGBA)
+	line(x1, y1, x2, y2, strokeWidth float64, c color.RGBA)
+	text(x, y float64, s string, anchor int, c color.RGBA)
+}
+
+// WriteFiles renders the chart to base+".svg" and base+".png" and returns the paths written.
+func (c Chart) WriteFiles(base string) ([]string, error) {
+	png, err := c.PNG()
+	if err != nil {
+		return nil, err
+	}
+	files := []struct {
+		path string
+		data []byte
+	}{
+		{base + ".svg", c.SVG()},
+		{base + ".png", png},
+	}
+
+	var paths []string
+	for _, f := range files {
+		if err := os.WriteFile(f.path, f.data, 0o644); err != nil {
+			return paths, fmt.Errorf("failed to write chart: %w", err)
+		}
+		paths = append(paths, f.path)
+	}
+	return paths, nil
+}
+
+// draw lays the chart out on cv.
+func (c Chart) draw(cv canvas) {
+	cv.rect(0, 0, width, height, background)
+	cv.text(width/2, 28, c.Title, anchorMiddle, foreground)
+	if c.YLabel != "" {
+		cv.text(marginLeft-10, marginTop-12, c.YLabel, anchorEnd, foreground)
+	}
+
+	plotW := float64(width - marginLeft - marginRight)
+	plotH := float64(height - marginTop - marginBottom)
+	bottom := float64(height - marginBottom)
+
+	top := c.axisMax()
+	step := top / gridLines
+	y := func(v float64) float64 { return bottom - v/top*plotH }
+
+	for i := 0; i <= gridLines; i++ {
+		v := step * float64(i)
+		cv.line(marginLeft, y(v), width-marginRight, y(v), 1, grid)
+		cv.text(marginLeft-8, y(v)+4, formatValue(v), anchorEnd, foreground)
+	}
+	cv.line(marginLeft, marginTop, marginLeft, bottom, 1, foreground)
+	cv.line(marginLeft, bottom, width-marginRight, bottom, 1, foreground)
+
+	n := len(c.Points)
+	if n == 0 {
+		cv.text(width/2, bottom-plotH/2, "no data", anchorMiddle, foreground)
+		return
+	}
+
+	slot := plotW / float64(n)
+	x := func(i int) float64 { return marginLeft + slot*(float64(i)+0.5) }
+	labelEvery := (n + maxXLabels - 1) / maxXLabels
+	for i, p := range c.Points {
+		if i%labelEvery == 0 {
+			cv.text(x(i), bottom+20, p.Label, anchorMiddle, foreground)
+		}
+	}
+
+	switch c.Kind {
+	case Bar:
+		barW := slot * 0.6
+		for i, p := range c.Points {
+			cv.rect(x(i)-barW/2, y(p.Value), barW, bottom-y(p.Value), accent)
+			cv.text(x(i), y(p.Value)-6, formatValue(p.Value), anchorMiddle, foreground)
+		}
+	default:
+		for i := 1; i < n; i++ {
+			cv.line(x(i-1), y(c.Points[i-1].Value), x(i), y(c.Points[i].Value), 2, accent)
+		}
+		for i, p := range c.Points {
+			cv.rect(x(i)-3, y(p.Value)-3, 6, 6, accent)
+		}
+	}
+}
+
+// niceStep rounds a raw grid step up to 1, 2 or 5 times a power of ten.
+func niceStep(raw float64) float64 {
+	if raw <= 0 {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if raw <= m*magnitude {
+			return m * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+// formatValue prints whole numbers without decimals and others with one.
+func formatValue(v float64) string {
+	if v == math.Trunc(v) {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+// SVG renders the chart as an SVG document.
+func (c Chart) SVG() []byte {
+	cv := &svgCanvas{}
+	fmt.Fprintf(&cv.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n", width, height, width, height)
+	c.draw(cv)
+	cv.buf.WriteString("</svg>\n")
+	return cv.buf.Bytes()
+}
+
+// svgCanvas writes SVG elements.
+type svgCanvas struct {
+	buf bytes.Buffer
+}
+
+func (s *svgCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	fmt.Fprintf(&s.buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`+"\n", x, y, w, h, hex(c))
+}
+
+func (s *svgCanvas) line(x1, y1, x2, y2, strokeWidth float64, c color.RGBA) {
+	fmt.Fprintf(&s.buf, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="%.0f"/>`+"\n", x1, y1, x2, y2, hex(c), strokeWidth)
+}
+
+func (s *svgCanvas) text(x, y float64, str string, anchor int, c color.RGBA) {
+	anchors := map[int]string{anchorStart: "start", anchorMiddle: "middle", anchorEnd: "end"}
+	fmt.Fprintf(&s.buf, `<text x="%.1f" y="%.1f" text-anchor="%s" fill="%s">%s</text>`+"\n", x, y, anchors[anchor], hex(c), escapeXML(str))
+}
+
+// hex formats c as #rrggbb.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// escapeXML escapes the characters that are special in SVG text.
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
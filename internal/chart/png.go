@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// PNG renders the chart as a PNG image.
+func (c Chart) PNG() ([]byte, error) {
+	cv := &pngCanvas{img: image.NewRGBA(image.Rect(0, 0, width, height))}
+	c.draw(cv)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, cv.img); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// pngCanvas rasterizes onto an RGBA image.
+type pngCanvas struct {
+	img *image.RGBA
+}
+
+func (p *pngCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	r := image.Rect(int(math.Round(x)), int(math.Round(y)), int(math.Round(x+w)), int(math.Round(y+h)))
+	draw.Draw(p.img, r, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// line stamps a square of strokeWidth every half pixel along the segment.
+func (p *pngCanvas) line(x1, y1, x2, y2, strokeWidth float64, c color.RGBA) {
+	steps := int(math.Ceil(math.Hypot(x2-x1, y2-y1) * 2))
+	if steps == 0 {
+		steps = 1
+	}
+	for i := 0; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		x, y := x1+(x2-x1)*t, y1+(y2-y1)*t
+		p.rect(x-strokeWidth/2, y-strokeWidth/2, strokeWidth, strokeWidth, c)
+	}
+}
+
+func (p *pngCanvas) text(x, y float64, s string, anchor int, c color.RGBA) {
+	face := basicfont.Face7x13
+	d := &font.Drawer{Dst: p.img, Src: image.NewUniform(c), Face: face}
+	w := d.MeasureString(s)
+	start := fixed.I(int(math.Round(x)))
+	switch anchor {
+	case anchorMiddle:
+		start -= w / 2
+	case anchorEnd:
+		start -= w
+	}
+	d.Dot = fixed.Point26_6{X: start, Y: fixed.I(int(math.Round(y)))}
+	d.DrawString(s)
+}
@@ -0,0 +1,115 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Snippet formats.
+const (
+	Mermaid  = "mermaid"
+	PlantUML = "plantuml"
+)
+
+// SnippetFormats lists the formats Snippet accepts.
+var SnippetFormats = []string{Mermaid, PlantUML}
+
+// Snippet renders the chart as a diagram definition in format, for wikis that render Mermaid or PlantUML
+// natively.
+func (c Chart) Snippet(format string) (string, error) {
+	switch format {
+	case Mermaid:
+		return c.mermaid(), nil
+	case PlantUML:
+		return c.plantUML(), nil
+	default:
+		return "", fmt.Errorf("unsupported chart snippet format %q (supported: %s)", format, strings.Join(SnippetFormats, ", "))
+	}
+}
+
+// mermaid renders line charts as an xychart and bar charts as a pie of the non-zero values.
+func (c Chart) mermaid() string {
+	var b strings.Builder
+	if c.Kind == Bar {
+		b.WriteString("pie showData\n")
+		fmt.Fprintf(&b, "    title %s\n", c.Title)
+		for _, p := range c.Points {
+			if p.Value > 0 {
+				fmt.Fprintf(&b, "    %s : %s\n", quote(p.Label), formatValue(p.Value))
+			}
+		}
+		return b.String()
+	}
+
+	labels := make([]string, len(c.Points))
+	values := make([]string, len(c.Points))
+	for i, p := range c.Points {
+		labels[i] = quote(p.Label)
+		values[i] = formatValue(round1(p.Value))
+	}
+	b.WriteString("xychart-beta\n")
+	fmt.Fprintf(&b, "    title %s\n", quote(c.Title))
+	fmt.Fprintf(&b, "    x-axis [%s]\n", strings.Join(labels, ", "))
+	fmt.Fprintf(&b, "    y-axis %s 0 --> %s\n", quote(c.YLabel), formatValue(c.axisMax()))
+	fmt.Fprintf(&b, "    line [%s]\n", strings.Join(values, ", "))
+	return b.String()
+}
+
+// plantUML renders line charts as a timing diagram with an analog signal (one tick per point, the labels
+// of the first and last point in the legend) and bar charts as a Salt table with text bars.
+func (c Chart) plantUML() string {
+	var b strings.Builder
+	if c.Kind == Bar {
+		maxValue := 0.0
+		for _, p := range c.Points {
+			maxValue = math.Max(maxValue, p.Value)
+		}
+		const barWidth = 20
+		b.WriteString("@startsalt\n")
+		fmt.Fprintf(&b, "title %s\n", c.Title)
+		b.WriteString("{#\n")
+		fmt.Fprintf(&b, "  . | %s | .\n", c.YLabel)
+		for _, p := range c.Points {
+			bar := 0
+			if maxValue > 0 {
+				bar = int(math.Round(p.Value / maxValue * barWidth))
+			}
+			fmt.Fprintf(&b, "  %s | %s | %s\n", p.Label, formatValue(p.Value), strings.Repeat("█", bar))
+		}
+		b.WriteString("}\n")
+		b.WriteString("@endsalt\n")
+		return b.String()
+	}
+
+	b.WriteString("@startuml\n")
+	fmt.Fprintf(&b, "title %s\n", c.Title)
+	fmt.Fprintf(&b, "analog %s between 0 and %s as V\n", quote(c.YLabel), formatValue(c.axisMax()))
+	for i, p := range c.Points {
+		fmt.Fprintf(&b, "@%d\nV is %s\n", i, formatValue(round1(p.Value)))
+	}
+	if n := len(c.Points); n > 0 {
+		fmt.Fprintf(&b, "legend\n%s … %s\nendlegend\n", c.Points[0].Label, c.Points[n-1].Label)
+	}
+	b.WriteString("@enduml\n")
+	return b.String()
+}
+
+// axisMax is the top of the value axis, the same as in the rendered images.
+func (c Chart) axisMax() float64 {
+	maxValue := 0.0
+	for _, p := range c.Points {
+		maxValue = math.Max(maxValue, p.Value)
+	}
+	return niceStep(maxValue/gridLines) * gridLines
+}
+
+// round1 rounds v to one decimal.
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+// quote wraps s in double quotes, dropping any it contains.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "") + `"`
+}
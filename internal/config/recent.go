@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// MaxRecentRepos is how many recently analyzed repositories are remembered.
+const MaxRecentRepos = 10
+
+// RecentRepo is a repository analyzed before, most recent use first in the recent list.
+type RecentRepo struct {
+	Provider string    `json:"provider"`
+	Repo     string    `json:"repo"`
+	LastUsed time.Time `json:"lastUsed"`
+}
+
+// RecentReposPath returns the recent repositories file next to the config at configPath.
+// It is kept separate from config.json so the hand-edited config is never rewritten.
+func RecentReposPath(configPath string) string {
+	if configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(configPath), "recent.json")
+}
+
+// LoadRecentRepos reads the recent repositories at path. A missing file yields an empty list.
+func LoadRecentRepos(path string) ([]RecentRepo, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recent repositories: %w", err)
+	}
+
+	var recent []RecentRepo
+	if err := json.Unmarshal(data, &recent); err != nil {
+		return nil, fmt.Errorf("failed to parse recent repositories %s: %w", path, err)
+	}
+	return recent, nil
+}
+
+// RememberRepo moves repo to the front of the recent list at path, keeping at most MaxRecentRepos entries.
+func RememberRepo(path, provider, repo string, now time.Time) error {
+	if path == "" {
+		return nil
+	}
+	recent, err := LoadRecentRepos(path)
+	if err != nil {
+		// A corrupt file is replaced rather than blocking the run
+		recent = nil
+	}
+
+	updated := []RecentRepo{{Provider: provider, Repo: repo, LastUsed: now}}
+	for _, r := range recent {
+		if r.Provider == provider && r.Repo == repo {
+			continue
+		}
+		updated = append(updated, r)
+	}
+	if len(updated) > MaxRecentRepos {
+		updated = updated[:MaxRecentRepos]
+	}
+
+	data, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config dir: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write recent repositories: %w", err)
+	}
+	return nil
+}
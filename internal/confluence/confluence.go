@@ -0,0 +1,184 @@
+package confluence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"visuche/internal/notify"
+)
+
+// Client talks to the Confluence REST API.
+type Client struct {
+	BaseURL string // e.g. https://example.atlassian.net/wiki
+	Email   string // When set, Token is sent with basic auth (Confluence Cloud API token); otherwise as a bearer token
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient returns a client for the Confluence instance at baseURL.
+func NewClient(baseURL, email, token string) *Client {
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Email:   email,
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// content mirrors the subset of a Confluence content object we read and write.
+type content struct {
+	ID        string     `json:"id,omitempty"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Space     *spaceRef  `json:"space,omitempty"`
+	Ancestors []ancestor `json:"ancestors,omitempty"`
+	Version   *version   `json:"version,omitempty"`
+	Body      *body      `json:"body,omitempty"`
+	Links     struct {
+		Base  string `json:"base"`
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+}
+
+type spaceRef struct {
+	Key string `json:"key"`
+}
+
+type ancestor struct {
+	ID string `json:"id"`
+}
+
+type version struct {
+	Number int `json:"number"`
+}
+
+type body struct {
+	Storage storage `json:"storage"`
+}
+
+type storage struct {
+	Value          string `json:"value"`
+	Representation string `json:"representation"`
+}
+
+// PublishPage creates the page titled title in space, or updates it when it already exists,
+// and returns its URL. parentID places a new page under an existing one.
+func (c *Client) PublishPage(space, title, parentID, storageBody string) (string, error) {
+	existing, err := c.findPage(space, title)
+	if err != nil {
+		return "", err
+	}
+
+	page := content{
+		Type:  "page",
+		Title: title,
+		Space: &spaceRef{Key: space},
+		Body:  &body{Storage: storage{Value: storageBody, Representation: "storage"}},
+	}
+	method, path := http.MethodPost, "/rest/api/content"
+	if existing != nil {
+		page.ID = existing.ID
+		page.Version = &version{Number: existing.Version.Number + 1}
+		method, path = http.MethodPut, "/rest/api/content/"+url.PathEscape(existing.ID)
+	} else if parentID != "" {
+		page.Ancestors = []ancestor{{ID: parentID}}
+	}
+
+	var result content
+	if err := c.do(method, path, page, &result); err != nil {
+		return "", err
+	}
+	base := result.Links.Base
+	if base == "" {
+		base = c.BaseURL
+	}
+	return base + result.Links.WebUI, nil
+}
+
+// findPage returns the page with the exact title in space, or nil when there is none.
+func (c *Client) findPage(space, title string) (*content, error) {
+	params := url.Values{}
+	params.Set("spaceKey", space)
+	params.Set("title", title)
+	params.Set("type", "page")
+	params.Set("expand", "version")
+
+	var result struct {
+		Results []content `json:"results"`
+	}
+	if err := c.do(http.MethodGet, "/rest/api/content?"+params.Encode(), nil, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+	return &result.Results[0], nil
+}
+
+func (c *Client) do(method, path string, payload, out interface{}) error {
+	var reader io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build Confluence request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Email != "" {
+		req.SetBasicAuth(c.Email, c.Token)
+	} else if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return fmt.Errorf("Confluence request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Confluence response: %w", err)
+	}
+	if resp.StatusCode/100 != 2 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		return fmt.Errorf("Confluence returned HTTP %d: %s", resp.StatusCode, apiErr.Message)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal Confluence response: %w", err)
+	}
+	return nil
+}
+
+// RenderSummary renders s in Confluence storage format (XHTML).
+func RenderSummary(s notify.Summary, generatedAt time.Time) string {
+	var b strings.Builder
+	if s.Text != "" {
+		fmt.Fprintf(&b, "<p><strong>%s</strong></p>", html.EscapeString(s.Text))
+	}
+	b.WriteString("<table><tbody>")
+	for _, f := range s.Fields {
+		fmt.Fprintf(&b, "<tr><th>%s</th><td>%s</td></tr>", html.EscapeString(f.Label), html.EscapeString(f.Value))
+	}
+	b.WriteString("</tbody></table>")
+	fmt.Fprintf(&b, "<p><em>Generated by visuche at %s</em></p>", html.EscapeString(generatedAt.Format("2006-01-02 15:04 MST")))
+	return b.String()
+}
@@ -1,29 +1,51 @@
 package csv
 
 import (
-	"encoding/csv"
+	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"strings"
 	"time"
+	"visuche/internal/actions"
 	"visuche/internal/github"
+	"visuche/internal/metadata"
+	"visuche/internal/stats"
 )
 
-// WritePullRequestsToCSV writes a slice of PullRequests to a CSV file.
-func WritePullRequestsToCSV(filename string, prs []github.PullRequest) error {
-	file, err := os.Create(filename)
+// MetadataPrefix starts the comment line holding the metadata block (compact JSON) above the header row.
+// Readers skip it by treating '#' as the comment character.
+const MetadataPrefix = "# visuche-metadata: "
+
+// ListSeparator joins the label names and reviewer logins in the Labels and Reviewers columns.
+const ListSeparator = ";"
+
+// writeMetadata writes the metadata comment line before the CSV header.
+func writeMetadata(w io.Writer, meta metadata.Metadata) error {
+	data, err := json.Marshal(meta)
 	if err != nil {
-		return fmt.Errorf("failed to create CSV file: %w", err)
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "%s%s\n", MetadataPrefix, data); err != nil {
+		return fmt.Errorf("failed to write metadata: %w", err)
 	}
-	defer file.Close()
+	return nil
+}
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// WritePullRequestsToCSV writes a slice of PullRequests to a CSV file.
+func WritePullRequestsToCSV(filename string, prs []github.PullRequest, meta metadata.Metadata) error {
+	writer, closeFile, err := createCSV(filename, meta)
+	if err != nil {
+		return err
+	}
+	defer closeFile() // Only takes effect on early returns
 
 	// Write CSV header
 	header := []string{
-		"Number", "Title", "CreatedAt", "MergedAt", "ClosedAt", "Merged", "LeadTime (Hours)",
+		"Number", "Title", "URL", "CreatedAt", "MergedAt", "ClosedAt", "Merged", "LeadTime (Hours)",
 		"Author", "Additions", "Deletions", "ChangedFiles", "Commits",
-		"IsDraft", "State", "MergedBy",
+		"IsDraft", "State", "BaseRef", "HeadRef", "MergedBy", "Labels",
+		"Reviewers", "Reviews", "Approvals", "FirstReviewAt", "ReviewComments",
+		"CodingTime (Hours)", "PickupTime (Hours)", "ReviewTime (Hours)", "MergeTime (Hours)",
 	}
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write CSV header: %w", err)
@@ -32,9 +54,15 @@ func WritePullRequestsToCSV(filename string, prs []github.PullRequest) error {
 	// Write PR data
 	for _, pr := range prs {
 		leadTimeHours := pr.LeadTime.Hours()
+		reviews, approvals, firstReview := reviewCounts(pr)
+		firstReviewAt := "" // Left empty when nobody reviewed
+		if !firstReview.IsZero() {
+			firstReviewAt = firstReview.Format(time.RFC3339)
+		}
 		record := []string{
 			fmt.Sprintf("%d", pr.Number),
 			pr.Title,
+			pr.URL,
 			pr.CreatedAt.Format(time.RFC3339),
 			pr.MergedAt.Format(time.RFC3339),
 			pr.ClosedAt.Format(time.RFC3339),
@@ -47,12 +75,131 @@ func WritePullRequestsToCSV(filename string, prs []github.PullRequest) error {
 			"0", // Commits disabled due to GraphQL complexity
 			fmt.Sprintf("%t", pr.IsDraft),
 			pr.State,
+			pr.BaseRefName,
+			pr.HeadRefName,
 			pr.MergedBy.Login,
+			strings.Join(pr.LabelNames(), ListSeparator),
+			strings.Join(pr.ReviewerLogins(), ListSeparator),
+			fmt.Sprintf("%d", reviews),
+			fmt.Sprintf("%d", approvals),
+			firstReviewAt,
+			fmt.Sprintf("%d", pr.ReviewCommentCount),
+			fmt.Sprintf("%.2f", pr.CodingTime.Hours()),
+			fmt.Sprintf("%.2f", pr.PickupTime.Hours()),
+			fmt.Sprintf("%.2f", pr.ReviewTime.Hours()),
+			fmt.Sprintf("%.2f", pr.MergeTime.Hours()),
 		}
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write CSV record: %w", err)
 		}
 	}
 
-	return nil
-}
\ No newline at end of file
+	return closeFile()
+}
+
+// reviewCounts returns the number of reviews and approvals submitted on pr by people other than the
+// author, and when the first of them was submitted (zero when nobody reviewed).
+func reviewCounts(pr github.PullRequest) (reviews, approvals int, first time.Time) {
+	for _, r := range pr.Reviews {
+		if r.Author.Login == pr.Author.Login {
+			continue
+		}
+		reviews++
+		if strings.EqualFold(r.State, "APPROVED") {
+			approvals++
+		}
+		if first.IsZero() || r.SubmittedAt.Before(first) {
+			first = r.SubmittedAt
+		}
+	}
+	return reviews, approvals, first
+}
+
+// WriteActivityCalendarToCSV writes a per-author activity calendar to a CSV file, one row per author and day.
+func WriteActivityCalendarToCSV(filename string, days []stats.ActivityDay, meta metadata.Metadata) error {
+	writer, closeFile, err := createCSV(filename, meta)
+	if err != nil {
+		return err
+	}
+	defer closeFile() // Only takes effect on early returns
+
+	if err := writer.Write([]string{"Date", "Author", "PRsOpened", "PRsMerged", "ReviewsGiven"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, d := range days {
+		record := []string{
+			d.Date.Format("2006-01-02"),
+			d.Author,
+			fmt.Sprintf("%d", d.PRsOpened),
+			fmt.Sprintf("%d", d.PRsMerged),
+			fmt.Sprintf("%d", d.ReviewsGiven),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+	return closeFile()
+}
+
+// WriteScorecardToCSV writes a repository scorecard to a CSV file, one row per repository.
+func WriteScorecardToCSV(filename string, rows []stats.ScorecardRow, meta metadata.Metadata) error {
+	writer, closeFile, err := createCSV(filename, meta)
+	if err != nil {
+		return err
+	}
+	defer closeFile() // Only takes effect on early returns
+
+	header := []string{
+		"Repo", "MergedPRs", "MedianLeadTime (Hours)", "MergeRate (%)", "CIRuns", "CISuccessRate (%)",
+		"OpenPRs", "MedianOpenAge (Hours)", "Error",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, r := range rows {
+		record := []string{
+			r.Repo,
+			fmt.Sprintf("%d", r.MergedPRs),
+			fmt.Sprintf("%.2f", r.MedianLeadTime.Hours()),
+			fmt.Sprintf("%.1f", r.MergeRate),
+			fmt.Sprintf("%d", r.CIRuns),
+			fmt.Sprintf("%.1f", r.CISuccessRate),
+			fmt.Sprintf("%d", r.OpenPRs),
+			fmt.Sprintf("%.2f", r.MedianOpenAge.Hours()),
+			r.Err,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+	return closeFile()
+}
+
+// WriteFailureDetailsToCSV writes failed workflow runs to a CSV file, one row per run.
+func WriteFailureDetailsToCSV(filename string, failures []actions.FailureDetail, meta metadata.Metadata) error {
+	writer, closeFile, err := createCSV(filename, meta)
+	if err != nil {
+		return err
+	}
+	defer closeFile() // Only takes effect on early returns
+
+	header := []string{"Workflow", "Title", "CreatedAt", "Duration (Seconds)", "FailedJob", "FailedStep", "URL"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, f := range failures {
+		record := []string{
+			f.WorkflowName,
+			f.DisplayTitle,
+			f.CreatedAt.Format(time.RFC3339),
+			fmt.Sprintf("%.0f", f.Duration.Seconds()),
+			f.FailedJob,
+			f.FailedStep,
+			f.URL,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+	return closeFile()
+}
@@ -0,0 +1,142 @@
+package csv
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"visuche/internal/metadata"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// Encodings of CSV files.
+const (
+	EncodingUTF8     = "utf-8"
+	EncodingUTF8BOM  = "utf-8-bom"
+	EncodingShiftJIS = "shift_jis"
+)
+
+// utf8BOM makes Excel open a UTF-8 CSV as UTF-8 instead of the system code page.
+const utf8BOM = "\uFEFF"
+
+// Format is the delimiter and encoding CSV files are written and read with.
+type Format struct {
+	Delimiter rune
+	Encoding  string
+}
+
+// DefaultFormat is comma-separated UTF-8 without a BOM.
+func DefaultFormat() Format {
+	return Format{Delimiter: ',', Encoding: EncodingUTF8}
+}
+
+var format = DefaultFormat()
+
+// SetFormat replaces the format used by every CSV writer and reader.
+func SetFormat(f Format) {
+	format = f
+}
+
+// ParseDelimiter accepts comma, semicolon or tab (by name or as the character itself).
+func ParseDelimiter(name string) (rune, error) {
+	switch strings.ToLower(name) {
+	case "comma", ",":
+		return ',', nil
+	case "semicolon", ";":
+		return ';', nil
+	case "tab", "\t", `\t`:
+		return '\t', nil
+	default:
+		return 0, fmt.Errorf("unsupported CSV delimiter %q (supported: comma, semicolon, tab)", name)
+	}
+}
+
+// ParseEncoding accepts utf-8, utf-8-bom or shift_jis, ignoring case and common spellings such as sjis.
+func ParseEncoding(name string) (string, error) {
+	switch strings.ReplaceAll(strings.ToLower(name), "_", "-") {
+	case "utf-8", "utf8":
+		return EncodingUTF8, nil
+	case "utf-8-bom", "utf8-bom", "utf-8bom":
+		return EncodingUTF8BOM, nil
+	case "shift-jis", "sjis", "cp932", "windows-31j":
+		return EncodingShiftJIS, nil
+	default:
+		return "", fmt.Errorf("unsupported CSV encoding %q (supported: utf-8, utf-8-bom, shift_jis)", name)
+	}
+}
+
+// createCSV creates filename in the current format, writes the metadata comment line and returns a CSV
+// writer on it. The returned function flushes the writer and closes the file; calls after the first do nothing.
+func createCSV(filename string, meta metadata.Metadata) (*csv.Writer, func() error, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CSV file: %w", err)
+	}
+
+	var out io.Writer = file
+	var encoder io.WriteCloser
+	switch format.Encoding {
+	case EncodingUTF8BOM:
+		if _, err := io.WriteString(file, utf8BOM); err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("failed to write CSV file: %w", err)
+		}
+	case EncodingShiftJIS:
+		// Characters Shift_JIS cannot represent, such as emoji in PR titles, become the SUB control character
+		encoder = transform.NewWriter(file, encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder()))
+		out = encoder
+	}
+
+	if err := writeMetadata(out, meta); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	writer := csv.NewWriter(out)
+	writer.Comma = format.Delimiter
+
+	closed := false
+	closeFile := func() error {
+		if closed {
+			return nil
+		}
+		closed = true
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to write CSV file: %w", err)
+		}
+		if encoder != nil {
+			if err := encoder.Close(); err != nil {
+				file.Close()
+				return fmt.Errorf("failed to write CSV file: %w", err)
+			}
+		}
+		return file.Close()
+	}
+	return writer, closeFile, nil
+}
+
+// newReader returns a CSV reader on file in the current format that skips the metadata comment line.
+// A UTF-8 BOM is skipped whatever the configured encoding.
+func newReader(file *os.File) (*csv.Reader, error) {
+	var in io.Reader = file
+	if format.Encoding == EncodingShiftJIS {
+		in = japanese.ShiftJIS.NewDecoder().Reader(file)
+	}
+	buffered := bufio.NewReader(in)
+	if r, _, err := buffered.ReadRune(); err == nil && r != '\uFEFF' {
+		if err := buffered.UnreadRune(); err != nil {
+			return nil, fmt.Errorf("failed to read CSV file: %w", err)
+		}
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.Comma = format.Delimiter
+	reader.Comment = '#' // Metadata line
+	return reader, nil
+}
@@ -0,0 +1,93 @@
+package csv
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// ReadPullRequestsFromCSV reads PRs back from a file written by WritePullRequestsToCSV. Columns are
+// matched by header name, so files edited in a spreadsheet still load; calculated columns (lead, coding,
+// pickup, review and merge time) are ignored and recomputed by the analysis. The review columns are ignored
+// too: they summarize the reviews without their timestamps, so reviews cannot be restored from them.
+func ReadPullRequestsFromCSV(filename string) ([]github.PullRequest, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer file.Close()
+
+	reader, err := newReader(file)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV file: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s is empty", filename)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"Number", "CreatedAt", "State"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%s has no %q column; expected a visuche --csv export", filename, required)
+		}
+	}
+
+	prs := make([]github.PullRequest, 0, len(rows)-1)
+	for line, row := range rows[1:] {
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		var pr github.PullRequest
+		if pr.Number, err = strconv.Atoi(get("Number")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid PR number %q", line+2, get("Number"))
+		}
+		if pr.CreatedAt, err = parseTime(get("CreatedAt")); err != nil || pr.CreatedAt.IsZero() {
+			return nil, fmt.Errorf("line %d: invalid CreatedAt %q", line+2, get("CreatedAt"))
+		}
+		pr.MergedAt, _ = parseTime(get("MergedAt"))
+		pr.ClosedAt, _ = parseTime(get("ClosedAt"))
+		pr.Title = get("Title")
+		pr.URL = get("URL")
+		pr.Author.Login = get("Author")
+		pr.MergedBy.Login = get("MergedBy")
+		pr.State = strings.ToUpper(get("State"))
+		pr.BaseRefName = get("BaseRef")
+		pr.HeadRefName = get("HeadRef")
+		pr.IsDraft = get("IsDraft") == "true"
+		pr.Additions, _ = strconv.Atoi(get("Additions"))
+		pr.Deletions, _ = strconv.Atoi(get("Deletions"))
+		pr.ChangedFiles, _ = strconv.Atoi(get("ChangedFiles"))
+		for _, name := range strings.Split(get("Labels"), ListSeparator) {
+			if name = strings.TrimSpace(name); name != "" {
+				pr.Labels = append(pr.Labels, github.Label{Name: name})
+			}
+		}
+		prs = append(prs, pr)
+	}
+	return prs, nil
+}
+
+// parseTime parses an RFC3339 timestamp, treating an empty value and Go's zero time as unset.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil || t.Year() <= 1 {
+		return time.Time{}, err
+	}
+	return t, nil
+}
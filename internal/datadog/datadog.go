@@ -0,0 +1,115 @@
+package datadog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/metrics"
+)
+
+const defaultSite = "datadoghq.com"
+
+// gaugeType is the v2 series intake value for gauge metrics.
+const gaugeType = 3
+
+// Client submits metrics to the Datadog metrics API.
+type Client struct {
+	Site   string // Datadog site, e.g. datadoghq.com or datadoghq.eu, or a full URL for a proxy
+	APIKey string
+	Tags   []string // Added to every series
+	HTTP   *http.Client
+}
+
+// NewClient returns a client for site (default datadoghq.com).
+func NewClient(site, apiKey string, tags []string) (*Client, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("datadog API key is not set (DD_API_KEY or datadog.apiKey in the config)")
+	}
+	if site == "" {
+		site = defaultSite
+	}
+	return &Client{
+		Site:   strings.TrimSuffix(site, "/"),
+		APIKey: apiKey,
+		Tags:   tags,
+		HTTP:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+type series struct {
+	Metric string   `json:"metric"`
+	Type   int      `json:"type"`
+	Points []point  `json:"points"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+type point struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// Submit sends gauges as one series each, timestamped now. Gauge labels become key:value tags.
+func (c *Client) Submit(gauges []metrics.Gauge) error {
+	now := time.Now().Unix()
+	payload := struct {
+		Series []series `json:"series"`
+	}{}
+	for _, g := range gauges {
+		payload.Series = append(payload.Series, series{
+			Metric: g.Name,
+			Type:   gaugeType,
+			Points: []point{{Timestamp: now, Value: g.Value}},
+			Tags:   c.tags(g.Labels),
+		})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseURL()+"/api/v2/series", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("DD-API-KEY", c.APIKey)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("datadog API returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// baseURL returns the API host for the site; a site given as a URL is used as is.
+func (c *Client) baseURL() string {
+	if strings.HasPrefix(c.Site, "http://") || strings.HasPrefix(c.Site, "https://") {
+		return c.Site
+	}
+	return "https://api." + c.Site
+}
+
+// tags merges the configured tags with labels, sorted for stable series identity.
+func (c *Client) tags(labels map[string]string) []string {
+	tags := append([]string(nil), c.Tags...)
+	for k, v := range labels {
+		tags = append(tags, k+":"+v)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// ParseTags splits a DD_TAGS style list, separated by commas or spaces.
+func ParseTags(raw string) []string {
+	return strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' })
+}
@@ -0,0 +1,576 @@
+// Package demo generates a synthetic but realistic PR and workflow run dataset in the mock
+// provider's fixture format, so the full report can be shown without a token or a real repository.
+package demo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/provider"
+)
+
+// Repo is the repository the demo dataset pretends to come from.
+const Repo = "acme/webshop"
+
+// Teams groups the demo developers, for --group-by team.
+var Teams = map[string][]string{
+	"platform": {"alice", "bob", "carol"},
+	"product":  {"dave", "erin", "frank", "grace"},
+}
+
+// Options controls the generated dataset. The same options always produce the same dataset.
+type Options struct {
+	Seed int64
+	End  time.Time // Last day of the dataset; nothing happens after it
+	Days int       // Length of the period in days
+}
+
+// Summary describes a generated dataset.
+type Summary struct {
+	PullRequests int
+	WorkflowRuns int
+}
+
+type developer struct {
+	Login  string
+	Team   string
+	Weight int     // Relative share of the PRs
+	Pace   float64 // Multiplier on coding and response times
+}
+
+// developers is a slice rather than derived from Teams so generation never depends on map order.
+var developers = []developer{
+	{"alice", "platform", 5, 0.8},
+	{"bob", "platform", 3, 1.0},
+	{"carol", "platform", 2, 1.2},
+	{"dave", "product", 4, 0.9},
+	{"erin", "product", 3, 1.0},
+	{"frank", "product", 2, 1.3},
+	{"grace", "product", 1, 1.8},
+}
+
+type component struct {
+	Dir    string
+	Team   string
+	Files  []string
+	Topics []string
+}
+
+var components = []component{
+	{"api/handlers", "platform", []string{"orders.go", "users.go", "auth.go", "cart.go", "middleware.go"},
+		[]string{"order export", "user search", "session refresh", "rate limiting", "request logging"}},
+	{"internal/billing", "platform", []string{"invoice.go", "tax.go", "stripe.go", "refund.go"},
+		[]string{"invoice rounding", "tax rules for EU", "refund webhooks", "payment retries"}},
+	{"web/src/components", "product", []string{"Cart.tsx", "Checkout.tsx", "Header.tsx", "ProductCard.tsx", "Search.tsx"},
+		[]string{"cart badge", "checkout validation", "product card layout", "search suggestions", "dark mode"}},
+	{"web/src/pages", "product", []string{"Home.tsx", "Product.tsx", "Account.tsx", "Orders.tsx"},
+		[]string{"order history page", "account settings", "landing page hero", "wishlist"}},
+}
+
+var docsComponent = component{"docs", "", []string{"README.md", "api.md", "deployment.md", "contributing.md"},
+	[]string{"API reference", "deployment guide", "local setup steps", "contribution guidelines"}}
+
+// kind is the type of change a PR makes; it drives the label, title verb and branch prefix.
+type kind struct {
+	Label  string
+	Verbs  []string
+	Branch string
+	Weight int
+}
+
+var kinds = []kind{
+	{"feature", []string{"Add", "Support", "Introduce"}, "feature", 45},
+	{"bug", []string{"Fix", "Handle", "Correct"}, "fix", 30},
+	{"chore", []string{"Refactor", "Clean up", "Update"}, "chore", 15},
+	{"docs", []string{"Document", "Clarify", "Expand"}, "docs", 10},
+}
+
+var dependencies = []string{"golang.org/x/net", "github.com/stretchr/testify", "react", "typescript", "eslint", "github.com/aws/aws-sdk-go-v2"}
+
+// Fixture records mirror the gh CLI JSON the mock provider reads.
+type author struct {
+	Login string `json:"login"`
+}
+
+type review struct {
+	Author      author    `json:"author"`
+	SubmittedAt time.Time `json:"submittedAt"`
+	State       string    `json:"state"`
+}
+
+type pullRequest struct {
+	Number       int             `json:"number"`
+	Title        string          `json:"title"`
+	URL          string          `json:"url"`
+	Body         string          `json:"body"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	MergedAt     *time.Time      `json:"mergedAt"`
+	ClosedAt     *time.Time      `json:"closedAt"`
+	Author       author          `json:"author"`
+	MergedBy     *author         `json:"mergedBy"`
+	Additions    int             `json:"additions"`
+	Deletions    int             `json:"deletions"`
+	ChangedFiles int             `json:"changedFiles"`
+	IsDraft      bool            `json:"isDraft"`
+	State        string          `json:"state"`
+	BaseRefName  string          `json:"baseRefName"`
+	HeadRefName  string          `json:"headRefName"`
+	Reviews      []review        `json:"reviews"`
+	Commits      []github.Commit `json:"commits"`
+	Files        []github.PRFile `json:"files"`
+	Labels       []github.Label  `json:"labels"`
+	Comments     struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"comments"`
+	MergeCommit *struct {
+		Oid string `json:"oid"`
+	} `json:"mergeCommit"`
+}
+
+type linkedIssue struct {
+	PullRequest int       `json:"pullRequest"`
+	Repo        string    `json:"repo"`
+	Number      int       `json:"number"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type reviewRequest struct {
+	PullRequest int    `json:"pullRequest"`
+	Reviewer    string `json:"reviewer"`
+}
+
+type generator struct {
+	rng            *rand.Rand
+	end            time.Time // Exclusive: the start of the day after Options.End
+	number         int       // Last issue/PR number handed out
+	runID          int64
+	runNumbers     map[string]int
+	prs            []pullRequest
+	runs           []actions.WorkflowRun
+	linkedIssues   []linkedIssue
+	reviewRequests []reviewRequest
+}
+
+// Generate writes the dataset for opts to dir as pull_requests.json, workflow_runs.json,
+// linked_issues.json and review_requests.json.
+func Generate(dir string, opts Options) (Summary, error) {
+	if opts.Days < 1 {
+		return Summary{}, fmt.Errorf("the demo period must be at least one day")
+	}
+	lastDay := time.Date(opts.End.Year(), opts.End.Month(), opts.End.Day(), 0, 0, 0, 0, time.UTC)
+	g := &generator{
+		rng:        rand.New(rand.NewSource(opts.Seed)),
+		end:        lastDay.AddDate(0, 0, 1),
+		number:     1200,
+		runID:      7100000000,
+		runNumbers: make(map[string]int),
+	}
+
+	for day := lastDay.AddDate(0, 0, -(opts.Days - 1)); day.Before(g.end); day = day.AddDate(0, 0, 1) {
+		g.addRun("Nightly E2E", "schedule", "main", "Nightly E2E", day.Add(2*time.Hour), g.minutes(25, 40), 0.15)
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		if day.Weekday() == time.Monday {
+			g.addDependencyUpdate(day.Add(6 * time.Hour))
+		}
+
+		opened := make([]time.Time, g.rng.Intn(4))
+		for i := range opened {
+			opened[i] = day.Add(time.Duration(9*60+g.rng.Intn(9*60)) * time.Minute)
+		}
+		sort.Slice(opened, func(i, j int) bool { return opened[i].Before(opened[j]) })
+		for _, created := range opened {
+			g.addPullRequest(created)
+		}
+	}
+
+	// gh lists newest first
+	sort.SliceStable(g.prs, func(i, j int) bool { return g.prs[i].CreatedAt.After(g.prs[j].CreatedAt) })
+	sort.SliceStable(g.runs, func(i, j int) bool { return g.runs[i].CreatedAt.After(g.runs[j].CreatedAt) })
+
+	files := map[string]interface{}{
+		provider.PullRequestsFixture:   g.prs,
+		provider.WorkflowRunsFixture:   g.runs,
+		provider.LinkedIssuesFixture:   g.linkedIssues,
+		provider.ReviewRequestsFixture: g.reviewRequests,
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Summary{}, fmt.Errorf("failed to create demo dir: %w", err)
+	}
+	for name, records := range files {
+		data, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return Summary{}, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0o644); err != nil {
+			return Summary{}, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return Summary{PullRequests: len(g.prs), WorkflowRuns: len(g.runs)}, nil
+}
+
+// addPullRequest adds a human PR opened at created, with its reviews, commits and CI runs.
+func (g *generator) addPullRequest(created time.Time) {
+	dev := g.pickDeveloper()
+	k := g.pickKind()
+	comp := g.pickComponent(dev.Team)
+	if k.Label == "docs" {
+		comp = docsComponent
+	}
+	topic := comp.Topics[g.rng.Intn(len(comp.Topics))]
+
+	pr := pullRequest{
+		Number:      g.nextNumber(),
+		Title:       fmt.Sprintf("%s %s", k.Verbs[g.rng.Intn(len(k.Verbs))], topic),
+		CreatedAt:   created,
+		Author:      author{dev.Login},
+		State:       "OPEN",
+		BaseRefName: "main",
+		HeadRefName: fmt.Sprintf("%s/%s", k.Branch, strings.ReplaceAll(strings.ToLower(topic), " ", "-")),
+		Labels:      []github.Label{{Name: k.Label}},
+	}
+	pr.URL = prURL(pr.Number)
+	if k.Label == "bug" && g.chance(0.25) {
+		pr.HeadRefName = "hotfix/" + strings.TrimPrefix(pr.HeadRefName, k.Branch+"/")
+	}
+	g.addFiles(&pr, comp, k)
+	g.addBody(&pr, k)
+
+	// Coding starts hours to days before the PR is opened
+	coding := time.Duration(float64(g.hours(2, 30)) * dev.Pace)
+	pr.Commits = g.commits(created.Add(-coding), created, 1+g.rng.Intn(4))
+	if g.chance(0.12) {
+		pair := g.pickReviewer(dev)
+		pr.Commits[0].MessageBody = fmt.Sprintf("Co-authored-by: %s <%s@acme.example>", pair.Login, pair.Login)
+	}
+	g.addRun("CI", "pull_request", pr.HeadRefName, pr.Title, created.Add(time.Minute), g.ciDuration(pr), 0.12)
+
+	reviewers := []developer{g.pickReviewer(dev)}
+	if pr.Additions > 400 && g.chance(0.6) {
+		if second := g.pickReviewer(dev); second.Login != reviewers[0].Login {
+			reviewers = append(reviewers, second)
+		}
+	}
+	for _, r := range reviewers {
+		g.reviewRequests = append(g.reviewRequests, reviewRequest{pr.Number, r.Login})
+	}
+	if g.chance(0.2) {
+		g.reviewRequests = append(g.reviewRequests, reviewRequest{pr.Number, "team:" + dev.Team})
+	}
+
+	// Abandoned: closed without merging after a while, sometimes after a review
+	if g.chance(0.07) {
+		closed := created.Add(g.hours(24, 20*24))
+		if g.chance(0.5) {
+			pr.Reviews = append(pr.Reviews, review{author{reviewers[0].Login}, created.Add(g.hours(2, 24)), "COMMENTED"})
+		}
+		g.finish(&pr, closed, "CLOSED", nil)
+		return
+	}
+
+	// Review rounds: larger PRs get more change requests
+	t := created.Add(time.Duration(float64(g.expHours(5)+30*time.Minute) * dev.Pace))
+	changeRequestRate := math.Min(0.2+float64(pr.Additions)/2000, 0.7)
+	for round := 0; round < 3 && g.chance(changeRequestRate); round++ {
+		state := "CHANGES_REQUESTED"
+		if g.chance(0.4) {
+			state = "COMMENTED"
+		}
+		pr.Reviews = append(pr.Reviews, review{author{reviewers[0].Login}, t, state})
+		pr.Comments.TotalCount += 1 + g.rng.Intn(4)
+
+		fixed := t.Add(time.Duration(float64(g.hours(1, 20)) * dev.Pace))
+		pr.Commits = append(pr.Commits, g.commits(fixed, fixed.Add(time.Minute), 1)...)
+		g.addRun("CI", "pull_request", pr.HeadRefName, pr.Title, fixed.Add(time.Minute), g.ciDuration(pr), 0.12)
+		t = fixed.Add(g.expHours(4) + 20*time.Minute)
+	}
+	for _, r := range reviewers {
+		pr.Reviews = append(pr.Reviews, review{author{r.Login}, t, "APPROVED"})
+		t = t.Add(g.expHours(2))
+	}
+
+	merged := t.Add(g.expHours(3) + 5*time.Minute)
+	mergedBy := author{dev.Login}
+	if g.chance(0.2) {
+		mergedBy = author{reviewers[0].Login}
+	}
+	g.finish(&pr, merged, "MERGED", &mergedBy)
+	if pr.State == "MERGED" {
+		g.addRun("CI", "push", "main", pr.Title, merged.Add(30*time.Second), g.ciDuration(pr), 0.05)
+		g.addRun("Deploy", "push", "main", pr.Title, merged.Add(time.Minute), g.minutes(3, 6), 0.04)
+	}
+}
+
+// addDependencyUpdate adds a weekly Dependabot PR, usually merged within a day or two.
+func (g *generator) addDependencyUpdate(created time.Time) {
+	dep := dependencies[g.rng.Intn(len(dependencies))]
+	minor := 1 + g.rng.Intn(20)
+	pr := pullRequest{
+		Number:       g.nextNumber(),
+		Title:        fmt.Sprintf("Bump %s from 1.%d.0 to 1.%d.1", dep, minor, minor),
+		Body:         "Bumps " + dep + ".\n\nDependabot will resolve any conflicts with this PR as long as you don't alter it yourself.",
+		CreatedAt:    created,
+		Author:       author{"app/dependabot"},
+		State:        "OPEN",
+		BaseRefName:  "main",
+		HeadRefName:  fmt.Sprintf("dependabot/%s-1.%d.1", strings.ReplaceAll(dep, "/", "-"), minor),
+		Labels:       []github.Label{{Name: "dependencies"}},
+		Additions:    2 + g.rng.Intn(10),
+		Deletions:    2 + g.rng.Intn(10),
+		ChangedFiles: 2,
+	}
+	pr.URL = prURL(pr.Number)
+	g.addRun("CI", "pull_request", pr.HeadRefName, pr.Title, created.Add(time.Minute), g.minutes(5, 10), 0.1)
+	if g.chance(0.2) {
+		// Stuck open, waiting for someone to look at a failing upgrade
+		g.prs = append(g.prs, pr)
+		return
+	}
+	reviewer := developers[g.rng.Intn(3)] // Platform owns dependency updates
+	approved := created.Add(g.hours(1, 48))
+	pr.Reviews = []review{{author{reviewer.Login}, approved, "APPROVED"}}
+	g.finish(&pr, approved.Add(g.expHours(1)), "MERGED", &author{reviewer.Login})
+}
+
+// finish closes pr at closed with state, unless that is after the end of the dataset; then the PR
+// stays open and events after the end are dropped.
+func (g *generator) finish(pr *pullRequest, closed time.Time, state string, mergedBy *author) {
+	if !closed.Before(g.end) {
+		var reviews []review
+		for _, r := range pr.Reviews {
+			if r.SubmittedAt.Before(g.end) {
+				reviews = append(reviews, r)
+			}
+		}
+		var commits []github.Commit
+		for _, c := range pr.Commits {
+			if c.CommittedDate.Before(g.end) {
+				commits = append(commits, c)
+			}
+		}
+		pr.Reviews, pr.Commits = reviews, commits
+		pr.IsDraft = len(reviews) == 0 && g.chance(0.3)
+		g.prs = append(g.prs, *pr)
+		return
+	}
+
+	pr.State = state
+	pr.ClosedAt = &closed
+	if state == "MERGED" {
+		pr.MergedAt = &closed
+		pr.MergedBy = mergedBy
+		pr.MergeCommit = &struct {
+			Oid string `json:"oid"`
+		}{fmt.Sprintf("%016x%016x%08x", g.rng.Uint64(), g.rng.Uint64(), g.rng.Uint32())}
+	}
+	g.prs = append(g.prs, *pr)
+}
+
+// addFiles picks the changed files and the size of the change.
+func (g *generator) addFiles(pr *pullRequest, comp component, k kind) {
+	var additions int
+	switch r := g.rng.Float64(); {
+	case k.Label == "docs" || r < 0.5:
+		additions = 5 + g.rng.Intn(80)
+	case r < 0.85:
+		additions = 80 + g.rng.Intn(320)
+	default:
+		additions = 400 + g.rng.Intn(1100)
+	}
+
+	count := 1 + g.rng.Intn(len(comp.Files))
+	if additions < 40 {
+		count = 1
+	}
+	for _, i := range g.rng.Perm(len(comp.Files))[:count] {
+		pr.Files = append(pr.Files, github.PRFile{Path: comp.Dir + "/" + comp.Files[i]})
+	}
+	if k.Label == "feature" && g.chance(0.5) {
+		// Features usually come with tests next to the code
+		pr.Files = append(pr.Files, github.PRFile{Path: comp.Dir + "/" + strings.TrimSuffix(comp.Files[0], filepath.Ext(comp.Files[0])) + "_test" + filepath.Ext(comp.Files[0])})
+	}
+
+	remaining := additions
+	for i := range pr.Files {
+		share := remaining
+		if i < len(pr.Files)-1 {
+			share = remaining / 2
+		}
+		pr.Files[i].Additions = share
+		pr.Files[i].Deletions = share * g.rng.Intn(60) / 100
+		remaining -= share
+		pr.Additions += pr.Files[i].Additions
+		pr.Deletions += pr.Files[i].Deletions
+	}
+	pr.ChangedFiles = len(pr.Files)
+}
+
+// addBody writes a PR description; most link an issue and some carry a checklist.
+func (g *generator) addBody(pr *pullRequest, k kind) {
+	var body strings.Builder
+	if !g.chance(0.15) {
+		fmt.Fprintf(&body, "## Summary\n\nThis PR %s.\n", strings.ToLower(pr.Title[:1])+pr.Title[1:])
+	}
+	if k.Label != "docs" && g.chance(0.6) {
+		issue := g.nextNumber()
+		fmt.Fprintf(&body, "\nFixes #%d\n", issue)
+		g.linkedIssues = append(g.linkedIssues, linkedIssue{pr.Number, Repo, issue, pr.CreatedAt.Add(-g.hours(24, 20*24))})
+	}
+	if g.chance(0.5) {
+		body.WriteString("\n## Checklist\n")
+		for _, item := range []string{"Tests added", "Docs updated", "Tested locally"} {
+			mark := " "
+			if g.chance(0.75) {
+				mark = "x"
+			}
+			fmt.Fprintf(&body, "- [%s] %s\n", mark, item)
+		}
+	}
+	pr.Body = body.String()
+}
+
+// commits returns n commits authored between from and to, oldest first.
+func (g *generator) commits(from, to time.Time, n int) []github.Commit {
+	messages := []string{"WIP", "Address review comments", "Add tests", "Fix lint", "Handle edge case", "Tidy up"}
+	commits := make([]github.Commit, n)
+	step := to.Sub(from) / time.Duration(n)
+	for i := range commits {
+		at := from.Add(step * time.Duration(i))
+		commits[i] = github.Commit{AuthoredDate: at, CommittedDate: at, MessageHeadline: messages[g.rng.Intn(len(messages))]}
+	}
+	return commits
+}
+
+// addRun records a completed workflow run unless it would finish after the end of the dataset.
+// Failed PR and push runs are re-run once, as people do with flaky jobs.
+func (g *generator) addRun(workflow, event, branch, title string, created time.Time, duration time.Duration, failureRate float64) {
+	for attempt := 1; attempt <= 2; attempt++ {
+		started := created.Add(time.Duration(5+g.rng.Intn(30)) * time.Second)
+		updated := started.Add(duration)
+		if !updated.Before(g.end) {
+			return
+		}
+		conclusion := "success"
+		if g.chance(failureRate) {
+			conclusion = "failure"
+		}
+
+		g.runID++
+		if attempt == 1 {
+			g.runNumbers[workflow]++
+		}
+		g.runs = append(g.runs, actions.WorkflowRun{
+			Attempt:      attempt,
+			Conclusion:   conclusion,
+			CreatedAt:    created,
+			DatabaseId:   g.runID,
+			DisplayTitle: title,
+			Event:        event,
+			HeadBranch:   branch,
+			Name:         workflow,
+			Number:       g.runNumbers[workflow],
+			StartedAt:    started,
+			Status:       "completed",
+			UpdatedAt:    updated,
+			WorkflowName: workflow,
+			URL:          fmt.Sprintf("https://github.com/%s/actions/runs/%d", Repo, g.runID),
+		})
+		if conclusion == "success" || event == "schedule" {
+			return
+		}
+		created = updated.Add(g.hours(0, 2))
+	}
+}
+
+// ciDuration is the CI time for pr; big changes run more tests.
+func (g *generator) ciDuration(pr pullRequest) time.Duration {
+	return time.Duration(float64(g.minutes(5, 12)) * (1 + float64(pr.Additions)/3000))
+}
+
+func (g *generator) pickDeveloper() developer {
+	total := 0
+	for _, d := range developers {
+		total += d.Weight
+	}
+	n := g.rng.Intn(total)
+	for _, d := range developers {
+		if n < d.Weight {
+			return d
+		}
+		n -= d.Weight
+	}
+	return developers[0]
+}
+
+// pickReviewer picks someone other than dev, usually from the same team.
+func (g *generator) pickReviewer(dev developer) developer {
+	sameTeam := g.chance(0.75)
+	var candidates []developer
+	for _, d := range developers {
+		if d.Login != dev.Login && (d.Team == dev.Team) == sameTeam {
+			candidates = append(candidates, d)
+		}
+	}
+	return candidates[g.rng.Intn(len(candidates))]
+}
+
+func (g *generator) pickKind() kind {
+	n := g.rng.Intn(100)
+	for _, k := range kinds {
+		if n < k.Weight {
+			return k
+		}
+		n -= k.Weight
+	}
+	return kinds[0]
+}
+
+// pickComponent picks a component, mostly one owned by team.
+func (g *generator) pickComponent(team string) component {
+	owned := g.chance(0.8)
+	var candidates []component
+	for _, c := range components {
+		if (c.Team == team) == owned {
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates[g.rng.Intn(len(candidates))]
+}
+
+// prURL returns the web URL of PR number in the demo repository.
+func prURL(number int) string {
+	return fmt.Sprintf("https://github.com/%s/pull/%d", Repo, number)
+}
+
+func (g *generator) nextNumber() int {
+	g.number++
+	return g.number
+}
+
+func (g *generator) chance(p float64) bool {
+	return g.rng.Float64() < p
+}
+
+// hours returns a uniformly random duration between min and max hours.
+func (g *generator) hours(min, max int) time.Duration {
+	return time.Duration(min)*time.Hour + time.Duration(g.rng.Int63n(int64(max-min)*int64(time.Hour)+1))
+}
+
+// expHours returns an exponentially distributed duration with the given mean in hours, so most
+// waits are short with a long tail.
+func (g *generator) expHours(mean float64) time.Duration {
+	return time.Duration(g.rng.ExpFloat64() * mean * float64(time.Hour))
+}
+
+func (g *generator) minutes(min, max int) time.Duration {
+	return time.Duration(min)*time.Minute + time.Duration(g.rng.Int63n(int64(max-min)*int64(time.Minute)+1))
+}
@@ -0,0 +1,126 @@
+package diagnose
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// Kind is a class of failure that has a known remedy.
+type Kind int
+
+const (
+	Unknown Kind = iota
+	NotInstalled
+	NotAuthenticated
+	RepoNotFound
+	SSORequired
+	RateLimited
+)
+
+// Exit codes per Kind. 1 stays the generic failure code; 2 is left to usage errors.
+var exitCodes = map[Kind]int{
+	Unknown:          1,
+	NotInstalled:     3,
+	NotAuthenticated: 4,
+	RepoNotFound:     5,
+	SSORequired:      6,
+	RateLimited:      7,
+}
+
+// Diagnosis describes a failed gh/glab call in terms the user can act on.
+type Diagnosis struct {
+	Kind    Kind
+	Tool    string   // "gh" or "glab"
+	Summary string   // One line describing what went wrong
+	Hints   []string // Remediation steps, in the order to try them
+	Detail  string   // The original error text
+}
+
+// ExitCode returns the process exit code for the diagnosis.
+func (d Diagnosis) ExitCode() int {
+	return exitCodes[d.Kind]
+}
+
+var ssoURLPattern = regexp.MustCompile(`https://github\.com/orgs/[^\s]+/sso[^\s]*`)
+
+// Classify matches err against the failure modes of the gh and glab CLIs. Errors it does not
+// recognize are returned with Kind Unknown and no hints.
+func Classify(err error) Diagnosis {
+	if err == nil {
+		return Diagnosis{}
+	}
+	detail := err.Error()
+	lower := strings.ToLower(detail)
+	tool := "gh"
+	if strings.Contains(lower, "glab") {
+		tool = "glab"
+	}
+	d := Diagnosis{Tool: tool, Detail: detail}
+
+	switch {
+	case errors.Is(err, exec.ErrNotFound) || strings.Contains(lower, "executable file not found"):
+		d.Kind = NotInstalled
+		d.Summary = fmt.Sprintf("the %s CLI is not installed or not on PATH", tool)
+		if tool == "glab" {
+			d.Hints = []string{"Install glab: https://gitlab.com/gitlab-org/cli#installation", "Then run: glab auth login"}
+		} else {
+			d.Hints = []string{"Install gh: https://cli.github.com", "Then run: gh auth login"}
+		}
+	case strings.Contains(lower, "saml"):
+		d.Kind = SSORequired
+		d.Summary = "the organization enforces SAML single sign-on and your token is not authorized for it"
+		if url := ssoURLPattern.FindString(detail); url != "" {
+			d.Hints = append(d.Hints, "Authorize your token for the organization: "+url)
+		}
+		d.Hints = append(d.Hints,
+			"Or re-authenticate and approve SSO in the browser: gh auth refresh -h github.com",
+			"Personal access tokens are authorized under https://github.com/settings/tokens (Configure SSO)")
+	case strings.Contains(lower, "rate limit") || strings.Contains(lower, "http 429"):
+		d.Kind = RateLimited
+		d.Summary = fmt.Sprintf("the %s API rate limit was exceeded", hostName(tool))
+		if tool == "glab" {
+			d.Hints = []string{"Wait a few minutes and retry", "Narrow the period with --since/--until to make fewer requests"}
+		} else {
+			d.Hints = []string{
+				"Check when the limit resets: gh api rate_limit",
+				"Narrow the period with --since/--until to make fewer requests",
+				"Unauthenticated or GITHUB_TOKEN-based sessions have lower limits; run gh auth login with your own account",
+			}
+		}
+	case strings.Contains(lower, "gh auth login") || strings.Contains(lower, "glab auth login") ||
+		strings.Contains(lower, "not logged in") || strings.Contains(lower, "not logged into") ||
+		strings.Contains(lower, "bad credentials") || strings.Contains(lower, "http 401") ||
+		strings.Contains(lower, "401 unauthorized"):
+		d.Kind = NotAuthenticated
+		d.Summary = fmt.Sprintf("%s is not authenticated or the token has expired", tool)
+		d.Hints = []string{
+			fmt.Sprintf("Run: %s auth login", tool),
+			fmt.Sprintf("Check the current session: %s auth status", tool),
+		}
+		if tool == "gh" {
+			d.Hints = append(d.Hints, "If GH_TOKEN or GITHUB_TOKEN is set, it takes precedence over the stored login")
+		}
+	case strings.Contains(lower, "could not resolve to a repository") || strings.Contains(lower, "http 404") ||
+		strings.Contains(lower, "404 not found") || strings.Contains(lower, "project not found"):
+		d.Kind = RepoNotFound
+		d.Summary = "the repository was not found or your account cannot see it"
+		d.Hints = []string{
+			"Check the owner/repo spelling passed to --repo",
+			fmt.Sprintf("Private repositories need a token with access: %s auth status", tool),
+		}
+	default:
+		d.Kind = Unknown
+	}
+	return d
+}
+
+// hostName returns the service behind tool.
+func hostName(tool string) string {
+	if tool == "glab" {
+		return "GitLab"
+	}
+	return "GitHub"
+}
@@ -3,25 +3,131 @@ package git
 import (
 	"fmt"
 	"os/exec"
-	"strings"
 	"regexp"
+	"sort"
+	"strings"
 )
 
+var (
+	githubRemotePattern = regexp.MustCompile(`(?:github\.com[/:])((?:[^/]+)/(?:[^/]+?))(?:\.git)?$`)
+	gitlabRemotePattern = regexp.MustCompile(`(?:gitlab\.[^/:]+[/:])(.+?)(?:\.git)?$`)
+)
+
+// Remote is a git remote pointing at a recognised repository host.
+type Remote struct {
+	Name string
+	URL  string
+	Repo string // owner/name (or group/subgroup/project for GitLab)
+}
+
 // GetRepoFromGitRemote gets the repository owner/name from the git remote URL.
-func GetRepoFromGitRemote() (string, error) {
-	cmd := exec.Command("git", "remote", "get-url", "origin")
+// An empty remote picks the preferred remote (upstream for forks, then origin).
+func GetRepoFromGitRemote(remote string) (string, error) {
+	return getPathFromGitRemote(githubRemotePattern, remote)
+}
+
+// GetGitLabProjectFromGitRemote gets the GitLab project path (including subgroups) from the git remote URL.
+// An empty remote picks the preferred remote (upstream for forks, then origin).
+func GetGitLabProjectFromGitRemote(remote string) (string, error) {
+	return getPathFromGitRemote(gitlabRemotePattern, remote)
+}
+
+// ListGitHubRemotes returns all remotes pointing at GitHub, preferred remote first.
+func ListGitHubRemotes() ([]Remote, error) {
+	return listRemotes(githubRemotePattern)
+}
+
+// ListGitLabRemotes returns all remotes pointing at GitLab, preferred remote first.
+func ListGitLabRemotes() ([]Remote, error) {
+	return listRemotes(gitlabRemotePattern)
+}
+
+// FindGitHubRemote returns the named GitHub remote, or the preferred one (upstream for forks, then
+// origin) when remote is empty.
+func FindGitHubRemote(remote string) (Remote, error) {
+	return findRemote(githubRemotePattern, remote)
+}
+
+// getPathFromGitRemote extracts the repository path of the named (or preferred) remote with re.
+func getPathFromGitRemote(re *regexp.Regexp, remote string) (string, error) {
+	r, err := findRemote(re, remote)
+	if err != nil {
+		return "", err
+	}
+	return r.Repo, nil
+}
+
+// findRemote returns the named (or preferred) remote whose URL matches re.
+func findRemote(re *regexp.Regexp, remote string) (Remote, error) {
+	remotes, err := listRemotes(re)
+	if err != nil {
+		return Remote{}, err
+	}
+	if len(remotes) == 0 {
+		return Remote{}, fmt.Errorf("no supported git remote found")
+	}
+	if remote == "" {
+		return remotes[0], nil
+	}
+	for _, r := range remotes {
+		if r.Name == remote {
+			return r, nil
+		}
+	}
+	return Remote{}, fmt.Errorf("could not parse repository name from remote: %s", remote)
+}
+
+// listRemotes parses `git remote -v` and keeps remotes whose fetch URL matches re.
+func listRemotes(re *regexp.Regexp) ([]Remote, error) {
+	cmd := exec.Command("git", "remote", "-v")
 	out, err := cmd.Output()
 	if err != nil {
-		return "", fmt.Errorf("could not get git remote URL: %w", err)
+		return nil, fmt.Errorf("could not list git remotes: %w", err)
 	}
 
-	url := strings.TrimSpace(string(out))
-	re := regexp.MustCompile(`(?:github\.com[/:])((?:[^/]+)/(?:[^/]+))(?:\.git)?$`)
-	matches := re.FindStringSubmatch(url)
+	var remotes []Remote
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[2] != "(fetch)" {
+			continue
+		}
+		matches := re.FindStringSubmatch(fields[1])
+		if len(matches) < 2 {
+			continue
+		}
+		remotes = append(remotes, Remote{Name: fields[0], URL: fields[1], Repo: matches[1]})
+	}
+
+	// Forks usually track the canonical repository as "upstream", which is what teams want analyzed.
+	sort.SliceStable(remotes, func(i, j int) bool {
+		return remotePriority(remotes[i].Name) < remotePriority(remotes[j].Name)
+	})
+	return remotes, nil
+}
 
-	if len(matches) < 2 {
-		return "", fmt.Errorf("could not parse repository name from URL: %s", url)
+// remotePriority orders remotes: upstream, origin, then everything else.
+func remotePriority(name string) int {
+	switch name {
+	case "upstream":
+		return 0
+	case "origin":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// GetDefaultBranch returns the default branch recorded for the remote (refs/remotes/<remote>/HEAD).
+// An empty remote means origin.
+func GetDefaultBranch(remote string) (string, error) {
+	if remote == "" {
+		remote = "origin"
+	}
+	cmd := exec.Command("git", "symbolic-ref", "--short", fmt.Sprintf("refs/remotes/%s/HEAD", remote))
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("could not determine default branch: %w", err)
 	}
 
-	return matches[1], nil
+	return strings.TrimPrefix(strings.TrimSpace(string(out)), remote+"/"), nil
 }
@@ -0,0 +1,75 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"visuche/internal/transport"
+)
+
+// prBatchSize is the number of PRs looked up per GraphQL query; larger batches make the query too complex.
+const prBatchSize = 30
+
+// queryPRBatches looks up the PRs with the given numbers in batched GraphQL queries, selecting fields on
+// each PR, and passes the repository object of every batch (a map of aliased PR nodes) to decode.
+// A batch that fails is skipped so the others still fill in their PRs; what names the looked-up data
+// in the warning listing how many PRs were left without it.
+func queryPRBatches(repo string, numbers []int, what, fields string, decode func(repository json.RawMessage) error) {
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 {
+		return
+	}
+	owner, repoName := parts[0], parts[1]
+
+	failed := 0
+	for start := 0; start < len(numbers); start += prBatchSize {
+		end := start + prBatchSize
+		if end > len(numbers) {
+			end = len(numbers)
+		}
+
+		var prQueries []string
+		for i, number := range numbers[start:end] {
+			prQueries = append(prQueries, fmt.Sprintf(`
+		pr%d: pullRequest(number: %d) {
+			number
+			%s
+		}`, i, number, fields))
+		}
+		query := fmt.Sprintf(`{
+		repository(owner: "%s", name: "%s") {
+			%s
+		}
+	}`, owner, repoName, strings.Join(prQueries, "\n"))
+
+		cmd := transport.Command("gh", "api", "graphql", "-f", fmt.Sprintf("query=%s", query))
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			fmt.Printf("❌ GraphQL query failed: %s\n", stderr.String())
+			failed += end - start
+			continue
+		}
+
+		var response struct {
+			Data struct {
+				Repository json.RawMessage `json:"repository"`
+			} `json:"data"`
+		}
+		err := json.Unmarshal(stdout.Bytes(), &response)
+		if err == nil {
+			err = decode(response.Data.Repository)
+		}
+		if err != nil {
+			fmt.Printf("❌ Failed to parse GraphQL response: %v\n", err)
+			failed += end - start
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("⚠️  %d of %d PRs are missing %s: their lookups failed\n", failed, len(numbers), what)
+	}
+}
@@ -2,20 +2,25 @@ package github
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"os/exec"
-	"sort"
+	"io"
+	"regexp"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 	"visuche/internal/animation"
+	"visuche/internal/progress"
+	"visuche/internal/transport"
 )
 
 // PullRequest represents a GitHub Pull Request.
 type PullRequest struct {
 	Number    int           `json:"number"`
 	Title     string        `json:"title"`
+	URL       string        `json:"url"`
+	Body      string        `json:"body"`
 	CreatedAt time.Time     `json:"createdAt"`
 	MergedAt  time.Time     `json:"mergedAt"`
 	ClosedAt  time.Time     `json:"closedAt"`
@@ -23,13 +28,12 @@ type PullRequest struct {
 	LeadTime  time.Duration // Calculated field
 
 	// Additional fields from gh pr list --json
-	Additions    int `json:"additions"`
-	Deletions    int `json:"deletions"`
-	ChangedFiles int `json:"changedFiles"`
-	Commits      []struct {
-		CommittedDate time.Time `json:"committedDate"`
-	} `json:"commits"`
-	Author struct {
+	Additions    int      `json:"additions"`
+	Deletions    int      `json:"deletions"`
+	ChangedFiles int      `json:"changedFiles"`
+	Commits      []Commit `json:"commits"`
+	Files        []PRFile `json:"files"`
+	Author       struct {
 		Login string `json:"login"`
 	} `json:"author"`
 	Reviews []struct {
@@ -54,7 +58,10 @@ type PullRequest struct {
 	MergedBy         struct {
 		Login string `json:"login"`
 	} `json:"mergedBy"`
-	HeadRefName string `json:"headRefName"`
+	HeadRefName       string          `json:"headRefName"`
+	IsCrossRepository bool            `json:"isCrossRepository"` // Opened from a fork
+	ReviewRequests    []ReviewRequest `json:"reviewRequests"`    // Pending review requests (users or teams)
+	Labels            []Label         `json:"labels"`
 
 	// Comment timing metrics (calculated fields)
 	FirstCommentTime      time.Time     `json:"-"` // Time of first comment
@@ -70,169 +77,495 @@ type PullRequest struct {
 	// Lifecycle metrics
 	IsReopened      bool      `json:"-"`
 	FirstReopenedAt time.Time `json:"-"`
+
+	// AutoMergeEnabled is true when GitHub auto-merge was enabled on the PR before it merged
+	AutoMergeEnabled bool `json:"-"`
+
+	// ReadyForReviewAt is the last time the PR left draft (only fetched with --exclude-draft-time)
+	ReadyForReviewAt time.Time `json:"-"`
+
+	// Issues this PR closes, with their creation time (populated by FetchLinkedIssues)
+	LinkedIssues []LinkedIssue `json:"-"`
+
+	// Everyone ever requested to review, as logins or "team:<slug>" (populated by FetchRequestedReviewers)
+	RequestedReviewers []string `json:"-"`
+
+	// Commit the PR landed as on the base branch (populated by FetchMergeCommits)
+	MergeMethod     string `json:"-"` // MergeMethodMerge, MergeMethodSquash or MergeMethodRebase; "" when unknown
+	LandedAdditions int    `json:"-"`
+	LandedDeletions int    `json:"-"`
+
+	// Review bodies and inline review comments (populated by FetchReviewComments)
+	ReviewComments []ReviewComment `json:"-"`
+
+	// First comment or review by a maintainer on a PR from a fork (populated by FetchMaintainerResponses)
+	FirstMaintainerResponseAt time.Time `json:"-"`
+	FirstMaintainerResponder  string    `json:"-"`
+
+	// Cycle-time stages (calculated fields)
+	FirstCommitAt time.Time     `json:"-"` // Author date of the first commit on the PR
+	CodingTime    time.Duration `json:"-"` // First commit → PR open
+	PickupTime    time.Duration `json:"-"` // PR open → first review
+	ReviewTime    time.Duration `json:"-"` // First review → final approval
+	MergeTime     time.Duration `json:"-"` // Final approval → merge
 }
 
-// FetchPullRequests fetches pull requests from GitHub using gh pr list command with time-based parallel fetching.
-func FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]PullRequest, error) {
-	// If no date range is specified, use a simple single request
-	if since == "" && until == "" {
-		return fetchPRsSingle(repo, since, until, author, label, includeOpen)
+// Label is a PR label.
+type Label struct {
+	Name string `json:"name"`
+}
+
+// HasLabel reports whether the PR carries the label name (case-insensitive, like GitHub's label filter).
+func (pr PullRequest) HasLabel(name string) bool {
+	for _, l := range pr.Labels {
+		if strings.EqualFold(l.Name, name) {
+			return true
+		}
 	}
+	return false
+}
 
-	// For date ranges, try to split into smaller chunks for parallel processing
-	return fetchPRsWithDateSplit(repo, since, until, author, label, includeOpen)
+// LabelNames returns the names of the PR's labels.
+func (pr PullRequest) LabelNames() []string {
+	names := make([]string, 0, len(pr.Labels))
+	for _, l := range pr.Labels {
+		names = append(names, l.Name)
+	}
+	return names
 }
 
-// fetchPRsSingle fetches PRs with a single request (for no date filtering)
-func fetchPRsSingle(repo string, since, until, author, label string, includeOpen bool) ([]PullRequest, error) {
-	args := buildBaseArgs(repo, since, until, author, label, includeOpen)
-	args = append(args, "--limit", "1000") // Maximum limit
+// ReviewerLogins returns the distinct logins that reviewed the PR in review order, excluding the author.
+func (pr PullRequest) ReviewerLogins() []string {
+	seen := make(map[string]bool)
+	logins := []string{}
+	for _, review := range pr.Reviews {
+		login := review.Author.Login
+		if login == "" || login == pr.Author.Login || seen[login] {
+			continue
+		}
+		seen[login] = true
+		logins = append(logins, login)
+	}
+	return logins
+}
 
-	// Start shiba animation (simple emoji version)
-	spinner := animation.NewShibaSpinner("Fetching PRs...", false)
-	spinner.Start()
-	defer spinner.Stop()
+// IsAbandoned reports whether the PR was closed without being merged.
+func (pr PullRequest) IsAbandoned() bool {
+	return !pr.Merged && pr.State == "CLOSED"
+}
 
-	var prs []PullRequest
-	var lastErr error
+// ReviewableAt returns when the PR became reviewable: the ready-for-review time when known, otherwise creation.
+func (pr PullRequest) ReviewableAt() time.Time {
+	if pr.ReadyForReviewAt.After(pr.CreatedAt) {
+		return pr.ReadyForReviewAt
+	}
+	return pr.CreatedAt
+}
 
-	for attempt := 1; attempt <= 3; attempt++ {
-		var stdout, stderr bytes.Buffer
-		cmd := exec.Command("gh", args...)
-		cmd.Stdout = &stdout
-		cmd.Stderr = &stderr
-
-		if err := cmd.Run(); err != nil {
-			lastErr = fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
-			// Retry transient upstream issues like 504/timeout with small backoff
-			if attempt < 3 && (strings.Contains(stderr.String(), "504") || strings.Contains(strings.ToLower(stderr.String()), "timeout")) {
-				time.Sleep(time.Duration(attempt) * time.Second)
-				continue
-			}
-			return nil, lastErr
+// mergeBotLogins lists well-known merge bots that do not use the [bot] suffix.
+var mergeBotLogins = []string{"bors", "mergify", "kodiak", "homu", "k8s-ci-robot", "k8s-merge-robot"}
+
+// extraBotLogins lists configured bot accounts, such as review bots running under a regular user account.
+var extraBotLogins []string
+
+// SetBotLogins adds accounts that IsBotLogin treats as bots on top of the built-in rules.
+func SetBotLogins(logins []string) {
+	extraBotLogins = nil
+	for _, login := range logins {
+		extraBotLogins = append(extraBotLogins, strings.ToLower(login))
+	}
+}
+
+// IsBotLogin reports whether a login belongs to a bot or GitHub App account.
+func IsBotLogin(login string) bool {
+	login = strings.ToLower(login)
+	if strings.HasSuffix(login, "[bot]") || strings.HasPrefix(login, "app/") {
+		return true
+	}
+	for _, bot := range mergeBotLogins {
+		if login == bot {
+			return true
+		}
+	}
+	for _, bot := range extraBotLogins {
+		if login == bot {
+			return true
 		}
+	}
+	return false
+}
 
-		if err := json.Unmarshal(stdout.Bytes(), &prs); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+// DropBotReviews removes reviews submitted by bots (see IsBotLogin), so automated reviewers don't count
+// as a human first review or approval.
+func DropBotReviews(prs []PullRequest) []PullRequest {
+	for i := range prs {
+		reviews := prs[i].Reviews[:0]
+		for _, review := range prs[i].Reviews {
+			if !IsBotLogin(review.Author.Login) {
+				reviews = append(reviews, review)
+			}
 		}
-		return filterDependabotPRs(processPRs(prs)), nil
+		prs[i].Reviews = reviews
 	}
+	return prs
+}
 
-	// Fallback: should not reach here because we return on success or error above
-	if lastErr != nil {
-		return nil, lastErr
+// ReviewRequest is a pending review request for a user (Login) or a team (Slug).
+type ReviewRequest struct {
+	Login string `json:"login"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+}
+
+// Reviewer returns the requested user's login, or "team:<slug>" for team requests.
+func (r ReviewRequest) Reviewer() string {
+	if r.Login != "" {
+		return r.Login
+	}
+	if r.Slug != "" {
+		return "team:" + r.Slug
 	}
-	return nil, fmt.Errorf("unknown error fetching PRs")
+	return "team:" + r.Name
 }
 
-// fetchPRsWithDateSplit fetches PRs by splitting date range into chunks for parallel processing
-func fetchPRsWithDateSplit(repo string, since, until, author, label string, includeOpen bool) ([]PullRequest, error) {
-	const maxWorkers = 5
-	chunkSize := 14 * 24 * time.Hour // 2-week chunks to reduce GraphQL load
+// IsAutomatedMerge reports whether the PR was merged by GitHub auto-merge or a merge bot.
+func (pr PullRequest) IsAutomatedMerge() bool {
+	return pr.Merged && (pr.AutoMergeEnabled || IsBotLogin(pr.MergedBy.Login))
+}
+
+// FetchPullRequests fetches the repository's pull requests created within the inclusive YYYY-MM-DD range.
+// PRs are paged newest first through the GraphQL API, so there is no result ceiling and paging stops
+// as soon as PRs older than since are reached.
+func FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]PullRequest, error) {
+	return StreamPullRequests(repo, since, until, author, label, includeOpen, nil)
+}
 
-	// Parse dates
-	sinceTime, _ := time.Parse("2006-01-02", since)
-	untilTime, _ := time.Parse("2006-01-02", until)
+// StreamPullRequests is FetchPullRequests, additionally passing each selected PR to emit (when not nil) as
+// soon as its page arrives. A PR is emitted once even when a failed query is retried.
+func StreamPullRequests(repo string, since, until, author, label string, includeOpen bool, emit func(PullRequest)) ([]PullRequest, error) {
+	var states []string
+	if !includeOpen {
+		states = []string{"MERGED", "CLOSED"}
+	}
 
-	// If date range is small, use single request
-	if untilTime.Sub(sinceTime) < chunkSize {
-		return fetchPRsSingle(repo, since, until, author, label, includeOpen)
+	var sinceTime, untilEnd time.Time
+	if t, err := time.Parse("2006-01-02", since); err == nil {
+		sinceTime = t
+	}
+	if t, err := time.Parse("2006-01-02", until); err == nil {
+		untilEnd = t.AddDate(0, 0, 1) // Include the until date
 	}
 
-	// Split into chunks for better parallelization
-	var dateRanges [][]string
-	current := sinceTime
-	for current.Before(untilTime) {
-		end := current.Add(chunkSize)
-		if end.After(untilTime) {
-			end = untilTime
+	if author == "@me" {
+		login, err := currentLogin()
+		if err != nil {
+			return nil, err
 		}
-		dateRanges = append(dateRanges, []string{
-			current.Format("2006-01-02"),
-			end.Format("2006-01-02"),
-		})
-		current = end
+		author = login
 	}
 
-	// Start shiba animation for parallel fetching
-	spinner := animation.NewShibaSpinner(fmt.Sprintf("Fetching PRs in parallel (%d chunks, %d workers)...", len(dateRanges), maxWorkers), false)
+	spinner := animation.NewShibaSpinner("Fetching PRs...", false)
 	spinner.Start()
 	defer spinner.Stop()
 
-	// Channel for work distribution
-	jobs := make(chan []string, len(dateRanges))
-	results := make(chan []PullRequest, len(dateRanges))
-	errors := make(chan error, len(dateRanges))
+	keep := func(pr PullRequest) bool {
+		if !untilEnd.IsZero() && !pr.CreatedAt.Before(untilEnd) {
+			return false
+		}
+		return author == "" || strings.EqualFold(pr.Author.Login, author)
+	}
+	done := func(pr PullRequest) bool {
+		return !sinceTime.IsZero() && pr.CreatedAt.Before(sinceTime)
+	}
+	return queryPullRequests(repo, states, label, keep, done, emit)
+}
 
-	// Worker pool
-	var wg sync.WaitGroup
-	for w := 0; w < maxWorkers; w++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for dateRange := range jobs {
-				prs, err := fetchPRsSingle(repo, dateRange[0], dateRange[1], author, label, includeOpen)
-				if err != nil {
-					errors <- err
-					return
+// prPageSize is the number of PRs per GraphQL page; larger pages make 502/504 responses likely on busy repositories.
+const prPageSize = 50
+
+// pullRequestsQuery lists PRs newest first. gh api --paginate fills $endCursor from pageInfo.
+const pullRequestsQuery = `query($owner: String!, $name: String!, $states: [PullRequestState!], $labels: [String!], $pageSize: Int!, $endCursor: String) {
+	repository(owner: $owner, name: $name) {
+		pullRequests(first: $pageSize, after: $endCursor, states: $states, labels: $labels, orderBy: {field: CREATED_AT, direction: DESC}) {
+			nodes {
+				number title url body createdAt mergedAt closedAt state isDraft
+				additions deletions changedFiles baseRefName headRefName isCrossRepository reviewDecision
+				author { login }
+				mergedBy { login }
+				reviews(first: 100) { nodes { author { login } submittedAt state } }
+				reviewRequests(first: 20) {
+					nodes { requestedReviewer { ... on User { login } ... on Mannequin { login } ... on Team { slug name } } }
 				}
-				results <- prs
-				fmt.Printf("✅ Fetched %d PRs for %s to %s\n", len(prs), dateRange[0], dateRange[1])
+				labels(first: 100) { nodes { name } }
 			}
-		}()
+			pageInfo { hasNextPage endCursor }
+		}
 	}
+}`
+
+// graphQLPullRequest is a PR node of pullRequestsQuery. Connections are wrapped in nodes, so they shadow
+// the flat gh pr list fields of the embedded PullRequest.
+type graphQLPullRequest struct {
+	PullRequest
+	Reviews struct {
+		Nodes []struct {
+			Author struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			SubmittedAt time.Time `json:"submittedAt"`
+			State       string    `json:"state"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+	ReviewRequests struct {
+		Nodes []struct {
+			RequestedReviewer ReviewRequest `json:"requestedReviewer"`
+		} `json:"nodes"`
+	} `json:"reviewRequests"`
+	Labels struct {
+		Nodes []Label `json:"nodes"`
+	} `json:"labels"`
+}
 
-	// Send jobs
-	go func() {
-		for _, dateRange := range dateRanges {
-			jobs <- dateRange
+// toPullRequest flattens the connections into the gh pr list shape.
+func (node graphQLPullRequest) toPullRequest() PullRequest {
+	pr := node.PullRequest
+	pr.Reviews = node.Reviews.Nodes
+	pr.Labels = node.Labels.Nodes
+	for _, request := range node.ReviewRequests.Nodes {
+		pr.ReviewRequests = append(pr.ReviewRequests, request.RequestedReviewer)
+	}
+	return pr
+}
+
+// pullRequestsPage is one page of pullRequestsQuery.
+type pullRequestsPage struct {
+	Data struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []graphQLPullRequest `json:"nodes"`
+			} `json:"pullRequests"`
+		} `json:"repository"`
+	} `json:"data"`
+}
+
+// queryPullRequests pages through PRs in the given states (all when empty) with gh api --paginate,
+// decoding pages as they arrive. keep selects PRs; once done reports true for a PR, the remaining
+// (older) pages are not fetched. Selected PRs are passed to emit (when not nil) as they arrive.
+// Transient upstream errors are retried.
+func queryPullRequests(repo string, states []string, label string, keep, done func(PullRequest) bool, emit func(PullRequest)) ([]PullRequest, error) {
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid repository %q, expected owner/repo", repo)
+	}
+	args := []string{
+		"api", "graphql", "--paginate",
+		"-f", "query=" + pullRequestsQuery,
+		"-f", "owner=" + parts[0],
+		"-f", "name=" + parts[1],
+		"-F", fmt.Sprintf("pageSize=%d", prPageSize),
+	}
+	for _, state := range states {
+		args = append(args, "-f", "states[]="+state)
+	}
+	if label != "" {
+		args = append(args, "-f", "labels[]="+label)
+	}
+
+	// A retry pages from the start again; PRs emitted by a failed attempt are not emitted twice
+	emitted := make(map[int]bool)
+	emitOnce := func(pr PullRequest) {
+		if emit == nil || emitted[pr.Number] {
+			return
 		}
-		close(jobs)
-	}()
+		emitted[pr.Number] = true
+		emit(processPRs([]PullRequest{pr})[0])
+	}
 
-	// Wait for all workers to complete
+	var lastErr error
+	for attempt := 1; attempt <= 3; attempt++ {
+		prs, stderr, err := runPullRequestsQuery(args, keep, done, emitOnce)
+		if err == nil {
+			return fetchMergeStates(repo, processPRs(prs)), nil
+		}
+		lastErr = err
+		// Retry transient upstream issues like 504/timeout with small backoff
+		if attempt < 3 && (strings.Contains(stderr, "504") || strings.Contains(strings.ToLower(stderr), "timeout")) {
+			time.Sleep(time.Duration(attempt) * time.Second)
+			continue
+		}
+		break
+	}
+	return nil, lastErr
+}
+
+// fetchMergeStates fills in the mergeability of the open, non-draft PRs (the ones merge blockers are
+// reported for) with batched GraphQL queries. GitHub computes it on request, so it is left out of
+// pullRequestsQuery, which also pages through closed PRs.
+func fetchMergeStates(repo string, prs []PullRequest) []PullRequest {
+	var numbers []int
+	for _, pr := range prs {
+		if pr.State == "OPEN" && !pr.IsDraft {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+
+	type mergeState struct {
+		Number           int    `json:"number"`
+		Mergeable        string `json:"mergeable"`
+		MergeStateStatus string `json:"mergeStateStatus"`
+	}
+	states := make(map[int]mergeState)
+	queryPRBatches(repo, numbers, "merge states", "mergeable mergeStateStatus", func(repository json.RawMessage) error {
+		var nodes map[string]mergeState
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+		for _, pr := range nodes {
+			states[pr.Number] = pr
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if state, ok := states[prs[i].Number]; ok {
+			prs[i].Mergeable = state.Mergeable
+			prs[i].MergeStateStatus = state.MergeStateStatus
+		}
+	}
+	return prs
+}
+
+// runPullRequestsQuery runs one paginated gh api call, returning the selected PRs and gh's stderr.
+func runPullRequestsQuery(args []string, keep, done func(PullRequest) bool, emit func(PullRequest)) ([]PullRequest, string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stdout, pipe := io.Pipe()
+	var stderr bytes.Buffer
+	cmd := transport.CommandContext(ctx, "gh", args...)
+	cmd.Stdout = pipe
+	cmd.Stderr = &stderr
+	finished := make(chan error, 1)
 	go func() {
-		wg.Wait()
-		close(results)
-		close(errors)
+		err := cmd.Run()
+		pipe.Close()
+		finished <- err
 	}()
 
-	// Collect results
-	var allPRs []PullRequest
-	var lastError error
+	// stop abandons the rest of the output and waits for gh to exit
+	stop := func() {
+		cancel()
+		stdout.Close()
+		<-finished
+	}
 
-	for {
-		select {
-		case prs, ok := <-results:
-			if !ok {
-				results = nil
-			} else {
-				allPRs = append(allPRs, prs...)
+	var prs []PullRequest
+	decoder := json.NewDecoder(stdout)
+	stopped := false
+	for !stopped {
+		var page pullRequestsPage
+		if err := decoder.Decode(&page); err == io.EOF {
+			break
+		} else if err != nil {
+			stop()
+			return nil, stderr.String(), fmt.Errorf("failed to parse PR page: %w\n%s", err, stderr.String())
+		}
+		for _, node := range page.Data.Repository.PullRequests.Nodes {
+			pr := node.toPullRequest()
+			if done(pr) {
+				stopped = true
+				break
 			}
-		case err, ok := <-errors:
-			if !ok {
-				errors = nil
-			} else {
-				lastError = err
+			if keep(pr) {
+				prs = append(prs, pr)
+				emit(pr)
 			}
 		}
+	}
 
-		if results == nil && errors == nil {
-			break
-		}
+	if stopped {
+		// The remaining pages are older than needed; stop gh instead of paging through the whole history
+		stop()
+		return prs, "", nil
+	}
+	if err := <-finished; err != nil {
+		return nil, stderr.String(), fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+	return prs, "", nil
+}
+
+// CountPullRequests returns the number of PRs created in the inclusive YYYY-MM-DD range, using a single search call.
+func CountPullRequests(repo, since, until string) (int, error) {
+	created := since + ".." + until
+	if until == "" {
+		created = ">=" + since
+	}
+	cmd := transport.Command("gh", "api", "-X", "GET", "search/issues",
+		"-f", fmt.Sprintf("q=repo:%s is:pr created:%s", repo, created),
+		"-f", "per_page=1",
+		"--jq", ".total_count")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return 0, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+	return strconv.Atoi(strings.TrimSpace(stdout.String()))
+}
+
+// EstimateAPICalls approximates the API calls a default analysis of prCount PRs makes: list pages,
+// sampled review comment lookups, per-PR reopen events and commits, and batched auto-merge queries.
+func EstimateAPICalls(prCount int) int {
+	commentSample := prCount
+	if commentSample > 100 {
+		commentSample = 100
+	}
+	return (prCount+prPageSize-1)/prPageSize + commentSample + 2*prCount + (prCount+29)/30
+}
+
+// currentLogin returns the login of the authenticated gh user.
+func currentLogin() (string, error) {
+	cmd := transport.Command("gh", "api", "user", "--jq", ".login")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
 	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// FetchDefaultBranch returns the repository's default branch name using gh repo view.
+func FetchDefaultBranch(repo string) (string, error) {
+	cmd := transport.Command("gh", "repo", "view", repo, "--json", "defaultBranchRef", "--jq", ".defaultBranchRef.name")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
 
-	if lastError != nil {
-		return nil, lastError
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
 	}
 
-	deduped := deduplicatePRs(filterDependabotPRs(allPRs))
-	if len(deduped) != len(allPRs) {
-		fmt.Printf("ℹ️  Removed %d duplicate PRs after chunked fetch\n", len(allPRs)-len(deduped))
+	branch := strings.TrimSpace(stdout.String())
+	if branch == "" {
+		return "", fmt.Errorf("repository %s has no default branch", repo)
 	}
+	return branch, nil
+}
 
-	fmt.Printf("🎉 Total unique PRs fetched: %d\n", len(deduped))
-	return deduped, nil
+// ParsePullRequests decodes `gh pr list --json` output and applies the standard post-processing
+// (merged flag, lead time). Bot PRs are kept; use SplitBotPRs to separate them.
+func ParsePullRequests(data []byte) ([]PullRequest, error) {
+	var prs []PullRequest
+	if err := json.Unmarshal(data, &prs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	return processPRs(prs), nil
 }
 
 // Comment represents a PR comment
@@ -318,6 +651,448 @@ func FetchPRCommentTiming(repo string, prs []PullRequest) []PullRequest {
 	return prs
 }
 
+// Commit is a PR commit in the `gh pr list --json commits` shape.
+type Commit struct {
+	AuthoredDate    time.Time `json:"authoredDate"`
+	CommittedDate   time.Time `json:"committedDate"`
+	MessageHeadline string    `json:"messageHeadline"`
+	MessageBody     string    `json:"messageBody"`
+}
+
+// PRFile is a file changed by a PR in the `gh pr list --json files` shape.
+type PRFile struct {
+	Path      string `json:"path"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// FetchChangedFiles loads the changed files of each merged PR that does not have them yet.
+func FetchChangedFiles(repo string, prs []PullRequest) []PullRequest {
+	var targets []PullRequest
+	for _, pr := range prs {
+		if pr.Merged && len(pr.Files) == 0 {
+			targets = append(targets, pr)
+		}
+	}
+
+	if len(targets) == 0 {
+		return prs
+	}
+
+	fmt.Printf("🔍 Fetching changed files for %d PRs...\n", len(targets))
+	stage := progress.Start("fetch_changed_files", len(targets))
+	defer stage.End()
+
+	type result struct {
+		number int
+		files  []PRFile
+	}
+
+	jobs := make(chan PullRequest, len(targets))
+	results := make(chan result, len(targets))
+	const workers = 4
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for pr := range jobs {
+				results <- result{number: pr.Number, files: fetchPRFiles(repo, pr.Number)}
+			}
+		}()
+	}
+
+	for _, pr := range targets {
+		jobs <- pr
+	}
+	close(jobs)
+
+	filesByPR := make(map[int][]PRFile, len(targets))
+	for i := 0; i < len(targets); i++ {
+		r := <-results
+		stage.Add(1)
+		if len(r.files) > 0 {
+			filesByPR[r.number] = r.files
+		}
+	}
+
+	for i := range prs {
+		if files, ok := filesByPR[prs[i].Number]; ok {
+			prs[i].Files = files
+		}
+	}
+
+	return prs
+}
+
+// fetchPRFiles returns the files changed by a PR (the API caps this at 3000 files).
+func fetchPRFiles(repo string, number int) []PRFile {
+	cmd := transport.Command("gh", "api", "--paginate", fmt.Sprintf("repos/%s/pulls/%d/files?per_page=100", repo, number))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	// --paginate emits one JSON array per page back to back, so decode them in sequence.
+	var files []PRFile
+	decoder := json.NewDecoder(&stdout)
+	for {
+		var page []struct {
+			Filename  string `json:"filename"`
+			Additions int    `json:"additions"`
+			Deletions int    `json:"deletions"`
+		}
+		if err := decoder.Decode(&page); err != nil {
+			break
+		}
+		for _, f := range page {
+			files = append(files, PRFile{Path: f.Filename, Additions: f.Additions, Deletions: f.Deletions})
+		}
+	}
+	return files
+}
+
+// FetchCommits loads the commits (dates and messages) of each merged PR and records the
+// author date of the first commit (for the coding stage).
+func FetchCommits(repo string, prs []PullRequest) []PullRequest {
+	var targets []PullRequest
+	for _, pr := range prs {
+		if pr.Merged {
+			targets = append(targets, pr)
+		}
+	}
+
+	if len(targets) == 0 {
+		return prs
+	}
+
+	fmt.Printf("🔍 Fetching commits for %d PRs...\n", len(targets))
+	stage := progress.Start("fetch_commits", len(targets))
+	defer stage.End()
+
+	type result struct {
+		number  int
+		commits []Commit
+	}
+
+	jobs := make(chan PullRequest, len(targets))
+	results := make(chan result, len(targets))
+	const workers = 4
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for pr := range jobs {
+				results <- result{number: pr.Number, commits: fetchPRCommits(repo, pr.Number)}
+			}
+		}()
+	}
+
+	for _, pr := range targets {
+		jobs <- pr
+	}
+	close(jobs)
+
+	commitsByPR := make(map[int][]Commit, len(targets))
+	for i := 0; i < len(targets); i++ {
+		r := <-results
+		stage.Add(1)
+		if len(r.commits) > 0 {
+			commitsByPR[r.number] = r.commits
+		}
+	}
+
+	for i := range prs {
+		if commits, ok := commitsByPR[prs[i].Number]; ok {
+			prs[i].Commits = commits
+			prs[i].FirstCommitAt = commits[0].AuthoredDate
+		}
+	}
+
+	return prs
+}
+
+// fetchPRCommits returns the commits of a PR, oldest first (the API caps this at 250 commits).
+func fetchPRCommits(repo string, number int) []Commit {
+	cmd := transport.Command("gh", "api", "--paginate", fmt.Sprintf("repos/%s/pulls/%d/commits?per_page=100", repo, number))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	// --paginate emits one JSON array per page back to back, so decode them in sequence.
+	var commits []Commit
+	decoder := json.NewDecoder(&stdout)
+	for {
+		var page []struct {
+			Commit struct {
+				Message string `json:"message"`
+				Author  struct {
+					Date time.Time `json:"date"`
+				} `json:"author"`
+				Committer struct {
+					Date time.Time `json:"date"`
+				} `json:"committer"`
+			} `json:"commit"`
+		}
+		if err := decoder.Decode(&page); err != nil {
+			break
+		}
+		for _, c := range page {
+			headline, body, _ := strings.Cut(c.Commit.Message, "\n")
+			commits = append(commits, Commit{
+				AuthoredDate:    c.Commit.Author.Date,
+				CommittedDate:   c.Commit.Committer.Date,
+				MessageHeadline: headline,
+				MessageBody:     strings.TrimSpace(body),
+			})
+		}
+	}
+	return commits
+}
+
+// FetchReadyForReviewTimes records when each merged, closed or open non-draft PR was last marked ready
+// for review, so draft time can be excluded from lead/review time and open PRs' review waits.
+func FetchReadyForReviewTimes(repo string, prs []PullRequest) []PullRequest {
+	var targets []PullRequest
+	for _, pr := range prs {
+		if pr.Merged || pr.State == "CLOSED" || (pr.State == "OPEN" && !pr.IsDraft) {
+			targets = append(targets, pr)
+		}
+	}
+
+	if len(targets) == 0 {
+		return prs
+	}
+
+	fmt.Printf("🔍 Checking ready-for-review events for %d PRs...\n", len(targets))
+	stage := progress.Start("fetch_ready_for_review_events", len(targets))
+	defer stage.End()
+
+	type result struct {
+		number int
+		time   time.Time
+	}
+
+	jobs := make(chan PullRequest, len(targets))
+	results := make(chan result, len(targets))
+	const workers = 4
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for pr := range jobs {
+				results <- result{number: pr.Number, time: fetchLastReadyForReviewEvent(repo, pr.Number)}
+			}
+		}()
+	}
+
+	for _, pr := range targets {
+		jobs <- pr
+	}
+	close(jobs)
+
+	readyTimes := make(map[int]time.Time, len(targets))
+	for i := 0; i < len(targets); i++ {
+		r := <-results
+		stage.Add(1)
+		if !r.time.IsZero() {
+			readyTimes[r.number] = r.time
+		}
+	}
+
+	for i := range prs {
+		if t, ok := readyTimes[prs[i].Number]; ok {
+			prs[i].ReadyForReviewAt = t
+		}
+	}
+
+	return prs
+}
+
+// fetchLastReadyForReviewEvent returns the latest ReadyForReviewEvent from the issue timeline.
+func fetchLastReadyForReviewEvent(repo string, number int) time.Time {
+	cmd := transport.Command("gh", "api", "--paginate", fmt.Sprintf("repos/%s/issues/%d/timeline?per_page=100", repo, number),
+		"--jq", `.[] | select(.event == "ready_for_review") | .created_at`)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return time.Time{}
+	}
+
+	latest := time.Time{}
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(line))
+		if err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// FetchAutoMergeEvents flags merged PRs that had GitHub auto-merge enabled, using batched GraphQL queries.
+func FetchAutoMergeEvents(repo string, prs []PullRequest) []PullRequest {
+	var numbers []int
+	for _, pr := range prs {
+		if pr.Merged {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+
+	autoMerged := make(map[int]bool)
+	queryPRBatches(repo, numbers, "auto-merge events", `timelineItems(itemTypes: [AUTO_MERGE_ENABLED_EVENT], first: 1) {
+				totalCount
+			}`, func(repository json.RawMessage) error {
+		var nodes map[string]struct {
+			Number        int `json:"number"`
+			TimelineItems struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"timelineItems"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			if pr.TimelineItems.TotalCount > 0 {
+				autoMerged[pr.Number] = true
+			}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if autoMerged[prs[i].Number] {
+			prs[i].AutoMergeEnabled = true
+		}
+	}
+
+	return prs
+}
+
+// FetchRequestedReviewers records every reviewer requested on merged PRs, including requests that were
+// later fulfilled or removed, from review-requested timeline events in batched GraphQL queries.
+func FetchRequestedReviewers(repo string, prs []PullRequest) []PullRequest {
+	var numbers []int
+	for _, pr := range prs {
+		if pr.Merged {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+
+	requested := make(map[int][]string)
+	queryPRBatches(repo, numbers, "requested reviewers", `timelineItems(itemTypes: [REVIEW_REQUESTED_EVENT], first: 50) {
+				nodes {
+					... on ReviewRequestedEvent {
+						requestedReviewer {
+							... on User { login }
+							... on Mannequin { login }
+							... on Team { slug }
+						}
+					}
+				}
+			}`, func(repository json.RawMessage) error {
+		var nodes map[string]struct {
+			Number        int `json:"number"`
+			TimelineItems struct {
+				Nodes []struct {
+					RequestedReviewer ReviewRequest `json:"requestedReviewer"`
+				} `json:"nodes"`
+			} `json:"timelineItems"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			seen := make(map[string]bool)
+			for _, node := range pr.TimelineItems.Nodes {
+				reviewer := node.RequestedReviewer.Reviewer()
+				if reviewer != "" && !seen[reviewer] {
+					seen[reviewer] = true
+					requested[pr.Number] = append(requested[pr.Number], reviewer)
+				}
+			}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if reviewers, ok := requested[prs[i].Number]; ok {
+			prs[i].RequestedReviewers = reviewers
+		}
+	}
+
+	return prs
+}
+
+// LinkedIssue is an issue referenced by a PR as one it closes.
+type LinkedIssue struct {
+	Repo      string // owner/repo
+	Number    int
+	CreatedAt time.Time
+}
+
+// FetchLinkedIssues resolves the issues each merged PR closes (closing keywords in the body or
+// manually linked in the sidebar) and records when they were created, using batched GraphQL queries.
+func FetchLinkedIssues(repo string, prs []PullRequest) []PullRequest {
+	var numbers []int
+	for _, pr := range prs {
+		if pr.Merged {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+
+	linked := make(map[int][]LinkedIssue)
+	queryPRBatches(repo, numbers, "linked issues", `closingIssuesReferences(first: 10) {
+				nodes {
+					number
+					createdAt
+					repository { nameWithOwner }
+				}
+			}`, func(repository json.RawMessage) error {
+		var nodes map[string]struct {
+			Number                  int `json:"number"`
+			ClosingIssuesReferences struct {
+				Nodes []struct {
+					Number     int       `json:"number"`
+					CreatedAt  time.Time `json:"createdAt"`
+					Repository struct {
+						NameWithOwner string `json:"nameWithOwner"`
+					} `json:"repository"`
+				} `json:"nodes"`
+			} `json:"closingIssuesReferences"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			for _, issue := range pr.ClosingIssuesReferences.Nodes {
+				linked[pr.Number] = append(linked[pr.Number], LinkedIssue{
+					Repo:      issue.Repository.NameWithOwner,
+					Number:    issue.Number,
+					CreatedAt: issue.CreatedAt,
+				})
+			}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		prs[i].LinkedIssues = linked[prs[i].Number]
+	}
+
+	return prs
+}
+
 // FetchReopenEvents marks PRs that were reopened and captures the first reopened timestamp.
 func FetchReopenEvents(repo string, prs []PullRequest) []PullRequest {
 	parts := strings.Split(repo, "/")
@@ -339,6 +1114,8 @@ func FetchReopenEvents(repo string, prs []PullRequest) []PullRequest {
 	}
 
 	fmt.Printf("🔍 Checking reopen events for %d PRs...\n", len(targets))
+	stage := progress.Start("fetch_reopen_events", len(targets))
+	defer stage.End()
 
 	type result struct {
 		number int
@@ -370,6 +1147,7 @@ func FetchReopenEvents(repo string, prs []PullRequest) []PullRequest {
 	reopenTimes := make(map[int]time.Time, len(targets))
 	for i := 0; i < len(targets); i++ {
 		r := <-results
+		stage.Add(1)
 		if !r.time.IsZero() {
 			reopenTimes[r.number] = r.time
 		}
@@ -387,7 +1165,7 @@ func FetchReopenEvents(repo string, prs []PullRequest) []PullRequest {
 
 // fetchFirstReopenEvent fetches the first "reopened" event for a PR using the issues events API.
 func fetchFirstReopenEvent(owner, repo string, number int) time.Time {
-	cmd := exec.Command("gh", "api", fmt.Sprintf("repos/%s/%s/issues/%d/events", owner, repo, number),
+	cmd := transport.Command("gh", "api", fmt.Sprintf("repos/%s/%s/issues/%d/events", owner, repo, number),
 		"--json", "event,created_at")
 
 	var stdout, stderr bytes.Buffer
@@ -438,7 +1216,7 @@ func fetchSinglePRCommentTiming(repo string, prNumber int) PRCommentTiming {
 		"--json", "comments,reviews,createdAt",
 	}
 
-	cmd := exec.Command("gh", args...)
+	cmd := transport.Command("gh", args...)
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -510,7 +1288,7 @@ func fetchPRCommentCountsGraphQL(owner, repo string, prs []PullRequest) map[int]
 	query := buildPRCommentQuery(owner, repo, prNumbers)
 
 	// Execute GraphQL query using gh api
-	cmd := exec.Command("gh", "api", "graphql", "-f", fmt.Sprintf("query=%s", query))
+	cmd := transport.Command("gh", "api", "graphql", "-f", fmt.Sprintf("query=%s", query))
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -576,6 +1354,9 @@ func buildPRCommentQuery(owner, repo string, prNumbers []int) string {
 func fetchPRReviewCommentCounts(owner, repo string, prs []PullRequest) map[int]int {
 	reviewCommentCounts := make(map[int]int)
 
+	stage := progress.Start("fetch_review_comment_counts", len(prs))
+	defer stage.End()
+
 	// Use worker pool for parallel processing
 	maxWorkers := 5 // Reasonable limit to avoid hitting GitHub API rate limits
 	jobs := make(chan PullRequest, len(prs))
@@ -606,6 +1387,7 @@ func fetchPRReviewCommentCounts(owner, repo string, prs []PullRequest) map[int]i
 	// Collect results
 	for i := 0; i < len(prs); i++ {
 		result := <-results
+		stage.Add(1)
 		reviewCommentCounts[result.prNumber] = result.count
 	}
 
@@ -614,30 +1396,18 @@ func fetchPRReviewCommentCounts(owner, repo string, prs []PullRequest) map[int]i
 
 // fetchSinglePRReviewCommentCount fetches review comment count for a single PR (excluding replies)
 func fetchSinglePRReviewCommentCount(owner, repo string, prNumber int) int {
-	// Use REST API to get review comments with in_reply_to_id field
-	cmd := exec.Command("gh", "api", fmt.Sprintf("repos/%s/%s/pulls/%d/comments", owner, repo, prNumber))
+	// Use REST API to get review comments with in_reply_to_id field.
+	// Time out after 10 seconds to avoid hanging on slow API calls
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cmd := transport.CommandContext(ctx, "gh", "api", fmt.Sprintf("repos/%s/%s/pulls/%d/comments", owner, repo, prNumber))
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	// Add timeout to avoid hanging on slow API calls
-	done := make(chan error, 1)
-	go func() {
-		done <- cmd.Run()
-	}()
-
-	select {
-	case err := <-done:
-		if err != nil {
-			// Silently ignore errors for individual PRs
-			return 0
-		}
-	case <-time.After(10 * time.Second):
-		// Timeout after 10 seconds
-		if cmd.Process != nil {
-			cmd.Process.Kill()
-		}
+	if err := cmd.Run(); err != nil {
+		// Silently ignore errors (and timeouts) for individual PRs
 		return 0
 	}
 
@@ -665,47 +1435,45 @@ func fetchSinglePRReviewCommentCount(owner, repo string, prNumber int) int {
 	return originalComments
 }
 
-// buildBaseArgs builds the base arguments for gh pr list command
-func buildBaseArgs(repo string, since, until, author, label string, includeOpen bool) []string {
-	args := []string{
-		"pr", "list",
-		"--repo", repo,
-		"--json", "number,title,createdAt,mergedAt,closedAt,author,additions,deletions,changedFiles,isDraft,state,mergedBy,reviews,baseRefName,headRefName",
-	}
+// FetchOpenPullRequests fetches the currently open PRs, including pending review requests.
+func FetchOpenPullRequests(repo string) ([]PullRequest, error) {
+	spinner := animation.NewShibaSpinner("Fetching open PRs...", false)
+	spinner.Start()
+	defer spinner.Stop()
 
-	// Add state filter
-	if !includeOpen {
-		args = append(args, "--state", "closed")
-	} else {
-		args = append(args, "--state", "all")
-	}
+	all := func(PullRequest) bool { return true }
+	never := func(PullRequest) bool { return false }
+	return queryPullRequests(repo, []string{"OPEN"}, "", all, never, nil)
+}
 
-	// Add author filter
-	if author != "" {
-		args = append(args, "--author", author)
-	}
+// CommentOnIssue posts body as a comment on issue or PR number and returns the comment URL.
+func CommentOnIssue(repo string, number int, body string) (string, error) {
+	return postIssueAPI(fmt.Sprintf("repos/%s/issues/%d/comments", repo, number), map[string]string{"body": body})
+}
 
-	// Add label filter
-	if label != "" {
-		args = append(args, "--label", label)
-	}
+// CreateIssue opens a new issue and returns its URL.
+func CreateIssue(repo, title, body string) (string, error) {
+	return postIssueAPI(fmt.Sprintf("repos/%s/issues", repo), map[string]string{"title": title, "body": body})
+}
 
-	// Add created date filter using search query
-	var searchQueries []string
-	if since != "" && until != "" {
-		searchQueries = append(searchQueries, fmt.Sprintf("created:%s..%s", since, until))
-	} else if since != "" {
-		searchQueries = append(searchQueries, fmt.Sprintf("created:>=%s", since))
-	} else if until != "" {
-		searchQueries = append(searchQueries, fmt.Sprintf("created:<=%s", until))
+// postIssueAPI POSTs payload to a REST endpoint through gh api and returns the html_url of the result.
+func postIssueAPI(endpoint string, payload map[string]string) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
 	}
 
-	if len(searchQueries) > 0 {
-		searchQuery := strings.Join(searchQueries, " ")
-		args = append(args, "--search", searchQuery)
-	}
+	cmd := transport.Command("gh", "api", "--method", "POST", endpoint, "--input", "-", "--jq", ".html_url")
+	cmd.Stdin = bytes.NewReader(data)
 
-	return args
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+	return strings.TrimSpace(stdout.String()), nil
 }
 
 // processPRs processes PRs to calculate lead time and set merged flag
@@ -714,63 +1482,107 @@ func processPRs(prs []PullRequest) []PullRequest {
 		// Set Merged flag based on state
 		prs[i].Merged = (prs[i].State == "MERGED")
 
+		// Lead time only applies to merged PRs; abandoned ones are measured separately
 		if prs[i].Merged && !prs[i].MergedAt.IsZero() {
 			prs[i].LeadTime = prs[i].MergedAt.Sub(prs[i].CreatedAt)
-		} else if !prs[i].ClosedAt.IsZero() {
-			prs[i].LeadTime = prs[i].ClosedAt.Sub(prs[i].CreatedAt)
 		}
 	}
 	return prs
 }
 
-// filterDependabotPRs drops PRs authored by dependabot to avoid skewing release/PR metrics.
-func filterDependabotPRs(prs []PullRequest) []PullRequest {
-	filtered := make([]PullRequest, 0, len(prs))
+// SplitBotPRs separates bot-authored PRs (Dependabot, Renovate, other [bot] accounts) from human PRs,
+// so bots do not skew release/PR metrics but can still be reported on their own.
+func SplitBotPRs(prs []PullRequest) (human []PullRequest, bots []PullRequest) {
+	human = make([]PullRequest, 0, len(prs))
 	for _, pr := range prs {
-		login := strings.ToLower(pr.Author.Login)
-		head := strings.ToLower(pr.HeadRefName)
-		title := strings.ToLower(pr.Title)
-		// GitHub apps sometimes appear as "dependabot", "dependabot[bot]", "app/dependabot",
-		// or generic bot accounts ending with "[bot]".
-		if login != "" &&
-			(strings.HasPrefix(login, "dependabot") ||
-				strings.Contains(login, "dependabot") ||
-				strings.HasSuffix(login, "[bot]")) {
+		if isBotPR(pr) {
+			bots = append(bots, pr)
 			continue
 		}
-		if head != "" && strings.HasPrefix(head, "dependabot") {
-			continue
-		}
-		if title != "" && strings.Contains(title, "dependabot") {
-			continue
-		}
-		filtered = append(filtered, pr)
+		human = append(human, pr)
 	}
-	return filtered
+	return human, bots
 }
 
-// deduplicatePRs removes duplicate pull requests by PR number, keeping the most recent occurrence.
-func deduplicatePRs(prs []PullRequest) []PullRequest {
-	seen := make(map[int]PullRequest)
+// isBotPR reports whether a PR was opened by a bot account.
+func isBotPR(pr PullRequest) bool {
+	login := strings.ToLower(pr.Author.Login)
+	// GitHub apps sometimes appear as "dependabot", "dependabot[bot]", "app/dependabot",
+	// or generic bot accounts ending with "[bot]".
+	if login != "" &&
+		(strings.HasPrefix(login, "dependabot") ||
+			strings.Contains(login, "dependabot") ||
+			strings.Contains(login, "renovate") ||
+			strings.HasSuffix(login, "[bot]")) {
+		return true
+	}
+	return IsBotLogin(login) || pr.IsDependencyUpdate()
+}
 
-	for _, pr := range prs {
-		existing, exists := seen[pr.Number]
-		if !exists || pr.CreatedAt.After(existing.CreatedAt) {
-			seen[pr.Number] = pr
+// IsDependencyUpdate reports whether the PR is a Dependabot or Renovate dependency update.
+func (pr PullRequest) IsDependencyUpdate() bool {
+	login := strings.ToLower(pr.Author.Login)
+	head := strings.ToLower(pr.HeadRefName)
+	title := strings.ToLower(pr.Title)
+	if strings.Contains(login, "dependabot") || strings.Contains(login, "renovate") {
+		return true
+	}
+	if strings.HasPrefix(head, "dependabot") || strings.HasPrefix(head, "renovate/") {
+		return true
+	}
+	return title != "" && strings.Contains(title, "dependabot")
+}
+
+// advisoryPattern matches CVE and GitHub security advisory identifiers.
+var advisoryPattern = regexp.MustCompile(`(?i)\bCVE-\d{4}-\d{4,}\b|\bGHSA(-[23456789cfghjmpqrvwx]{4}){3}\b`)
+
+// IsSecurityRelated reports whether the PR addresses a vulnerability: a security/vulnerability label,
+// a "security" title prefix or Renovate's "[SECURITY]" suffix, or a CVE/GHSA identifier in the title or body.
+func (pr PullRequest) IsSecurityRelated() bool {
+	for _, l := range pr.Labels {
+		name := strings.ToLower(l.Name)
+		if strings.Contains(name, "security") || strings.Contains(name, "vulnerab") {
+			return true
 		}
 	}
+	title := strings.ToLower(pr.Title)
+	if strings.HasPrefix(title, "security") || strings.Contains(title, "[security]") {
+		return true
+	}
+	return advisoryPattern.MatchString(pr.Title) || advisoryPattern.MatchString(pr.Body)
+}
 
-	deduped := make([]PullRequest, 0, len(seen))
-	for _, pr := range seen {
-		deduped = append(deduped, pr)
+// DependencyTool returns "dependabot" or "renovate" for dependency update PRs, or "" otherwise.
+func (pr PullRequest) DependencyTool() string {
+	login := strings.ToLower(pr.Author.Login)
+	head := strings.ToLower(pr.HeadRefName)
+	if strings.Contains(login, "renovate") || strings.HasPrefix(head, "renovate/") {
+		return "renovate"
 	}
+	if pr.IsDependencyUpdate() {
+		return "dependabot"
+	}
+	return ""
+}
 
-	sort.Slice(deduped, func(i, j int) bool {
-		if deduped[i].CreatedAt.Equal(deduped[j].CreatedAt) {
-			return deduped[i].Number < deduped[j].Number
-		}
-		return deduped[i].CreatedAt.Before(deduped[j].CreatedAt)
-	})
+// ListRepositories lists up to limit non-archived repositories of a user or organization as owner/name.
+func ListRepositories(owner string, limit int) ([]string, error) {
+	cmd := transport.Command("gh", "repo", "list", owner, "--no-archived", "--limit", strconv.Itoa(limit),
+		"--json", "nameWithOwner", "--jq", ".[].nameWithOwner")
 
-	return deduped
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+
+	var repos []string
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			repos = append(repos, line)
+		}
+	}
+	return repos, nil
 }
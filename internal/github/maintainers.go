@@ -0,0 +1,89 @@
+package github
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// IsMaintainerAssociation reports whether a GitHub author association (OWNER, MEMBER, COLLABORATOR,
+// CONTRIBUTOR, FIRST_TIME_CONTRIBUTOR, NONE, ...) grants write access to the repository.
+func IsMaintainerAssociation(association string) bool {
+	switch association {
+	case "OWNER", "MEMBER", "COLLABORATOR":
+		return true
+	}
+	return false
+}
+
+// FetchMaintainerResponses records the first comment or review by a maintainer (an owner, member or
+// collaborator other than the author, bots excluded) on each PR from a fork, looking at the first 100
+// comments and reviews, using batched GraphQL queries. PRs from the repository's own branches are left as-is.
+func FetchMaintainerResponses(repo string, prs []PullRequest) []PullRequest {
+	var forks []int
+	for _, pr := range prs {
+		if pr.IsCrossRepository {
+			forks = append(forks, pr.Number)
+		}
+	}
+
+	type response struct {
+		at        time.Time
+		responder string
+	}
+	first := make(map[int]response)
+
+	queryPRBatches(repo, forks, "maintainer responses", `author { login }
+			comments(first: 100) { nodes { author { login } authorAssociation createdAt } }
+			reviews(first: 100) { nodes { author { login } authorAssociation submittedAt } }`, func(repository json.RawMessage) error {
+		type author struct {
+			Login string `json:"login"`
+		}
+		var nodes map[string]struct {
+			Number   int    `json:"number"`
+			Author   author `json:"author"`
+			Comments struct {
+				Nodes []struct {
+					Author            author    `json:"author"`
+					AuthorAssociation string    `json:"authorAssociation"`
+					CreatedAt         time.Time `json:"createdAt"`
+				} `json:"nodes"`
+			} `json:"comments"`
+			Reviews struct {
+				Nodes []struct {
+					Author            author    `json:"author"`
+					AuthorAssociation string    `json:"authorAssociation"`
+					SubmittedAt       time.Time `json:"submittedAt"`
+				} `json:"nodes"`
+			} `json:"reviews"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			record := func(login, association string, at time.Time) {
+				if login == pr.Author.Login || IsBotLogin(login) || !IsMaintainerAssociation(association) || at.IsZero() {
+					return
+				}
+				if r, ok := first[pr.Number]; !ok || at.Before(r.at) {
+					first[pr.Number] = response{at: at, responder: login}
+				}
+			}
+			for _, c := range pr.Comments.Nodes {
+				record(c.Author.Login, c.AuthorAssociation, c.CreatedAt)
+			}
+			for _, r := range pr.Reviews.Nodes {
+				record(r.Author.Login, r.AuthorAssociation, r.SubmittedAt)
+			}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if r, ok := first[prs[i].Number]; ok {
+			prs[i].FirstMaintainerResponseAt = r.at
+			prs[i].FirstMaintainerResponder = r.responder
+		}
+	}
+	return prs
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Merge methods inferred by FetchMergeCommits.
+const (
+	MergeMethodMerge  = "merge"
+	MergeMethodSquash = "squash"
+	MergeMethodRebase = "rebase"
+)
+
+// HasLandedSize reports whether the size of the commit the PR landed as is known. Rebase merges land
+// several commits, so the last one's size says nothing about the PR.
+func (pr PullRequest) HasLandedSize() bool {
+	return pr.MergeMethod == MergeMethodMerge || pr.MergeMethod == MergeMethodSquash
+}
+
+// FetchMergeCommits records the merge method of each merged PR and the additions and deletions of the
+// commit it landed as on the base branch, using batched GraphQL queries. A merge commit with two parents
+// is a merge; a single-parent commit is a squash when the PR had one commit or the headline ends with
+// GitHub's "(#N)" squash suffix, otherwise a rebase.
+func FetchMergeCommits(repo string, prs []PullRequest) []PullRequest {
+	var numbers []int
+	for _, pr := range prs {
+		if pr.Merged {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+
+	type landed struct {
+		method               string
+		additions, deletions int
+	}
+	byPR := make(map[int]landed)
+
+	queryPRBatches(repo, numbers, "merge commits", `commits { totalCount }
+			mergeCommit { oid messageHeadline additions deletions parents { totalCount } }`, func(repository json.RawMessage) error {
+		var nodes map[string]struct {
+			Number  int `json:"number"`
+			Commits struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"commits"`
+			MergeCommit *struct {
+				Oid             string `json:"oid"`
+				MessageHeadline string `json:"messageHeadline"`
+				Additions       int    `json:"additions"`
+				Deletions       int    `json:"deletions"`
+				Parents         struct {
+					TotalCount int `json:"totalCount"`
+				} `json:"parents"`
+			} `json:"mergeCommit"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			commit := pr.MergeCommit
+			if commit == nil {
+				continue
+			}
+			method := MergeMethodRebase
+			switch {
+			case commit.Parents.TotalCount > 1:
+				method = MergeMethodMerge
+			case pr.Commits.TotalCount == 1 || strings.HasSuffix(commit.MessageHeadline, fmt.Sprintf("(#%d)", pr.Number)):
+				method = MergeMethodSquash
+			}
+			byPR[pr.Number] = landed{method: method, additions: commit.Additions, deletions: commit.Deletions}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if l, ok := byPR[prs[i].Number]; ok {
+			prs[i].MergeMethod = l.method
+			prs[i].LandedAdditions = l.additions
+			prs[i].LandedDeletions = l.deletions
+		}
+	}
+
+	return prs
+}
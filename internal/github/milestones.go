@@ -0,0 +1,101 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"visuche/internal/transport"
+)
+
+// Milestone is a repository milestone in the REST API shape.
+type Milestone struct {
+	Number       int       `json:"number"`
+	Title        string    `json:"title"`
+	State        string    `json:"state"` // "open" or "closed"
+	CreatedAt    time.Time `json:"created_at"`
+	DueOn        time.Time `json:"due_on"`
+	ClosedAt     time.Time `json:"closed_at"`
+	OpenIssues   int       `json:"open_issues"`
+	ClosedIssues int       `json:"closed_issues"`
+}
+
+// MilestoneItem is an issue or PR assigned to a milestone, in the REST issues API shape.
+type MilestoneItem struct {
+	Number      int             `json:"number"`
+	Title       string          `json:"title"`
+	State       string          `json:"state"` // "open" or "closed"
+	CreatedAt   time.Time       `json:"created_at"`
+	ClosedAt    time.Time       `json:"closed_at"`
+	PullRequest json.RawMessage `json:"pull_request,omitempty"` // Set for PRs only
+}
+
+// IsPullRequest reports whether the item is a PR rather than an issue.
+func (i MilestoneItem) IsPullRequest() bool {
+	return len(i.PullRequest) > 0 && string(i.PullRequest) != "null"
+}
+
+// FindMilestone returns the milestone whose number or title (case-insensitive) is ref.
+func FindMilestone(milestones []Milestone, ref string) (Milestone, error) {
+	ref = strings.TrimPrefix(strings.TrimSpace(ref), "#")
+	number, numErr := strconv.Atoi(ref)
+	for _, m := range milestones {
+		if (numErr == nil && m.Number == number) || strings.EqualFold(m.Title, ref) {
+			return m, nil
+		}
+	}
+	return Milestone{}, fmt.Errorf("milestone %q not found", ref)
+}
+
+// FetchMilestones lists the open and closed milestones of repo.
+func FetchMilestones(repo string) ([]Milestone, error) {
+	out, err := ghAPIStream(fmt.Sprintf("repos/%s/milestones?state=all&per_page=100", repo))
+	if err != nil {
+		return nil, err
+	}
+	var milestones []Milestone
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		var m Milestone
+		if err := decoder.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		milestones = append(milestones, m)
+	}
+	return milestones, nil
+}
+
+// FetchMilestoneItems lists the issues and PRs assigned to milestone number, open and closed.
+func FetchMilestoneItems(repo string, number int) ([]MilestoneItem, error) {
+	out, err := ghAPIStream(fmt.Sprintf("repos/%s/issues?milestone=%d&state=all&per_page=100", repo, number))
+	if err != nil {
+		return nil, err
+	}
+	var items []MilestoneItem
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		var item MilestoneItem
+		if err := decoder.Decode(&item); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ghAPIStream pages through a REST list endpoint, returning its elements as a stream of JSON values.
+func ghAPIStream(endpoint string) (io.Reader, error) {
+	cmd := transport.Command("gh", "api", "--paginate", endpoint, "--jq", ".[]")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+	return &stdout, nil
+}
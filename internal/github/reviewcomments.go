@@ -0,0 +1,91 @@
+package github
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ReviewComment is the text a reviewer left on a PR: the body of a submitted review or one of its inline comments.
+type ReviewComment struct {
+	Author    string
+	Body      string
+	State     string // State of the review ("APPROVED", "CHANGES_REQUESTED", "COMMENTED") for review bodies; "" for inline comments
+	CreatedAt time.Time
+}
+
+// FetchReviewComments records the review bodies and inline review comments of each PR (up to 50 reviews
+// with 50 comments each), using batched GraphQL queries. Empty review bodies are skipped.
+func FetchReviewComments(repo string, prs []PullRequest) []PullRequest {
+	numbers := make([]int, len(prs))
+	for i, pr := range prs {
+		numbers[i] = pr.Number
+	}
+
+	comments := make(map[int][]ReviewComment)
+	queryPRBatches(repo, numbers, "review comments", `reviews(first: 50) {
+				nodes {
+					author { login }
+					body
+					state
+					submittedAt
+					comments(first: 50) {
+						nodes { author { login } body createdAt }
+					}
+				}
+			}`, func(repository json.RawMessage) error {
+		type author struct {
+			Login string `json:"login"`
+		}
+		var nodes map[string]struct {
+			Number  int `json:"number"`
+			Reviews struct {
+				Nodes []struct {
+					Author      author    `json:"author"`
+					Body        string    `json:"body"`
+					State       string    `json:"state"`
+					SubmittedAt time.Time `json:"submittedAt"`
+					Comments    struct {
+						Nodes []struct {
+							Author    author    `json:"author"`
+							Body      string    `json:"body"`
+							CreatedAt time.Time `json:"createdAt"`
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"nodes"`
+			} `json:"reviews"`
+		}
+		if err := json.Unmarshal(repository, &nodes); err != nil {
+			return err
+		}
+
+		for _, pr := range nodes {
+			for _, review := range pr.Reviews.Nodes {
+				if strings.TrimSpace(review.Body) != "" {
+					comments[pr.Number] = append(comments[pr.Number], ReviewComment{
+						Author:    review.Author.Login,
+						Body:      review.Body,
+						State:     review.State,
+						CreatedAt: review.SubmittedAt,
+					})
+				}
+				for _, c := range review.Comments.Nodes {
+					comments[pr.Number] = append(comments[pr.Number], ReviewComment{
+						Author:    c.Author.Login,
+						Body:      c.Body,
+						CreatedAt: c.CreatedAt,
+					})
+				}
+			}
+		}
+		return nil
+	})
+
+	for i := range prs {
+		if c, ok := comments[prs[i].Number]; ok {
+			prs[i].ReviewComments = c
+		}
+	}
+
+	return prs
+}
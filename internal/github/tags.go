@@ -0,0 +1,30 @@
+package github
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"visuche/internal/transport"
+)
+
+// FetchTagDate returns the committer date of the commit tag points at.
+func FetchTagDate(repo, tag string) (time.Time, error) {
+	cmd := transport.Command("gh", "api", fmt.Sprintf("repos/%s/commits/%s", repo, url.PathEscape(tag)),
+		"--jq", ".commit.committer.date")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return time.Time{}, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+
+	date, err := time.Parse(time.RFC3339, strings.TrimSpace(stdout.String()))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse the date of tag %s: %w", tag, err)
+	}
+	return date, nil
+}
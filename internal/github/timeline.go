@@ -0,0 +1,137 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/transport"
+)
+
+// Timeline event kinds. All but EventOpened and EventCIRun match the issue timeline API's event names.
+const (
+	EventOpened           = "opened"
+	EventCommitted        = "committed"
+	EventReviewRequested  = "review_requested"
+	EventReviewed         = "reviewed"
+	EventCommented        = "commented"
+	EventReadyForReview   = "ready_for_review"
+	EventConvertedToDraft = "convert_to_draft"
+	EventForcePushed      = "head_ref_force_pushed"
+	EventReopened         = "reopened"
+	EventMerged           = "merged"
+	EventClosed           = "closed"
+	EventCIRun            = "ci_run"
+)
+
+// TimelineEvent is one entry of a PR's timeline.
+type TimelineEvent struct {
+	Time   time.Time `json:"time"`
+	Kind   string    `json:"kind"`
+	Actor  string    `json:"actor"`
+	Detail string    `json:"detail"` // Commit headline, review state, requested reviewer, CI result, ...
+}
+
+// prViewFields are the gh pr view fields that fill a PullRequest, commits included.
+const prViewFields = "number,title,url,body,createdAt,mergedAt,closedAt,state,isDraft,additions,deletions,changedFiles," +
+	"baseRefName,headRefName,isCrossRepository,reviewDecision,mergeable,mergeStateStatus,author,mergedBy,reviews,reviewRequests,labels,commits"
+
+// FetchPullRequest fetches a single PR with its reviews and commits.
+func FetchPullRequest(repo string, number int) (PullRequest, error) {
+	cmd := transport.Command("gh", "pr", "view", fmt.Sprintf("%d", number), "--repo", repo, "--json", prViewFields)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return PullRequest{}, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+
+	var pr PullRequest
+	if err := json.Unmarshal(stdout.Bytes(), &pr); err != nil {
+		return PullRequest{}, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	return processPRs([]PullRequest{pr})[0], nil
+}
+
+// timelineItem is an issue timeline API entry; which fields are set depends on the event.
+type timelineItem struct {
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Actor     struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	User struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	RequestedReviewer struct {
+		Login string `json:"login"`
+	} `json:"requested_reviewer"`
+	RequestedTeam struct {
+		Slug string `json:"slug"`
+	} `json:"requested_team"`
+}
+
+// FetchTimelineEvents fetches the timeline events of a PR that its PullRequest does not carry:
+// review requests, conversation comments, draft changes, force pushes and reopens.
+func FetchTimelineEvents(repo string, number int) ([]TimelineEvent, error) {
+	cmd := transport.Command("gh", "api", "--paginate", fmt.Sprintf("repos/%s/issues/%d/timeline?per_page=100", repo, number),
+		"--jq", ".[]")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gh command failed: %s\n%s", err, stderr.String())
+	}
+
+	var events []TimelineEvent
+	decoder := json.NewDecoder(&stdout)
+	for decoder.More() {
+		var item timelineItem
+		if err := decoder.Decode(&item); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		event := TimelineEvent{Time: item.CreatedAt, Kind: item.Event, Actor: item.Actor.Login}
+		switch item.Event {
+		case EventReviewRequested:
+			event.Detail = item.RequestedReviewer.Login
+			if event.Detail == "" && item.RequestedTeam.Slug != "" {
+				event.Detail = "team:" + item.RequestedTeam.Slug
+			}
+		case EventCommented:
+			event.Actor = item.User.Login
+		case EventReadyForReview, EventConvertedToDraft, EventForcePushed, EventReopened:
+		default:
+			continue // Commits, reviews, merges and closes come from the PR itself
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// BuildTimeline merges the events derivable from pr (opening, commits, reviews, merge or close)
+// with extra events, ordered by time.
+func BuildTimeline(pr PullRequest, extra []TimelineEvent) []TimelineEvent {
+	events := []TimelineEvent{{Time: pr.CreatedAt, Kind: EventOpened, Actor: pr.Author.Login, Detail: pr.Title}}
+	for _, c := range pr.Commits {
+		events = append(events, TimelineEvent{Time: c.CommittedDate, Kind: EventCommitted, Detail: c.MessageHeadline})
+	}
+	for _, r := range pr.Reviews {
+		events = append(events, TimelineEvent{Time: r.SubmittedAt, Kind: EventReviewed, Actor: r.Author.Login, Detail: strings.ToLower(r.State)})
+	}
+	switch {
+	case pr.Merged && !pr.MergedAt.IsZero():
+		events = append(events, TimelineEvent{Time: pr.MergedAt, Kind: EventMerged, Actor: pr.MergedBy.Login, Detail: pr.BaseRefName})
+	case pr.State == "CLOSED" && !pr.ClosedAt.IsZero():
+		events = append(events, TimelineEvent{Time: pr.ClosedAt, Kind: EventClosed})
+	}
+	events = append(events, extra...)
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	return events
+}
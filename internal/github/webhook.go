@@ -0,0 +1,110 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// repoNamePattern matches a GitHub owner or repository name.
+var repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// WebhookEvent is the part of a GitHub webhook payload shared by the events the collector handles.
+type WebhookEvent struct {
+	Action     string `json:"action"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	PullRequest struct {
+		Number int `json:"number"`
+	} `json:"pull_request"`
+	WorkflowRun json.RawMessage `json:"workflow_run"`
+	// Workflow is the workflow file path in workflow_dispatch payloads and the workflow object in
+	// workflow_run payloads; see WorkflowPath.
+	Workflow json.RawMessage        `json:"workflow"`
+	Inputs   map[string]interface{} `json:"inputs"` // workflow_dispatch only
+}
+
+// WorkflowPath returns the path of the workflow file the event is about (".github/workflows/ci.yml"),
+// or an empty string for events that aren't about a workflow.
+func (e WebhookEvent) WorkflowPath() string {
+	var path string
+	if err := json.Unmarshal(e.Workflow, &path); err == nil {
+		return path
+	}
+	var workflow struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(e.Workflow, &workflow); err == nil {
+		return workflow.Path
+	}
+	return ""
+}
+
+// InputValues returns the workflow_dispatch inputs as strings; boolean and number inputs are
+// formatted the way they are written in the workflow file.
+func (e WebhookEvent) InputValues() map[string]string {
+	if len(e.Inputs) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(e.Inputs))
+	for k, v := range e.Inputs {
+		switch v := v.(type) {
+		case string:
+			values[k] = v
+		case nil:
+			values[k] = ""
+		default:
+			values[k] = fmt.Sprint(v)
+		}
+	}
+	return values
+}
+
+// ParseWebhookEvent decodes a webhook payload.
+func ParseWebhookEvent(body []byte) (WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return event, fmt.Errorf("failed to unmarshal webhook payload: %w", err)
+	}
+	if event.Repository.FullName == "" {
+		return event, fmt.Errorf("webhook payload has no repository")
+	}
+	if !ValidFullName(event.Repository.FullName) {
+		return event, fmt.Errorf("webhook payload has an invalid repository %q", event.Repository.FullName)
+	}
+	return event, nil
+}
+
+// ValidFullName reports whether name is exactly owner/repo, both plain GitHub names. The full name
+// of a webhook payload becomes a path in the store, so "." and ".." are rejected too.
+func ValidFullName(name string) bool {
+	parts := strings.Split(name, "/")
+	if len(parts) != 2 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "." || part == ".." || !repoNamePattern.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyWebhookSignature checks the X-Hub-Signature-256 header ("sha256=<hex HMAC of body>") against secret.
+func VerifyWebhookSignature(secret string, body []byte, signature string) error {
+	sum, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil || !strings.HasPrefix(signature, "sha256=") {
+		return fmt.Errorf("missing or malformed X-Hub-Signature-256 header")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return fmt.Errorf("webhook signature does not match the secret")
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/animation"
+	"visuche/internal/github"
+	"visuche/internal/transport"
+)
+
+// mergeRequest mirrors the subset of the GitLab merge request API payload we use.
+type mergeRequest struct {
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	WebURL       string     `json:"web_url"`
+	State        string     `json:"state"` // opened, closed, locked, merged
+	CreatedAt    time.Time  `json:"created_at"`
+	MergedAt     *time.Time `json:"merged_at"`
+	ClosedAt     *time.Time `json:"closed_at"`
+	Draft        bool       `json:"draft"`
+	WorkInProg   bool       `json:"work_in_progress"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	SourceProj   int        `json:"source_project_id"`
+	TargetProj   int        `json:"target_project_id"`
+	MergeCommit  string     `json:"merge_commit_sha"`
+	SquashCommit string     `json:"squash_commit_sha"`
+	Author       struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	MergedBy *struct {
+		Username string `json:"username"`
+	} `json:"merged_by"`
+	MergeUser *struct {
+		Username string `json:"username"`
+	} `json:"merge_user"`
+	Reviewers []struct {
+		Username string `json:"username"`
+	} `json:"reviewers"`
+	Labels []string `json:"labels"`
+}
+
+// pipeline mirrors the subset of the GitLab pipeline API payload we use.
+type pipeline struct {
+	ID        int64     `json:"id"`
+	IID       int       `json:"iid"`
+	Name      string    `json:"name"`
+	Ref       string    `json:"ref"`
+	Status    string    `json:"status"` // success, failed, canceled, skipped, running, pending, ...
+	Source    string    `json:"source"` // push, merge_request_event, schedule, web, ...
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	WebURL    string    `json:"web_url"`
+}
+
+// FetchMergeRequests fetches merge requests from GitLab using glab api and maps them to PullRequests.
+func FetchMergeRequests(project string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	params.Set("scope", "all")
+	if includeOpen {
+		params.Set("state", "all")
+	}
+	if since != "" {
+		params.Set("created_after", since+"T00:00:00Z")
+	}
+	if until != "" {
+		if untilTime, err := time.Parse("2006-01-02", until); err == nil {
+			params.Set("created_before", untilTime.AddDate(0, 0, 1).Format(time.RFC3339))
+		}
+	}
+	if author != "" {
+		params.Set("author_username", author)
+	}
+	if label != "" {
+		params.Set("labels", label)
+	}
+
+	spinner := animation.NewShibaSpinner("Fetching merge requests...", false)
+	spinner.Start()
+	defer spinner.Stop()
+
+	var mrs []mergeRequest
+	endpoint := fmt.Sprintf("projects/%s/merge_requests?%s", url.PathEscape(project), params.Encode())
+	if err := glabAPI(endpoint, &mrs); err != nil {
+		return nil, err
+	}
+
+	prs := make([]github.PullRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		if !includeOpen && mr.State == "opened" {
+			continue
+		}
+		prs = append(prs, toPullRequest(mr))
+	}
+	return prs, nil
+}
+
+// FetchOpenMergeRequests fetches the currently open merge requests with their reviewers.
+func FetchOpenMergeRequests(project string) ([]github.PullRequest, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	params.Set("state", "opened")
+
+	spinner := animation.NewShibaSpinner("Fetching open merge requests...", false)
+	spinner.Start()
+	defer spinner.Stop()
+
+	var mrs []mergeRequest
+	endpoint := fmt.Sprintf("projects/%s/merge_requests?%s", url.PathEscape(project), params.Encode())
+	if err := glabAPI(endpoint, &mrs); err != nil {
+		return nil, err
+	}
+
+	prs := make([]github.PullRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		prs = append(prs, toPullRequest(mr))
+	}
+	return prs, nil
+}
+
+// FetchPipelines fetches CI pipelines from GitLab using glab api and maps them to WorkflowRuns.
+func FetchPipelines(project string, since, until string) ([]actions.WorkflowRun, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if since != "" {
+		params.Set("updated_after", since+"T00:00:00Z")
+	}
+	if until != "" {
+		if untilTime, err := time.Parse("2006-01-02", until); err == nil {
+			params.Set("updated_before", untilTime.AddDate(0, 0, 1).Format(time.RFC3339))
+		}
+	}
+
+	spinner := animation.NewShibaSpinner("Fetching pipelines...", false)
+	spinner.Start()
+	defer spinner.Stop()
+
+	var pipelines []pipeline
+	endpoint := fmt.Sprintf("projects/%s/pipelines?%s", url.PathEscape(project), params.Encode())
+	if err := glabAPI(endpoint, &pipelines); err != nil {
+		return nil, err
+	}
+
+	runs := make([]actions.WorkflowRun, 0, len(pipelines))
+	for _, p := range pipelines {
+		runs = append(runs, toWorkflowRun(p))
+	}
+	return runs, nil
+}
+
+// FetchFailureDetails fills in the first failed job and its stage for the first limit failed pipelines
+// (all of them when limit is 0).
+func FetchFailureDetails(project string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail {
+	if limit <= 0 || limit > len(failures) {
+		limit = len(failures)
+	}
+
+	runByURL := make(map[string]actions.WorkflowRun, len(runs))
+	for _, run := range runs {
+		runByURL[run.URL] = run
+	}
+
+	for i := 0; i < limit; i++ {
+		run, ok := runByURL[failures[i].URL]
+		if !ok {
+			continue
+		}
+
+		var jobs []struct {
+			Name  string `json:"name"`
+			Stage string `json:"stage"`
+		}
+		endpoint := fmt.Sprintf("projects/%s/pipelines/%d/jobs?scope[]=failed", url.PathEscape(project), run.DatabaseId)
+		if err := glabAPI(endpoint, &jobs); err != nil || len(jobs) == 0 {
+			continue
+		}
+		failures[i].FailedJob = jobs[0].Name
+		failures[i].FailedStep = jobs[0].Stage
+	}
+
+	return failures
+}
+
+// FetchLinkedIssues records the issues each merged MR closes, with their creation time.
+func FetchLinkedIssues(project string, prs []github.PullRequest) []github.PullRequest {
+	spinner := animation.NewShibaSpinner("Resolving linked issues...", false)
+	spinner.Start()
+	defer spinner.Stop()
+
+	for i := range prs {
+		if !prs[i].Merged {
+			continue
+		}
+
+		var issues []struct {
+			IID       int       `json:"iid"`
+			ProjectID int       `json:"project_id"`
+			CreatedAt time.Time `json:"created_at"`
+			WebURL    string    `json:"web_url"`
+		}
+		endpoint := fmt.Sprintf("projects/%s/merge_requests/%d/closes_issues", url.PathEscape(project), prs[i].Number)
+		if err := glabAPI(endpoint, &issues); err != nil {
+			continue
+		}
+
+		prs[i].LinkedIssues = nil
+		for _, issue := range issues {
+			prs[i].LinkedIssues = append(prs[i].LinkedIssues, github.LinkedIssue{
+				Repo:      issueProject(issue.WebURL, project),
+				Number:    issue.IID,
+				CreatedAt: issue.CreatedAt,
+			})
+		}
+	}
+
+	return prs
+}
+
+// issueProject extracts the group/project path from an issue URL, falling back to project.
+func issueProject(webURL, project string) string {
+	u, err := url.Parse(webURL)
+	if err != nil {
+		return project
+	}
+	path := strings.TrimPrefix(u.Path, "/")
+	if idx := strings.Index(path, "/-/issues/"); idx > 0 {
+		return path[:idx]
+	}
+	return project
+}
+
+// FetchChangedFiles loads the changed files of each merged MR, counting added/removed lines from the diffs.
+// The diffs endpoint is paged like the other list endpoints, so glabAPI follows every page of 100 files.
+func FetchChangedFiles(project string, prs []github.PullRequest) []github.PullRequest {
+	spinner := animation.NewShibaSpinner("Fetching changed files...", false)
+	spinner.Start()
+	defer spinner.Stop()
+
+	for i := range prs {
+		if !prs[i].Merged || len(prs[i].Files) > 0 {
+			continue
+		}
+
+		var diffs []struct {
+			NewPath string `json:"new_path"`
+			Diff    string `json:"diff"`
+		}
+		endpoint := fmt.Sprintf("projects/%s/merge_requests/%d/diffs?per_page=100", url.PathEscape(project), prs[i].Number)
+		if err := glabAPI(endpoint, &diffs); err != nil {
+			continue
+		}
+
+		for _, d := range diffs {
+			file := github.PRFile{Path: d.NewPath}
+			file.Additions, file.Deletions = countDiffLines(d.Diff)
+			prs[i].Files = append(prs[i].Files, file)
+		}
+	}
+
+	return prs
+}
+
+// countDiffLines counts the added and removed lines of a GitLab file diff. GitLab omits the ---/+++ file
+// headers and starts at the first @@ hunk header, so every +/- line after it is content, including
+// added "++" or removed "--" lines.
+func countDiffLines(diff string) (additions, deletions int) {
+	inHunk := false
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk:
+		case strings.HasPrefix(line, "+"):
+			additions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return additions, deletions
+}
+
+// FetchDefaultBranch returns the project's default branch name.
+func FetchDefaultBranch(project string) (string, error) {
+	cmd := transport.Command("glab", "api", fmt.Sprintf("projects/%s", url.PathEscape(project)))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("glab command failed: %s\n%s", err, stderr.String())
+	}
+
+	var info struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &info); err != nil {
+		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	if info.DefaultBranch == "" {
+		return "", fmt.Errorf("project %s has no default branch", project)
+	}
+	return info.DefaultBranch, nil
+}
+
+// glabAPI runs `glab api --paginate` and decodes the concatenated JSON arrays into out.
+func glabAPI(endpoint string, out interface{}) error {
+	cmd := transport.Command("glab", "api", "--paginate", endpoint)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("glab command failed: %s\n%s", err, stderr.String())
+	}
+
+	// --paginate emits one JSON array per page back to back, so decode them in sequence.
+	var all []json.RawMessage
+	decoder := json.NewDecoder(&stdout)
+	for {
+		var page []json.RawMessage
+		if err := decoder.Decode(&page); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		all = append(all, page...)
+	}
+
+	merged, err := json.Marshal(all)
+	if err != nil {
+		return fmt.Errorf("failed to merge paginated JSON: %w", err)
+	}
+	if err := json.Unmarshal(merged, out); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// toPullRequest maps a GitLab merge request onto the shared PullRequest model.
+func toPullRequest(mr mergeRequest) github.PullRequest {
+	var pr github.PullRequest
+	pr.Number = mr.IID
+	pr.Title = mr.Title
+	pr.URL = mr.WebURL
+	pr.Body = mr.Description
+	pr.CreatedAt = mr.CreatedAt
+	pr.IsDraft = mr.Draft || mr.WorkInProg
+	pr.BaseRefName = mr.TargetBranch
+	pr.HeadRefName = mr.SourceBranch
+	pr.IsCrossRepository = mr.SourceProj != mr.TargetProj
+	pr.Author.Login = mr.Author.Username
+
+	switch mr.State {
+	case "merged":
+		pr.State = "MERGED"
+		pr.Merged = true
+	case "opened":
+		pr.State = "OPEN"
+	default:
+		pr.State = "CLOSED"
+	}
+
+	if mr.MergedAt != nil {
+		pr.MergedAt = *mr.MergedAt
+	}
+	if mr.ClosedAt != nil {
+		pr.ClosedAt = *mr.ClosedAt
+	}
+	if mr.MergeUser != nil {
+		pr.MergedBy.Login = mr.MergeUser.Username
+	} else if mr.MergedBy != nil {
+		pr.MergedBy.Login = mr.MergedBy.Username
+	}
+
+	// GitLab has no separate "requested" state, so assigned reviewers stand in for pending requests
+	for _, reviewer := range mr.Reviewers {
+		pr.RequestedReviewers = append(pr.RequestedReviewers, reviewer.Username)
+		if mr.State == "opened" {
+			pr.ReviewRequests = append(pr.ReviewRequests, github.ReviewRequest{Login: reviewer.Username})
+		}
+	}
+
+	for _, name := range mr.Labels {
+		pr.Labels = append(pr.Labels, github.Label{Name: name})
+	}
+
+	pr.MergeCommit.Oid = mr.MergeCommit
+	if pr.MergeCommit.Oid == "" {
+		pr.MergeCommit.Oid = mr.SquashCommit
+	}
+
+	if pr.Merged && !pr.MergedAt.IsZero() {
+		pr.LeadTime = pr.MergedAt.Sub(pr.CreatedAt)
+	}
+
+	return pr
+}
+
+// toWorkflowRun maps a GitLab pipeline onto the shared WorkflowRun model.
+func toWorkflowRun(p pipeline) actions.WorkflowRun {
+	run := actions.WorkflowRun{
+		Attempt:      1,
+		CreatedAt:    p.CreatedAt,
+		DatabaseId:   p.ID,
+		DisplayTitle: p.Ref,
+		Event:        p.Source,
+		HeadBranch:   p.Ref,
+		Name:         p.Name,
+		Number:       p.IID,
+		StartedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+		WorkflowName: p.Name,
+		URL:          p.WebURL,
+	}
+	if run.WorkflowName == "" {
+		run.WorkflowName = "pipeline"
+	}
+
+	switch strings.ToLower(p.Status) {
+	case "success":
+		run.Status, run.Conclusion = "completed", "success"
+	case "failed":
+		run.Status, run.Conclusion = "completed", "failure"
+	case "canceled":
+		run.Status, run.Conclusion = "completed", "cancelled"
+	case "skipped":
+		run.Status, run.Conclusion = "completed", "skipped"
+	default:
+		run.Status = "in_progress"
+	}
+
+	return run
+}
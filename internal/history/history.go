@@ -0,0 +1,263 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/stats"
+)
+
+// Snapshot is the stats of one run together with the query that produced them.
+type Snapshot struct {
+	RecordedAt time.Time   `json:"recordedAt"`
+	Provider   string      `json:"provider"`
+	Repo       string      `json:"repo"`
+	Since      string      `json:"since,omitempty"`
+	Until      string      `json:"until,omitempty"`
+	Author     string      `json:"author,omitempty"`
+	Label      string      `json:"label,omitempty"`
+	Stats      stats.Stats `json:"stats"`
+}
+
+// PeriodEnd is the last day covered by the snapshot: --until, or the day it was recorded.
+func (s Snapshot) PeriodEnd() time.Time {
+	if t, err := time.Parse("2006-01-02", s.Until); err == nil {
+		return t
+	}
+	y, m, d := s.RecordedAt.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// periodDays is the length of the analyzed window in days, or -1 when it is open-ended (no --since).
+func (s Snapshot) periodDays() int {
+	start, err := time.Parse("2006-01-02", s.Since)
+	if err != nil {
+		return -1
+	}
+	return int(s.PeriodEnd().Sub(start).Hours() / 24)
+}
+
+// Comparable reports whether s and other measure the same thing over windows of the same length.
+func (s Snapshot) Comparable(other Snapshot) bool {
+	return s.Provider == other.Provider &&
+		s.Repo == other.Repo &&
+		s.Author == other.Author &&
+		s.Label == other.Label &&
+		s.periodDays() == other.periodDays()
+}
+
+// Previous returns the most recently recorded snapshot comparable to current whose period ended earlier,
+// so re-running the same window doesn't compare a period against itself.
+func Previous(snapshots []Snapshot, current Snapshot) (Snapshot, bool) {
+	var best Snapshot
+	found := false
+	for _, s := range snapshots {
+		if !s.Comparable(current) || !s.PeriodEnd().Before(current.PeriodEnd()) {
+			continue
+		}
+		if !found || s.RecordedAt.After(best.RecordedAt) {
+			best = s
+			found = true
+		}
+	}
+	return best, found
+}
+
+// Backend persists snapshots.
+type Backend interface {
+	Append(s Snapshot) error
+	Load(repo string) ([]Snapshot, error)
+	Repos() ([]string, error)
+	// Prune deletes the snapshots recorded before cutoff and returns how many were deleted.
+	Prune(cutoff time.Time) (int, error)
+	Close() error
+}
+
+// Backends are the names accepted by Open.
+var Backends = []string{"json", "sqlite", "postgres"}
+
+// Open returns the backend named backend: "json" (or "") keeps JSON lines under dir, "sqlite" and
+// "postgres" use the database at dsn. An empty sqlite dsn selects history.db under dir.
+func Open(backend, dir, dsn string) (Backend, error) {
+	switch backend {
+	case "", "json":
+		return New(dir), nil
+	case "sqlite":
+		if dsn == "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, fmt.Errorf("failed to create history dir: %w", err)
+			}
+			dsn = filepath.Join(dir, "history.db")
+		}
+	}
+	return OpenSQL(backend, dsn)
+}
+
+// Store keeps snapshots as JSON lines, one file per repository.
+type Store struct {
+	Dir string
+}
+
+// New returns a store under dir.
+func New(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// DefaultDir returns the default history location ($XDG_DATA_HOME/visuche/history or ~/.local/share/visuche/history).
+func DefaultDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "visuche", "history")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "visuche-history")
+	}
+	return filepath.Join(home, ".local", "share", "visuche", "history")
+}
+
+// Append records s.
+func (st *Store) Append(s Snapshot) error {
+	if err := os.MkdirAll(st.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create history dir: %w", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(st.path(s.Repo), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// Load returns the snapshots recorded for repo in recording order. Unreadable lines are skipped.
+func (st *Store) Load(repo string) ([]Snapshot, error) {
+	f, err := os.Open(st.path(repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer f.Close()
+
+	var snapshots []Snapshot
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var s Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &s); err == nil {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return snapshots, scanner.Err()
+}
+
+// Repos returns the repositories with recorded runs, most recently recorded first.
+func (st *Store) Repos() ([]string, error) {
+	entries, err := os.ReadDir(st.Dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history dir: %w", err)
+	}
+
+	last := make(map[string]time.Time)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".jsonl") {
+			continue
+		}
+		// File names flatten the repository path, so the name is read back from the snapshots
+		snapshots, err := st.Load(strings.TrimSuffix(entry.Name(), ".jsonl"))
+		if err != nil || len(snapshots) == 0 {
+			continue
+		}
+		latest := snapshots[len(snapshots)-1]
+		if latest.RecordedAt.After(last[latest.Repo]) {
+			last[latest.Repo] = latest.RecordedAt
+		}
+	}
+
+	repos := make([]string, 0, len(last))
+	for repo := range last {
+		repos = append(repos, repo)
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		if !last[repos[i]].Equal(last[repos[j]]) {
+			return last[repos[i]].After(last[repos[j]])
+		}
+		return repos[i] < repos[j]
+	})
+	return repos, nil
+}
+
+// Prune rewrites every history file without the snapshots recorded before cutoff. Files left empty are removed.
+func (st *Store) Prune(cutoff time.Time) (int, error) {
+	entries, err := os.ReadDir(st.Dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read history dir: %w", err)
+	}
+
+	pruned := 0
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".jsonl") {
+			continue
+		}
+		snapshots, err := st.Load(strings.TrimSuffix(entry.Name(), ".jsonl"))
+		if err != nil {
+			return pruned, err
+		}
+		var kept bytes.Buffer
+		removed := 0
+		for _, s := range snapshots {
+			if s.RecordedAt.Before(cutoff) {
+				removed++
+				continue
+			}
+			data, err := json.Marshal(s)
+			if err != nil {
+				return pruned, err
+			}
+			kept.Write(append(data, '\n'))
+		}
+		if removed == 0 {
+			continue
+		}
+		path := filepath.Join(st.Dir, entry.Name())
+		if kept.Len() == 0 {
+			err = os.Remove(path)
+		} else {
+			err = os.WriteFile(path, kept.Bytes(), 0o644)
+		}
+		if err != nil {
+			return pruned, fmt.Errorf("failed to rewrite history file: %w", err)
+		}
+		pruned += removed
+	}
+	return pruned, nil
+}
+
+// Close does nothing; every call opens and closes its own file.
+func (st *Store) Close() error {
+	return nil
+}
+
+func (st *Store) path(repo string) string {
+	return filepath.Join(st.Dir, strings.ReplaceAll(repo, "/", "-")+".jsonl")
+}
@@ -0,0 +1,141 @@
+package history
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq" // postgres driver
+)
+
+// dialect is what differs between the SQL databases the history can live in.
+type dialect struct {
+	driver string
+	schema []string
+	// placeholder returns the n-th (1-based) bind parameter.
+	placeholder func(n int) string
+}
+
+// dialects are the SQL backends compiled in; sqlite is added by sql_sqlite.go in cgo builds only.
+var dialects = map[string]dialect{
+	"postgres": {
+		driver: "postgres",
+		schema: []string{
+			`CREATE TABLE IF NOT EXISTS visuche_snapshots (
+				id BIGSERIAL PRIMARY KEY,
+				repo TEXT NOT NULL,
+				recorded_at TIMESTAMPTZ NOT NULL,
+				data JSONB NOT NULL
+			)`,
+			`CREATE INDEX IF NOT EXISTS visuche_snapshots_repo ON visuche_snapshots (repo, id)`,
+		},
+		placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	},
+}
+
+// SQLStore keeps snapshots in the visuche_snapshots table of a SQLite or PostgreSQL database, so
+// several machines can share one history. Each row holds the repository, the recording time and
+// the snapshot as JSON.
+type SQLStore struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+// OpenSQL connects to the database (backend "sqlite" or "postgres") at dsn and creates the table if needed.
+func OpenSQL(backend, dsn string) (*SQLStore, error) {
+	d, ok := dialects[backend]
+	if !ok && backend == "sqlite" {
+		return nil, fmt.Errorf("this visuche binary was built without cgo, which the sqlite history backend needs; rebuild with CGO_ENABLED=1 or use json or postgres")
+	}
+	if !ok {
+		return nil, fmt.Errorf("unsupported history backend %q (use %s)", backend, strings.Join(Backends, ", "))
+	}
+	if dsn == "" {
+		return nil, fmt.Errorf("the %s history backend requires a dsn", backend)
+	}
+	db, err := sql.Open(d.driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database: %w", err)
+	}
+	for _, stmt := range d.schema {
+		if _, err := db.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to prepare history database: %w", err)
+		}
+	}
+	return &SQLStore{db: db, dialect: d}, nil
+}
+
+// Append records s.
+func (st *SQLStore) Append(s Snapshot) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	p := st.dialect.placeholder
+	query := fmt.Sprintf("INSERT INTO visuche_snapshots (repo, recorded_at, data) VALUES (%s, %s, %s)", p(1), p(2), p(3))
+	if _, err := st.db.Exec(query, s.Repo, s.RecordedAt.UTC(), string(data)); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// Load returns the snapshots recorded for repo in recording order. Unreadable rows are skipped.
+func (st *SQLStore) Load(repo string) ([]Snapshot, error) {
+	query := fmt.Sprintf("SELECT data FROM visuche_snapshots WHERE repo = %s ORDER BY id", st.dialect.placeholder(1))
+	rows, err := st.db.Query(query, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []Snapshot
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to read history: %w", err)
+		}
+		var s Snapshot
+		if err := json.Unmarshal([]byte(data), &s); err == nil {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return snapshots, rows.Err()
+}
+
+// Repos returns the repositories with recorded runs, most recently recorded first.
+func (st *SQLStore) Repos() ([]string, error) {
+	rows, err := st.db.Query("SELECT repo FROM visuche_snapshots GROUP BY repo ORDER BY MAX(id) DESC, repo")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer rows.Close()
+
+	var repos []string
+	for rows.Next() {
+		var repo string
+		if err := rows.Scan(&repo); err != nil {
+			return nil, fmt.Errorf("failed to read history: %w", err)
+		}
+		repos = append(repos, repo)
+	}
+	return repos, rows.Err()
+}
+
+// Prune deletes the snapshots recorded before cutoff.
+func (st *SQLStore) Prune(cutoff time.Time) (int, error) {
+	query := fmt.Sprintf("DELETE FROM visuche_snapshots WHERE recorded_at < %s", st.dialect.placeholder(1))
+	result, err := st.db.Exec(query, cutoff.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune history: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
+
+// Close closes the database connection.
+func (st *SQLStore) Close() error {
+	return st.db.Close()
+}
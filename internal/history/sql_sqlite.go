@@ -0,0 +1,23 @@
+//go:build cgo
+
+package history
+
+import (
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver (requires a cgo build)
+)
+
+func init() {
+	dialects["sqlite"] = dialect{
+		driver: "sqlite3",
+		schema: []string{
+			`CREATE TABLE IF NOT EXISTS visuche_snapshots (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				repo TEXT NOT NULL,
+				recorded_at TIMESTAMP NOT NULL,
+				data TEXT NOT NULL
+			)`,
+			`CREATE INDEX IF NOT EXISTS visuche_snapshots_repo ON visuche_snapshots (repo, id)`,
+		},
+		placeholder: func(int) string { return "?" },
+	}
+}
@@ -36,6 +36,9 @@ var translations = map[string]map[string]string{
 	"Releases (main/master merges)": {
 		"jp": "リリース回数（main/masterへのマージ）",
 	},
+	"Releases (%s merges)": {
+		"jp": "リリース回数（%sへのマージ）",
+	},
 	"Reopened PRs": {
 		"jp": "再オープンPR",
 	},
@@ -48,9 +51,6 @@ var translations = map[string]map[string]string{
 	"Hotfix Merges": {
 		"jp": "Hotfixマージ数",
 	},
-	"Hotfix→Release Gap (avg/median)": {
-		"jp": "Hotfixと直近リリースの間隔（平均/中央値）",
-	},
 	"Hotfix w/o prior release": {
 		"jp": "直近リリースなしのHotfix",
 	},
@@ -105,6 +105,399 @@ var translations = map[string]map[string]string{
 	"Lead Time": {
 		"jp": "リードタイム",
 	},
+	"🔄 Cycle Time Stages:": {
+		"jp": "🔄 サイクルタイム内訳:",
+	},
+	"Stage": {
+		"jp": "ステージ",
+	},
+	"Coding (first commit→open)": {
+		"jp": "コーディング（初回コミット→PR作成）",
+	},
+	"Pickup (open→first review)": {
+		"jp": "ピックアップ（PR作成→初回レビュー）",
+	},
+	"Review (first review→approval)": {
+		"jp": "レビュー（初回レビュー→承認）",
+	},
+	"Merge (approval→merge)": {
+		"jp": "マージ（承認→マージ）",
+	},
+	"⏰ Review Response SLA:": {
+		"jp": "⏰ レビュー応答SLA:",
+	},
+	"Target: first review within %s": {
+		"jp": "目標: %s以内に初回レビュー",
+	},
+	"%s (business hours)": {
+		"jp": "%s（営業時間）",
+	},
+	"No PRs to evaluate in this period": {
+		"jp": "この期間に評価対象のPRはありません",
+	},
+	"PRs Evaluated": {
+		"jp": "評価対象PR",
+	},
+	"Evaluated": {
+		"jp": "評価数",
+	},
+	"Within SLA": {
+		"jp": "SLA達成",
+	},
+	"SLA Attainment": {
+		"jp": "SLA達成率",
+	},
+	"📅 SLA by Week:": {
+		"jp": "📅 週別SLA:",
+	},
+	"👤 SLA by Reviewer:": {
+		"jp": "👤 レビュワー別SLA:",
+	},
+	"🚨 SLA Violations:": {
+		"jp": "🚨 SLA違反PR:",
+	},
+	"Week": {
+		"jp": "週",
+	},
+	"Reviewer": {
+		"jp": "レビュワー",
+	},
+	"Title": {
+		"jp": "タイトル",
+	},
+	"Author": {
+		"jp": "作成者",
+	},
+	"Created": {
+		"jp": "作成日時",
+	},
+	"Wait": {
+		"jp": "待ち時間",
+	},
+	"(awaiting review)": {
+		"jp": "（レビュー待ち）",
+	},
+	"... and %d more violations\n": {
+		"jp": "... 他 %d 件の違反\n",
+	},
+	"Approval→Merge (automated)": {
+		"jp": "承認→マージ（自動マージ）",
+	},
+	"Auto-merged PRs": {
+		"jp": "自動マージPR",
+	},
+	"Bot-merged PRs": {
+		"jp": "Botによるマージ",
+	},
+	"Automated Merge Rate": {
+		"jp": "自動マージ率",
+	},
+	"Failed to fetch pull requests": {
+		"jp": "プルリクエストの取得に失敗しました",
+	},
+	"Failed to fetch workflow runs": {
+		"jp": "ワークフロー実行の取得に失敗しました",
+	},
+	"🔄 Next refresh in %d min (Ctrl+C to exit)": {
+		"jp": "🔄 %d 分後に更新します（Ctrl+Cで終了）",
+	},
+	"📺 visuche watch: %s  (%s)": {
+		"jp": "📺 visuche watch: %s  (%s)",
+	},
+	"Open PRs": {
+		"jp": "オープンPR",
+	},
+	"Awaiting Review": {
+		"jp": "レビュー待ち",
+	},
+	"Changes Requested": {
+		"jp": "修正依頼",
+	},
+	"Approved": {
+		"jp": "承認済み",
+	},
+	"Draft": {
+		"jp": "ドラフト",
+	},
+	"🔧 CI (last %dh): %d runs, %d failed, %d in progress": {
+		"jp": "🔧 CI（直近%d時間）: 実行 %d 件、失敗 %d 件、実行中 %d 件",
+	},
+	"Status": {
+		"jp": "ステータス",
+	},
+	"Branch": {
+		"jp": "ブランチ",
+	},
+	"Started": {
+		"jp": "開始",
+	},
+	" ago": {
+		"jp": " 前",
+	},
+	"⏳ Stuck PRs (waiting > %s):": {
+		"jp": "⏳ 滞留中のPR（%s 超の待ち）:",
+	},
+	"📋 Review Queue: %s": {
+		"jp": "📋 レビュー待ちキュー: %s",
+	},
+	"🎉 No open PRs waiting for review": {
+		"jp": "🎉 レビュー待ちのPRはありません",
+	},
+	"(no reviewer requested)": {
+		"jp": "（レビュアー未指定）",
+	},
+	"PR": {
+		"jp": "PR",
+	},
+	"Waiting": {
+		"jp": "待ち時間",
+	},
+	"Age": {
+		"jp": "経過時間",
+	},
+	"Size": {
+		"jp": "サイズ",
+	},
+	"💡 Waiting = time since the last review, or since the PR was opened when nobody has reviewed it yet.": {
+		"jp": "💡 待ち時間 = 最後のレビューからの時間（未レビューの場合はPR作成からの時間）です。",
+	},
+	"♻️  Rework (changed again within %d weeks):": {
+		"jp": "♻️  手戻り（%d週間以内の再変更）:",
+	},
+	"Rework Rate (lines)": {
+		"jp": "手戻り率（行数）",
+	},
+	"PRs with Reworked Files": {
+		"jp": "手戻りファイルを含むPR",
+	},
+	"File": {
+		"jp": "ファイル",
+	},
+	"Reworks": {
+		"jp": "再変更回数",
+	},
+	"💡 File-level approximation: any later change to a file counts all earlier lines in it. PRs merged near the end of the period have less time to be reworked.": {
+		"jp": "💡 ファイル単位の近似値です。後続の変更があったファイルは以前の変更行をすべて手戻りとして数えます。期間末にマージされたPRは手戻りを観測できる期間が短くなります。",
+	},
+	"🚌 Bus Factor by Directory:": {
+		"jp": "🚌 ディレクトリ別バス係数:",
+	},
+	"No changed-file data available for merged PRs": {
+		"jp": "マージ済みPRの変更ファイル情報がありません",
+	},
+	"Directory": {
+		"jp": "ディレクトリ",
+	},
+	"Bus Factor": {
+		"jp": "バス係数",
+	},
+	"Top Author (share)": {
+		"jp": "最多作成者（割合）",
+	},
+	"Contributors": {
+		"jp": "貢献者数",
+	},
+	"Changed Lines": {
+		"jp": "変更行数",
+	},
+	"⚠️  %d of %d directories have bus factor 1 (one author wrote more than half of the changes)": {
+		"jp": "⚠️  %d / %d ディレクトリのバス係数が1です（1人が変更の過半を作成）",
+	},
+	"🤝 Pairing & Co-authorship:": {
+		"jp": "🤝 ペアプロ・共同作成:",
+	},
+	"Co-authored PRs": {
+		"jp": "共同作成PR",
+	},
+	"Pairing (2 people)": {
+		"jp": "ペア（2人）",
+	},
+	"Mobbing (3+ people)": {
+		"jp": "モブ（3人以上）",
+	},
+	"Time to First Review (median)": {
+		"jp": "初回レビューまで（中央値）",
+	},
+	"Lead Time (median)": {
+		"jp": "リードタイム（中央値）",
+	},
+	"Reviews/PR": {
+		"jp": "レビュー数/PR",
+	},
+	"Co-authored": {
+		"jp": "共同作成",
+	},
+	"Solo": {
+		"jp": "単独",
+	},
+	"Most frequent co-authors:": {
+		"jp": "共同作成者（多い順）:",
+	},
+	"  %s: %d PRs\n": {
+		"jp": "  %s: %d 件のPR\n",
+	},
+	"👥 Metrics by Team:": {
+		"jp": "👥 チーム別メトリクス:",
+	},
+	"Team": {
+		"jp": "チーム",
+	},
+	"PRs (merged)": {
+		"jp": "PR数（マージ済み）",
+	},
+	"Lead Time (avg/median)": {
+		"jp": "リードタイム（平均/中央値）",
+	},
+	"First Review (median)": {
+		"jp": "初回レビュー（中央値）",
+	},
+	"Review Stage (median)": {
+		"jp": "レビュー段階（中央値）",
+	},
+	"Approval→Merge (median)": {
+		"jp": "承認→マージ（中央値）",
+	},
+	"Comments/PR": {
+		"jp": "コメント数/PR",
+	},
+	"Self-merge": {
+		"jp": "セルフマージ",
+	},
+	"Reviews Given (PRs)": {
+		"jp": "実施レビュー数（PR数）",
+	},
+	"(unassigned)": {
+		"jp": "（未割り当て）",
+	},
+	"🎫 Jira Lead Time:": {
+		"jp": "🎫 Jiraリードタイム:",
+	},
+	"Merged PRs with Jira Issue": {
+		"jp": "Jira課題に紐づくマージ済みPR",
+	},
+	"Created→In Progress (avg/median)": {
+		"jp": "作成→着手（平均/中央値）",
+	},
+	"In Progress→Merge (avg/median)": {
+		"jp": "着手→マージ（平均/中央値）",
+	},
+	"Issue→Production (avg/median)": {
+		"jp": "課題→本番（平均/中央値）",
+	},
+	"💡 Keys are read from PR titles and branch names; production = merge into the default branch.": {
+		"jp": "💡 課題キーはPRタイトルとブランチ名から抽出します。本番 = デフォルトブランチへのマージです。",
+	},
+	"🔗 Issue Traceability:": {
+		"jp": "🔗 Issueトレーサビリティ:",
+	},
+	"Merged PRs Linked to an Issue": {
+		"jp": "Issueに紐づくマージ済みPR",
+	},
+	"Issue→PR (avg/median)": {
+		"jp": "Issue→PR（平均/中央値）",
+	},
+	"PR→Merge (avg/median)": {
+		"jp": "PR→マージ（平均/中央値）",
+	},
+	"Issue→Merge (avg/median)": {
+		"jp": "Issue→マージ（平均/中央値）",
+	},
+	"📝 PR Description Quality:": {
+		"jp": "📝 PR説明文の品質:",
+	},
+	"Average Description Length": {
+		"jp": "説明文の平均文字数",
+	},
+	"%.0f chars": {
+		"jp": "%.0f 文字",
+	},
+	"PRs with Linked Issue": {
+		"jp": "Issue連携ありのPR",
+	},
+	"PRs with Checklist": {
+		"jp": "チェックリストありのPR",
+	},
+	"Checklist Items Checked": {
+		"jp": "チェック済み項目の割合",
+	},
+	"Length ↔ Time to Approval (r)": {
+		"jp": "文字数 ↔ 承認までの時間 (r)",
+	},
+	"Length ↔ Comments (r)": {
+		"jp": "文字数 ↔ コメント数 (r)",
+	},
+	"Description": {
+		"jp": "説明文",
+	},
+	"Median Time to Approval": {
+		"jp": "承認までの時間（中央値）",
+	},
+	"Comments (avg/median)": {
+		"jp": "コメント数（平均/中央値）",
+	},
+	"Short description": {
+		"jp": "短い説明文",
+	},
+	"Medium description": {
+		"jp": "中程度の説明文",
+	},
+	"Long description": {
+		"jp": "長い説明文",
+	},
+	"Linked issue": {
+		"jp": "Issue連携あり",
+	},
+	"No linked issue": {
+		"jp": "Issue連携なし",
+	},
+	"Checklist complete": {
+		"jp": "チェックリスト完了",
+	},
+	"Checklist incomplete": {
+		"jp": "チェックリスト未完了",
+	},
+	"No checklist": {
+		"jp": "チェックリストなし",
+	},
+	"💡 Length buckets: short < %d chars, long ≥ %d chars. r < 0 means longer descriptions go with faster reviews / fewer comments.": {
+		"jp": "💡 文字数区分: 短い < %d 文字、長い ≥ %d 文字。r < 0 は説明文が長いほどレビューが速い／コメントが少ない傾向を示します。",
+	},
+	"🤖 Dependency Update PRs:": {
+		"jp": "🤖 依存関係更新PR:",
+	},
+	"Opened": {
+		"jp": "作成数",
+	},
+	"Merged": {
+		"jp": "マージ数",
+	},
+	"Auto-merged": {
+		"jp": "自動マージ",
+	},
+	"Closed without merge": {
+		"jp": "未マージでクローズ",
+	},
+	"Still open": {
+		"jp": "オープン中",
+	},
+	"Stuck open (>%dd)": {
+		"jp": "滞留中（%d日超）",
+	},
+	"Stuck": {
+		"jp": "滞留",
+	},
+	"Time to Merge (avg/median)": {
+		"jp": "マージまでの時間（平均/中央値）",
+	},
+	"Tool": {
+		"jp": "ツール",
+	},
+	"⏳ Stuck dependency PRs:": {
+		"jp": "⏳ 滞留中の依存関係更新PR:",
+	},
+	"... and %d more\n": {
+		"jp": "... 他 %d 件\n",
+	},
 	"Commit→PR Time": {
 		"jp": "コミット→PR時間",
 	},
@@ -258,35 +651,1055 @@ var translations = map[string]map[string]string{
 	"Event": {
 		"jp": "イベント",
 	},
-	"❌ Failure Analysis:": {
-		"jp": "❌ 失敗解析:",
+	"❌ Recurring Failures:": {
+		"jp": "❌ 繰り返し発生している失敗:",
+	},
+	"all time": {
+		"jp": "全期間",
+	},
+	"since %s": {
+		"jp": "%s 以降",
+	},
+	"until %s": {
+		"jp": "%s まで",
+	},
+	"visuche report: %s": {
+		"jp": "visuche レポート: %s",
+	},
+	"Releases / week": {
+		"jp": "リリース数/週",
+	},
+	"Current": {
+		"jp": "今回",
+	},
+	"Previous": {
+		"jp": "前回",
+	},
+	"Change": {
+		"jp": "変化",
+	},
+	"new": {
+		"jp": "新規",
+	},
+	"better": {
+		"jp": "改善",
+	},
+	"worse": {
+		"jp": "悪化",
+	},
+	"📈 Changes vs last run (%s):": {
+		"jp": "📈 前回実行からの変化 (%s):",
+	},
+	"🗑️  Closed Without Merge:": {
+		"jp": "🗑️  マージされずにクローズされたPR:",
+	},
+	"Abandon Rate": {
+		"jp": "放棄率",
+	},
+	"Time Open (avg/median)": {
+		"jp": "オープン期間（平均/中央値）",
+	},
+	"Top Authors": {
+		"jp": "上位の作成者",
+	},
+	"Top Labels": {
+		"jp": "上位のラベル",
+	},
+	"🚧 Concurrently Open PRs (WIP):": {
+		"jp": "🚧 同時オープンPR数 (WIP):",
+	},
+	"Open PRs per day (avg)": {
+		"jp": "1日あたりのオープンPR数（平均）",
+	},
+	"Peak open PRs": {
+		"jp": "オープンPR数のピーク",
+	},
+	"Open PRs per author (avg)": {
+		"jp": "作成者あたりのオープンPR数（平均）",
+	},
+	"Open at once (avg)": {
+		"jp": "同時オープン数（平均）",
+	},
+	"Peak": {
+		"jp": "ピーク",
+	},
+	"Days with open PRs": {
+		"jp": "オープンPRがあった日数",
+	},
+	"Open PRs at the end of each day (UTC):": {
+		"jp": "各日の終わり時点のオープンPR数 (UTC):",
+	},
+	"📏 Review Depth by PR Size (merged PRs):": {
+		"jp": "📏 PRサイズ別のレビューの深さ（マージ済みPR）:",
+	},
+	"Review Comments (avg)": {
+		"jp": "レビューコメント（平均）",
+	},
+	"Review Rounds (avg)": {
+		"jp": "レビューラウンド（平均）",
+	},
+	"Time to Approval (avg)": {
+		"jp": "承認までの時間（平均）",
+	},
+	"Later Revert/Hotfix": {
+		"jp": "後続のリバート/ホットフィックス",
+	},
+	"Split at the %d-line guideline:": {
+		"jp": "%d 行のガイドラインで分割:",
+	},
+	"Correlation with changed lines (r): review comments %.2f, review rounds %.2f, later revert/hotfix %s": {
+		"jp": "変更行数との相関 (r): レビューコメント %.2f、レビューラウンド %.2f、後続のリバート/ホットフィックス %s",
+	},
+	"Sizes: XS <10, S <100, M <500, L <1000, XL ≥1000 changed lines. Rounds = change requests + 1.": {
+		"jp": "サイズ: XS <10、S <100、M <500、L <1000、XL ≥1000 変更行。ラウンド = 変更要求数 + 1。",
+	},
+	"👥 Time to Approval by Requested Reviewers (merged PRs):": {
+		"jp": "👥 レビュー依頼人数別の承認までの時間（マージ済みPR）:",
+	},
+	"Requested Reviewers": {
+		"jp": "レビュー依頼人数",
+	},
+	"Time to Approval (avg/median)": {
+		"jp": "承認までの時間（平均/中央値）",
+	},
+	"Lead Time (avg)": {
+		"jp": "リードタイム（平均）",
+	},
+	"Requested reviewers include teams and requests that were later fulfilled or removed.": {
+		"jp": "レビュー依頼にはチームや、後で完了・取り消しされた依頼も含まれます。",
+	},
+	"🩺 visuche doctor": {
+		"jp": "🩺 visuche doctor",
+	},
+	"CLI installed": {
+		"jp": "CLIのインストール",
+	},
+	"Authentication": {
+		"jp": "認証",
+	},
+	"Token scopes": {
+		"jp": "トークンのスコープ",
+	},
+	"Git remote": {
+		"jp": "Gitリモート",
+	},
+	"API reachability": {
+		"jp": "APIへの接続",
+	},
+	"Repository access": {
+		"jp": "リポジトリへのアクセス",
+	},
+	"Fixture directory": {
+		"jp": "フィクスチャディレクトリ",
+	},
+	"%d of %d checks failed\n": {
+		"jp": "%d / %d 件のチェックが失敗しました\n",
+	},
+	"All checks passed": {
+		"jp": "すべてのチェックに合格しました",
+	},
+	"👥 Metrics by Author:": {
+		"jp": "👥 作成者別メトリクス:",
+	},
+	"🔗 PR × CI Cross Metrics:": {
+		"jp": "🔗 PR × CI クロス指標:",
+	},
+	"No merged PRs in this period": {
+		"jp": "この期間にマージされたPRはありません",
+	},
+	"CI Minutes (total)": {
+		"jp": "CI実行時間（分・合計）",
+	},
+	"CI Minutes per Merged PR": {
+		"jp": "マージ済みPRあたりのCI実行時間（分）",
+	},
+	"CI Runs per Merged PR": {
+		"jp": "マージ済みPRあたりのCI実行数",
+	},
+	"Failed Runs per Merged PR": {
+		"jp": "マージ済みPRあたりの失敗実行数",
+	},
+	"PR-triggered Runs": {
+		"jp": "PRトリガーの実行",
+	},
+	"💡 CI minutes are wall-clock run durations, not billed runner minutes.": {
+		"jp": "💡 CI実行時間は実行の経過時間であり、課金対象のランナー時間ではありません。",
+	},
+	"⚠️  The period spans %d days (more than maxRangeDays %d).\n": {
+		"jp": "⚠️  期間が %d 日あります（maxRangeDays %d を超えています）。\n",
+	},
+	"   %d PRs in the period, roughly %d API calls. Narrow --since/--until if you hit rate limits.\n": {
+		"jp": "   期間内のPRは %d 件で、API呼び出しはおよそ %d 回です。レート制限にかかる場合は --since/--until を狭めてください。\n",
+	},
+	"⏱️  visuche bench": {
+		"jp": "⏱️  visuche ベンチマーク",
+	},
+	"Min": {
+		"jp": "最小",
+	},
+	"Avg": {
+		"jp": "平均",
+	},
+	"Alloc/iter": {
+		"jp": "割り当て/回",
+	},
+	"Fetch": {
+		"jp": "取得",
+	},
+	"Enrichment": {
+		"jp": "補完",
+	},
+	"Stats": {
+		"jp": "統計",
+	},
+	"%d PRs from %s (scale %d), %d iterations\n": {
+		"jp": "%[2]s の PR %[1]d 件 (倍率 %[3]d)、%[4]d 回\n",
+	},
+	"Total per iteration: %s\n": {
+		"jp": "1 回あたりの合計: %s\n",
+	},
+	"📄 CPU profile written to %s\n": {
+		"jp": "📄 CPU プロファイルを %s に書き出しました\n",
+	},
+	"📄 Heap profile written to %s\n": {
+		"jp": "📄 ヒーププロファイルを %s に書き出しました\n",
+	},
+	"🎬 visuche demo": {
+		"jp": "🎬 visuche デモ",
+	},
+	"Generated %d PRs and %d workflow runs for the fictional repository %s (seed %d)\n": {
+		"jp": "架空のリポジトリ %[3]s 向けに PR %[1]d 件とワークフロー実行 %[2]d 件を生成しました (シード %[4]d)\n",
+	},
+	"📁 Fixtures written to %s\n": {
+		"jp": "📁 フィクスチャを %s に書き出しました\n",
+	},
+	"🚦 Default Branch Red Time (%s):": {
+		"jp": "🚦 デフォルトブランチの Red 時間 (%s):",
+	},
+	"No completed runs on the default branch in this period": {
+		"jp": "この期間にデフォルトブランチで完了した実行はありません",
+	},
+	"Red Time": {
+		"jp": "Red 時間",
+	},
+	"Red Intervals": {
+		"jp": "Red 区間数",
+	},
+	"Workflows on Branch": {
+		"jp": "ブランチ上のワークフロー数",
+	},
+	"Longest red intervals:": {
+		"jp": "最も長い Red 区間:",
+	},
+	"Broken At": {
+		"jp": "壊れた日時",
+	},
+	"Red For": {
+		"jp": "Red 継続時間",
+	},
+	"Breaking Run": {
+		"jp": "壊した実行",
+	},
+	"(still red)": {
+		"jp": "(継続中)",
+	},
+	"💡 A workflow is red from the completion of a failing run until its next successful run; cancelled runs are ignored.": {
+		"jp": "💡 失敗した実行の完了から次に成功した実行までをそのワークフローの Red 時間とします。キャンセルされた実行は無視します。",
+	},
+	"🐢 Slowest Steps (total time):": {
+		"jp": "🐢 最も時間を使ったステップ (合計時間):",
+	},
+	"No step timings available for these runs": {
+		"jp": "これらの実行にはステップの時間情報がありません",
+	},
+	"Job": {
+		"jp": "ジョブ",
+	},
+	"Step": {
+		"jp": "ステップ",
+	},
+	"Total Minutes": {
+		"jp": "合計 (分)",
+	},
+	"... and %d more steps\n": {
+		"jp": "... ほか %d ステップ\n",
+	},
+	"🧹 Workflow Hygiene:": {
+		"jp": "🧹 ワークフローの健全性:",
+	},
+	"yes": {
+		"jp": "あり",
+	},
+	"no": {
+		"jp": "なし",
+	},
+	"Concurrency": {
+		"jp": "同時実行制御",
+	},
+	"Jobs without Timeout": {
+		"jp": "タイムアウト未設定ジョブ",
+	},
+	"Unpinned Actions": {
+		"jp": "未固定のアクション",
+	},
+	"invalid YAML": {
+		"jp": "YAMLが不正",
+	},
+	"Not run in this period (remove, or check the triggers):": {
+		"jp": "期間中に実行されていません (削除するか、トリガーを確認してください):",
+	},
+	"Jobs without timeout-minutes (GitHub's default is 6 hours):": {
+		"jp": "timeout-minutes 未設定のジョブ (GitHubのデフォルトは6時間):",
+	},
+	"Actions pinned to a tag or branch (can change under you; pin a commit SHA):": {
+		"jp": "タグやブランチで指定されたアクション (内容が変わる可能性があります。コミットSHAで固定してください):",
+	},
+	"%d of %d workflows have hygiene issues\n": {
+		"jp": "%d / %d 件のワークフローに問題があります\n",
+	},
+	"📦 Artifact Storage:": {
+		"jp": "📦 アーティファクトのストレージ:",
+	},
+	"No artifacts uploaded by these runs": {
+		"jp": "これらの実行でアップロードされたアーティファクトはありません",
+	},
+	"Artifacts": {
+		"jp": "アーティファクト",
+	},
+	"Total Size": {
+		"jp": "合計サイズ",
+	},
+	"Per Run": {
+		"jp": "実行あたり",
+	},
+	"Largest Artifact": {
+		"jp": "最大のアーティファクト",
+	},
+	"Total: %d artifacts, %s\n": {
+		"jp": "合計: %d 件のアーティファクト、%s\n",
+	},
+	"⚠️  %s uploads %s per run, %.0fx the median run (%s); check what it uploads and its retention-days\n": {
+		"jp": "⚠️  %s は実行あたり %s をアップロードしています (実行の中央値の %.0f 倍、中央値 %s)。アップロード内容と retention-days を確認してください\n",
+	},
+	"🛑 Cancelled Runs:": {
+		"jp": "🛑 キャンセルされた実行:",
+	},
+	"Superseded": {
+		"jp": "後続実行で置換",
+	},
+	"Manual": {
+		"jp": "手動",
+	},
+	"Cancelled Late": {
+		"jp": "遅いキャンセル",
+	},
+	"Minutes Saved": {
+		"jp": "節約した分数",
+	},
+	"Minutes Wasted": {
+		"jp": "無駄になった分数",
+	},
+	"Total": {
+		"jp": "合計",
+	},
+	"Cancelled late:": {
+		"jp": "遅れてキャンセルされた実行:",
+	},
+	"Reason": {
+		"jp": "理由",
+	},
+	"Ran For": {
+		"jp": "実行時間",
+	},
+	"Typical": {
+		"jp": "通常",
+	},
+	"manual": {
+		"jp": "手動",
+	},
+	"superseded": {
+		"jp": "置換",
+	},
+	"💡 A run counts as superseded when a newer run of the same workflow, branch and event started before it was cancelled; late means it had used at least half of the workflow's median duration.": {
+		"jp": "💡 同じワークフロー・ブランチ・イベントの新しい実行がキャンセル前に開始された場合は「置換」とみなします。「遅い」は、ワークフローの実行時間の中央値の半分以上を消費していたことを意味します。",
+	},
+	"⏱️  Event-to-Start Latency:": {
+		"jp": "⏱️  イベントから開始までの待ち時間:",
+	},
+	"No runs to measure": {
+		"jp": "計測できる実行がありません",
+	},
+	"Dispatch Median": {
+		"jp": "ディスパッチ中央値",
+	},
+	"Dispatch P90": {
+		"jp": "ディスパッチP90",
+	},
+	"Dispatch Max": {
+		"jp": "ディスパッチ最大",
+	},
+	"Queue Median": {
+		"jp": "キュー中央値",
+	},
+	"Queue P90": {
+		"jp": "キューP90",
+	},
+	"Queue Max": {
+		"jp": "キュー最大",
+	},
+	"💡 Dispatch is the delay from the cron tick to the run being created (only derivable for scheduled runs); queue is the wait from the run being created until a runner picked up its first job.": {
+		"jp": "💡 ディスパッチは cron の予定時刻から実行が作成されるまでの遅延です (スケジュール実行のみ算出可能)。キューは実行の作成からランナーが最初のジョブを開始するまでの待ち時間です。",
+	},
+	"🧭 Developer Experience Score:": {
+		"jp": "🧭 開発者体験スコア:",
+	},
+	"Not enough data for a score in this period": {
+		"jp": "この期間にはスコアを算出するのに十分なデータがありません",
+	},
+	"Score: %.0f / 100 (grade %s)\n": {
+		"jp": "スコア: %.0f / 100 (評価 %s)\n",
+	},
+	"Component": {
+		"jp": "要素",
+	},
+	"Component Score": {
+		"jp": "要素スコア",
+	},
+	"Weight": {
+		"jp": "重み",
+	},
+	"Contribution": {
+		"jp": "寄与",
+	},
+	"Review Latency": {
+		"jp": "レビュー待ち時間",
+	},
+	"CI Wait": {
+		"jp": "CI待ち時間",
+	},
+	"CI Failure Rate": {
+		"jp": "CI失敗率",
+	},
+	"Left out (no data): %s\n": {
+		"jp": "除外 (データなし): %s\n",
+	},
+	"💡 An opinionated heuristic: each component scores 100 at or below a good value and 0 at or beyond a poor one. Adjust the weights under \"dxScore\" in the config.": {
+		"jp": "💡 独自のヒューリスティックです: 各要素は良好な値以下で100点、不良な値以上で0点になります。重みは設定ファイルの \"dxScore\" で調整できます。",
+	},
+	"📐 DORA Benchmark (heuristic):": {
+		"jp": "📐 DORA ベンチマーク (目安):",
+	},
+	"Band": {
+		"jp": "区分",
+	},
+	"Elite": {
+		"jp": "エリート",
+	},
+	"High": {
+		"jp": "高",
+	},
+	"Medium": {
+		"jp": "中",
+	},
+	"Low": {
+		"jp": "低",
+	},
+	"Derived From": {
+		"jp": "算出元",
+	},
+	"Deployment Frequency": {
+		"jp": "デプロイ頻度",
+	},
+	"Lead Time for Changes": {
+		"jp": "変更のリードタイム",
+	},
+	"Change Failure Rate": {
+		"jp": "変更失敗率",
+	},
+	"Time to Restore": {
+		"jp": "復旧時間",
+	},
+	"merges into the default branch": {
+		"jp": "デフォルトブランチへのマージ",
+	},
+	"median PR lead time": {
+		"jp": "PRリードタイムの中央値",
+	},
+	"revert and hotfix PRs per merged PR": {
+		"jp": "マージ済みPRあたりのリバート・ホットフィックスPR",
+	},
+	"median release→hotfix gap": {
+		"jp": "リリース→ホットフィックス間隔の中央値",
+	},
+	"💡 Bands follow the thresholds of the 2023 DORA State of DevOps report. visuche sees PRs, not deployments or incidents, so treat them as a rough orientation.": {
+		"jp": "💡 区分は 2023 年の DORA State of DevOps レポートのしきい値に基づきます。visuche が見ているのはPRであり、デプロイやインシデントではないため、大まかな目安として扱ってください。",
+	},
+	"👤 %s · %s → %s · %s · +%d −%d in %d files\n": {
+		"jp": "👤 %s · %s → %s · %s · +%d −%d (%d ファイル)\n",
+	},
+	"merged": {
+		"jp": "マージ済み",
+	},
+	"closed": {
+		"jp": "クローズ",
+	},
+	"open": {
+		"jp": "オープン",
+	},
+	"draft": {
+		"jp": "ドラフト",
+	},
+	"🕒 Timeline:": {
+		"jp": "🕒 タイムライン:",
+	},
+	"Time": {
+		"jp": "時刻",
+	},
+	"Since Open": {
+		"jp": "オープンから",
+	},
+	"Who": {
+		"jp": "ユーザー",
+	},
+	"Detail": {
+		"jp": "詳細",
+	},
+	"Commit": {
+		"jp": "コミット",
+	},
+	"Review requested": {
+		"jp": "レビュー依頼",
+	},
+	"Review": {
+		"jp": "レビュー",
+	},
+	"Comment": {
+		"jp": "コメント",
+	},
+	"Ready for review": {
+		"jp": "レビュー可能に変更",
+	},
+	"Converted to draft": {
+		"jp": "ドラフトに変更",
+	},
+	"Force-pushed": {
+		"jp": "フォースプッシュ",
+	},
+	"Reopened": {
+		"jp": "再オープン",
+	},
+	"Closed": {
+		"jp": "クローズ",
+	},
+	"CI run": {
+		"jp": "CI実行",
+	},
+	"🔄 Stages:": {
+		"jp": "🔄 ステージ:",
+	},
+	"Open For": {
+		"jp": "オープン期間",
+	},
+	"🔧 CI: %d runs (%d failed), %.0f CI minutes\n": {
+		"jp": "🔧 CI: %d 回実行 (%d 回失敗)、%.0f CI分\n",
+	},
+	"🗓️  Stages (Mermaid gantt):": {
+		"jp": "🗓️  ステージ (Mermaid ガントチャート):",
+	},
+	"🗓️  Slowest %d PRs (Mermaid gantt):": {
+		"jp": "🗓️  最も遅い %d 件のPR (Mermaid ガントチャート):",
+	},
+	"Slowest PRs in %s": {
+		"jp": "%s で最も遅いPR",
+	},
+	"🏁 Milestone": {
+		"jp": "🏁 マイルストーン",
+	},
+	"Issues (open / closed)": {
+		"jp": "Issue（オープン / クローズ）",
+	},
+	"PRs (open / closed)": {
+		"jp": "PR（オープン / クローズ）",
+	},
+	"Progress": {
+		"jp": "進捗",
+	},
+	"Velocity (closed per day, last %d days)": {
+		"jp": "ベロシティ（1日あたりのクローズ数、直近%d日）",
+	},
+	"Due": {
+		"jp": "期日",
+	},
+	"Projected completion": {
+		"jp": "完了見込み",
+	},
+	"done": {
+		"jp": "完了",
+	},
+	"unknown (nothing closed recently)": {
+		"jp": "不明（最近のクローズなし）",
+	},
+	"⚠️  At the current velocity the milestone will miss its due date (%s)\n": {
+		"jp": "⚠️  現在のベロシティではマイルストーンは期日（%s）に間に合いません\n",
+	},
+	"📉 Burndown (end of day, UTC):": {
+		"jp": "📉 バーンダウン（各日の終わり、UTC）:",
+	},
+	"Date": {
+		"jp": "日付",
+	},
+	"Open": {
+		"jp": "オープン",
+	},
+	"Open (█) and closed (░) items:": {
+		"jp": "オープン（█）とクローズ（░）の項目:",
+	},
+	"Could not determine the default branch, including PRs into any branch": {
+		"jp": "デフォルトブランチを特定できないため、すべてのブランチへのPRを含めます",
+	},
+	"📝 Release notes for %d PRs written to %s\n": {
+		"jp": "📝 %d件のPRのリリースノートを %s に書き出しました\n",
+	},
+	"🏷️  PR Types (from titles):": {
+		"jp": "🏷️  PRタイプ（タイトルから）:",
+	},
+	"Type": {
+		"jp": "タイプ",
+	},
+	"Share": {
+		"jp": "割合",
+	},
+	"vs. Overall": {
+		"jp": "全体比",
+	},
+	"Average Size": {
+		"jp": "平均サイズ",
+	},
+	"(no type)": {
+		"jp": "（タイプなし）",
+	},
+	"💡 %d of %d PRs have a typed title; overall median lead time %s. Custom prefixes can be mapped with prTypes in the config.": {
+		"jp": "💡 %d / %d件のPRのタイトルにタイプがあります。全体のリードタイム中央値は%sです。独自のプレフィックスは設定のprTypesで対応付けられます。",
+	},
+	"🔒 Security PRs:": {
+		"jp": "🔒 セキュリティPR:",
+	},
+	"Merged within %s": {
+		"jp": "%s以内にマージ",
+	},
+	"SLA breaches": {
+		"jp": "SLA違反",
+	},
+	"Source": {
+		"jp": "ソース",
+	},
+	"State": {
+		"jp": "状態",
+	},
+	"Time to Merge / Age": {
+		"jp": "マージまでの時間 / 経過時間",
+	},
+	"📅 Activity calendar: %s\n": {
+		"jp": "📅 アクティビティカレンダー: %s\n",
+	},
+	"📥 Analyzing %s...\n": {
+		"jp": "📥 %s を分析中...\n",
+	},
+	"⚖️  Repository Comparison:": {
+		"jp": "⚖️  リポジトリ比較:",
+	},
+	"%s vs %s": {
+		"jp": "%s 対 %s",
+	},
+	"CI Success Rate": {
+		"jp": "CI成功率",
+	},
+	"CI Average Duration": {
+		"jp": "CI平均実行時間",
+	},
+	"❗ marks differences of %.0f%% or more (%d metrics).": {
+		"jp": "❗ は%.0f%%以上の差を示します（%d項目）。",
+	},
+	"🩺 Repository Scorecard:": {
+		"jp": "🩺 リポジトリスコアカード:",
+	},
+	"Repository": {
+		"jp": "リポジトリ",
+	},
+	"CI Success": {
+		"jp": "CI成功率",
+	},
+	"Open PR Age (median)": {
+		"jp": "オープンPRの経過時間（中央値）",
+	},
+	"📥 [%d/%d] Analyzed %s\n": {
+		"jp": "📥 [%d/%d] %s を分析しました\n",
+	},
+	"🧹 Store (%s): removed %d PRs and %d workflow runs older than %d months\n": {
+		"jp": "🧹 ストア (%s): %[4]dか月より古い PR %[2]d 件とワークフロー実行 %[3]d 件を削除しました\n",
+	},
+	"🧹 Run history: removed %d snapshots older than %d months\n": {
+		"jp": "🧹 実行履歴: %[2]dか月より古いスナップショット %[1]d 件を削除しました\n",
+	},
+	"📥 Imported %d of %d PRs into the store of %s (%d already stored)\n": {
+		"jp": "📥 %[3]s のストアに %[2]d 件中 %[1]d 件の PR をインポートしました（%[4]d 件は保存済み）\n",
+	},
+	"🧮 PR Size vs Landed Change (merged PRs):": {
+		"jp": "🧮 PRサイズと実際に取り込まれた変更量（マージ済みPR）:",
+	},
+	"No merge or squash commits to measure (%d rebase merges)": {
+		"jp": "計測できるマージ/スカッシュコミットがありません（リベースマージ %d 件）",
+	},
+	"Landed": {
+		"jp": "取り込み後",
+	},
+	"Additions (total)": {
+		"jp": "追加行（合計）",
+	},
+	"Deletions (total)": {
+		"jp": "削除行（合計）",
+	},
+	"Changed Lines (median)": {
+		"jp": "変更行（中央値）",
+	},
+	"Measured %d PRs (%d squash, %d merge commit); %d rebase merges skipped.": {
+		"jp": "%d 件のPRを計測しました（スカッシュ %d 件、マージコミット %d 件）。リベースマージ %d 件は除外しました。",
+	},
+	"PR sizes overstate the landed change by %.0f%%; %d PRs by more than 20%%.": {
+		"jp": "PRサイズは実際に取り込まれた変更より %.0f%% 大きく表示されています。20%% 超の差があるPRは %d 件です。",
+	},
+	"Comments": {
+		"jp": "コメント",
+	},
+	"Blocking": {
+		"jp": "ブロッキング",
+	},
+	"Question": {
+		"jp": "質問",
+	},
+	"Nit": {
+		"jp": "細かい指摘",
+	},
+	"Approval": {
+		"jp": "承認",
+	},
+	"Other": {
+		"jp": "その他",
+	},
+	"(all reviewers)": {
+		"jp": "（全レビュワー）",
+	},
+	"💬 Review Comment Categories:": {
+		"jp": "💬 レビューコメントの分類:",
+	},
+	"No review comments to classify": {
+		"jp": "分類するレビューコメントがありません",
+	},
+	"Classified %d comments on %d PRs with keyword rules; replies by PR authors and bots are excluded.": {
+		"jp": "%[2]d 件のPRの %[1]d 件のコメントをキーワードルールで分類しました（PR作成者の返信とボットは除外）。",
+	},
+	"✏️  Suggested Changes:": {
+		"jp": "✏️  提案された変更（suggestion）:",
+	},
+	"No suggestion blocks in %d review comments": {
+		"jp": "%d 件のレビューコメントに suggestion ブロックはありません",
+	},
+	"Review comments with suggestions": {
+		"jp": "suggestion 付きレビューコメント",
+	},
+	"PRs with suggestions": {
+		"jp": "suggestion のあるPR",
+	},
+	"Suggestions applied": {
+		"jp": "適用された suggestion",
+	},
+	"Time to apply (avg/median)": {
+		"jp": "適用までの時間（平均/中央値）",
+	},
+	"Merged PRs only. Applied means a later commit credits the reviewer as co-author, as GitHub's \"Apply suggestion\" button does; each commit accounts for one suggestion, a batch for those posted since the previous commit.": {
+		"jp": "マージ済み PR のみ対象です。「適用」は GitHub の \"Apply suggestion\" ボタンと同様に、後続コミットがレビュワーを共同作成者に含むことを指します。1 コミットは 1 件の提案、一括適用コミットは直前のコミット以降の提案のみに対応します。",
+	},
+	"🚨 Unusual Weeks (median lead time):": {
+		"jp": "🚨 異常な週（リードタイム中央値）:",
+	},
+	"🚨 Unusual Weeks (CI failure rate):": {
+		"jp": "🚨 異常な週（CI失敗率）:",
+	},
+	"Week of %s: %s (baseline %s, %+.1f MADs)": {
+		"jp": "%s の週: %s（ベースライン %s、%+.1f MAD）",
+	},
+	"Week of %s: %.1f%% failed (baseline %.1f%%, %+.1f MADs)": {
+		"jp": "%s の週: 失敗率 %.1f%%（ベースライン %.1f%%、%+.1f MAD）",
+	},
+	"Baseline: median of up to %d preceding weeks; flagged beyond %.1f median absolute deviations.": {
+		"jp": "ベースライン: 直前最大 %d 週の中央値。中央絶対偏差（MAD）の %.1f 倍を超える週を表示しています。",
+	},
+	"drafts": {
+		"jp": "ドラフト",
+	},
+	"labels %s": {
+		"jp": "ラベル %s",
+	},
+	"titles matching %s": {
+		"jp": "%s に一致するタイトル",
+	},
+	"[bot]/app accounts and known merge bots": {
+		"jp": "[bot]/app アカウントと既知のマージボット",
+	},
+	"⚙️  Effective Settings:": {
+		"jp": "⚙️  適用中の設定:",
+	},
+	"  Releases: merges into %s": {
+		"jp": "  リリース: %s へのマージ",
+	},
+	"  Hotfixes: %s": {
+		"jp": "  ホットフィックス: %s",
+	},
+	"head branches matching %s": {
+		"jp": "%s に一致するヘッドブランチ",
+	},
+	"  Reverts: %s": {
+		"jp": "  リバート: %s",
+	},
+	"titles containing \"revert\"": {
+		"jp": "\"revert\" を含むタイトル",
+	},
+	"  WIP: open %s": {
+		"jp": "  WIP: オープン中の %s",
+	},
+	"  Bots (PRs and reviews excluded): %s": {
+		"jp": "  ボット（PRとレビューを除外）: %s",
+	},
+	"  Anomalies: beyond %.1f MADs of the preceding %d weeks": {
+		"jp": "  異常検知: 直前 %[2]d 週から %.1[1]f MAD を超える週",
+	},
+	"  Business hours: %d:00–%d:00 on weekdays": {
+		"jp": "  営業時間: 平日 %d:00–%d:00",
+	},
+	"⚠️  Failed to create chart directory: %v": {
+		"jp": "⚠️  チャート出力ディレクトリを作成できませんでした: %v",
+	},
+	"⚠️  Failed to write chart %s: %v": {
+		"jp": "⚠️  チャート %s を書き出せませんでした: %v",
+	},
+	"📁 Chart output: %s": {
+		"jp": "📁 チャート出力: %s",
+	},
+	"Std Dev": {
+		"jp": "標準偏差",
+	},
+	"Hotfix→Release Gap": {
+		"jp": "Hotfixと直近リリースの間隔",
+	},
+	"⏳ Open PRs:": {
+		"jp": "⏳ オープンPR:",
+	},
+	"Open < 1 day": {
+		"jp": "オープン 1日未満",
+	},
+	"Open 1–3 days": {
+		"jp": "オープン 1〜3日",
+	},
+	"Open 3–7 days": {
+		"jp": "オープン 3〜7日",
+	},
+	"Open > 7 days": {
+		"jp": "オープン 7日超",
+	},
+	"Average Open PR Age": {
+		"jp": "オープンPRの平均経過時間",
+	},
+	"Oldest Open PR": {
+		"jp": "最古のオープンPR",
+	},
+	"💡 Open PRs created before --since are not included": {
+		"jp": "💡 --since より前に作成されたオープンPRは含まれません",
+	},
+	"Ready for Review": {
+		"jp": "レビュー待ち",
+	},
+	"Approved, Awaiting Merge": {
+		"jp": "承認済み・マージ待ち",
+	},
+	"🚧 Merge Blockers:": {
+		"jp": "🚧 マージの阻害要因:",
+	},
+	"PRs": {
+		"jp": "PR数",
+	},
+	"Open PRs Checked": {
+		"jp": "対象のオープンPR",
+	},
+	"Merge Conflicts": {
+		"jp": "コンフリクト",
+	},
+	"Failing or Pending Checks": {
+		"jp": "失敗中・実行中のチェック",
+	},
+	"Needs Review or Changes": {
+		"jp": "レビュー不足・変更要求",
+	},
+	"Behind Base Branch": {
+		"jp": "ベースブランチより古い",
+	},
+	"Ready to Merge": {
+		"jp": "マージ可能",
+	},
+	"Mergeability Unknown": {
+		"jp": "マージ可否が不明",
+	},
+	"💡 Open, non-draft PRs; a PR can have several blockers": {
+		"jp": "💡 ドラフト以外のオープンPRが対象です。1つのPRに複数の阻害要因がある場合があります",
+	},
+	"🏷️  Metrics by Label:": {
+		"jp": "🏷️  ラベル別メトリクス:",
+	},
+	"Label": {
+		"jp": "ラベル",
+	},
+	"Reviews Received (PRs)": {
+		"jp": "受けたレビュー数（PR数）",
+	},
+	"(no label)": {
+		"jp": "（ラベルなし）",
+	},
+	"⚠️  %d PRs without the label %q were dropped": {
+		"jp": "⚠️  ラベル %[2]q のない %[1]d 件のPRを除外しました",
+	},
+	"Failed Job": {
+		"jp": "失敗ジョブ",
+	},
+	"Failed Step": {
+		"jp": "失敗ステップ",
+	},
+	"Failures": {
+		"jp": "失敗数",
+	},
+	"Last Seen": {
+		"jp": "最終発生",
+	},
+	"(unknown)": {
+		"jp": "(不明)",
+	},
+	"💡 Failures of the same workflow, job and step are counted together; the URL is the most recent one. (unknown) jobs were not looked up or had no failed job.": {
+		"jp": "💡 ワークフロー・ジョブ・ステップが同じ失敗をまとめて数えています。URL は最新の失敗です。(不明) のジョブは未取得か、失敗したジョブがありません。",
+	},
+	"⏱️  Workflows over their p99 budget:": {
+		"jp": "⏱️  p99 の予算を超えたワークフロー:",
+	},
+	"  ⚠️  %s: p99 %s exceeds the budget of %s": {
+		"jp": "  ⚠️  %s: p99 %s が予算 %s を超えています",
+	},
+	"👤 CI Time by Actor:": {
+		"jp": "👤 実行者別の CI 時間:",
+	},
+	"Actor": {
+		"jp": "実行者",
+	},
+	"user": {
+		"jp": "ユーザー",
+	},
+	"bot": {
+		"jp": "ボット",
+	},
+	"schedule": {
+		"jp": "スケジュール",
+	},
+	"CI Time": {
+		"jp": "CI 時間",
+	},
+	"Top Workflow": {
+		"jp": "主なワークフロー",
+	},
+	"(schedule)": {
+		"jp": "(スケジュール)",
+	},
+	"💡 CI time is the run time of completed runs; scheduled runs are grouped under (schedule) whoever edited the cron.": {
+		"jp": "💡 CI 時間は完了した実行の所要時間です。スケジュール実行は cron の編集者に関わらず (スケジュール) にまとめています。",
+	},
+	"🎛️  Workflow Dispatch Inputs:": {
+		"jp": "🎛️  手動実行 (workflow_dispatch) の入力:",
+	},
+	"Inputs": {
+		"jp": "入力",
+	},
+	"Last Run": {
+		"jp": "最終実行",
+	},
+	"💡 The API doesn't return dispatch inputs; run visuche collect with workflow_dispatch webhooks and analyze with --provider store to record them.": {
+		"jp": "💡 API は手動実行の入力を返しません。記録するには workflow_dispatch の Webhook で visuche collect を実行し、--provider store で分析してください。",
+	},
+	"🥞 Stacked PRs:": {
+		"jp": "🥞 スタック PR:",
+	},
+	"Stacked": {
+		"jp": "スタック",
+	},
+	"Standalone": {
+		"jp": "単独",
+	},
+	"Depth": {
+		"jp": "深さ",
+	},
+	"Stack": {
+		"jp": "構成",
+	},
+	"%d PRs × %d": {
+		"jp": "%d PR × %d",
+	},
+	"%d stacks by size: %s": {
+		"jp": "%d 個のスタック (サイズ別): %s",
+	},
+	"💡 A PR is stacked when its base branch is another open PR's head branch; PRs retargeted after their parent merged count as standalone.": {
+		"jp": "💡 ベースブランチが別のオープン PR のヘッドブランチである PR をスタックとみなします。親のマージ後にベースが付け替えられた PR は単独として数えます。",
+	},
+	"🍴 Fork vs Branch PRs:": {
+		"jp": "🍴 フォーク / ブランチ別 PR:",
+	},
+	"Forks": {
+		"jp": "フォーク",
+	},
+	"Branches": {
+		"jp": "ブランチ",
+	},
+	"Open without review": {
+		"jp": "レビュー待ちのオープン PR",
+	},
+	"💡 Merge rate is the share of closed PRs that were merged; time to first review counts reviewed PRs only.": {
+		"jp": "💡 マージ率はクローズ済み PR のうちマージされた割合です。初回レビューまでの時間はレビュー済みの PR のみで計算しています。",
+	},
+	"🤝 Maintainer Response SLO (PRs from forks):": {
+		"jp": "🤝 メンテナー応答 SLO (フォークからの PR):",
+	},
+	"Target: first maintainer comment or review within %s": {
+		"jp": "目標: %s 以内にメンテナーが最初のコメントまたはレビュー",
 	},
-	"🔴 Failure #%d:": {
-		"jp": "🔴 失敗 #%d:",
+	"No PRs from forks in this period": {
+		"jp": "この期間にフォークからの PR はありません",
 	},
-	"  Workflow: %s\n": {
-		"jp": "  ワークフロー: %s\n",
+	"PRs from Forks": {
+		"jp": "フォークからの PR",
 	},
-	"  Run: %s\n": {
-		"jp": "  実行: %s\n",
+	"Answered by a Maintainer": {
+		"jp": "メンテナー応答済み",
 	},
-	"  Date: %s\n": {
-		"jp": "  日時: %s\n",
+	"Open, Awaiting Response": {
+		"jp": "オープン (応答待ち)",
 	},
-	"  Duration: %s\n": {
-		"jp": "  所要時間: %s\n",
+	"First Maintainer Response (median)": {
+		"jp": "メンテナー初回応答 (中央値)",
 	},
-	"  Failed Job: %s\n": {
-		"jp": "  失敗ジョブ: %s\n",
+	"👤 SLA by Maintainer:": {
+		"jp": "👤 メンテナー別 SLA:",
 	},
-	"  Failed Step: %s\n": {
-		"jp": "  失敗ステップ: %s\n",
+	"Maintainer": {
+		"jp": "メンテナー",
 	},
-	"  URL: %s\n": {
-		"jp": "  URL: %s\n",
+	"(awaiting response)": {
+		"jp": "(応答待ち)",
 	},
-	"\n... and %d more failures\n": {
-		"jp": "\n...さらに %d 件の失敗があります\n",
+	"  Long-lived branches (never stack parents): %s": {
+		"jp": "  長期ブランチ（スタックの親にしない）: %s",
 	},
 }
 
@@ -0,0 +1,240 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// keyPattern matches Jira issue keys such as ABC-123.
+var keyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-\d+\b`)
+
+// Issue holds the Jira fields used for lead time analysis.
+type Issue struct {
+	Key       string
+	Status    string
+	CreatedAt time.Time
+	StartedAt time.Time // First transition out of the initial status (e.g. To Do → In Progress)
+}
+
+// Client talks to the Jira REST API.
+type Client struct {
+	BaseURL string
+	Email   string // When set, Token is sent with basic auth (Jira Cloud API token); otherwise as a bearer token (Server/Data Center PAT)
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient returns a client for the Jira instance at baseURL.
+func NewClient(baseURL, email, token string) *Client {
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Email:   email,
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ExtractKeys returns the unique Jira keys found in texts, in order of appearance.
+// When projects is non-empty, only keys of those projects are returned and matching is case-insensitive,
+// so lowercase branch names like feature/abc-123 are recognised.
+func ExtractKeys(projects []string, texts ...string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	add := func(key string) {
+		key = strings.ToUpper(key)
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	if len(projects) == 0 {
+		for _, text := range texts {
+			for _, key := range keyPattern.FindAllString(text, -1) {
+				add(key)
+			}
+		}
+		return keys
+	}
+
+	quoted := make([]string, len(projects))
+	for i, project := range projects {
+		quoted[i] = regexp.QuoteMeta(project)
+	}
+	projectPattern := regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)-\d+\b`)
+	for _, text := range texts {
+		for _, key := range projectPattern.FindAllString(text, -1) {
+			add(key)
+		}
+	}
+	return keys
+}
+
+// FetchIssues looks up the given keys with JQL search, batching to stay within URL limits.
+// Keys that do not exist or are not visible to the token are silently absent from the result.
+func (c *Client) FetchIssues(keys []string) (map[string]Issue, error) {
+	issues := make(map[string]Issue, len(keys))
+	const batchSize = 50
+	for start := 0; start < len(keys); start += batchSize {
+		end := start + batchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := c.fetchBatch(keys[start:end], issues); err != nil {
+			return issues, err
+		}
+	}
+	return issues, nil
+}
+
+// searchResponse mirrors the subset of the search payload we use; /rest/api/2/search (Server/Data Center)
+// and /rest/api/3/search/jql (Cloud) share the issue shape, and only the latter pages with nextPageToken.
+type searchResponse struct {
+	Issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Created string `json:"created"`
+			Status  struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+		Changelog struct {
+			Histories []struct {
+				Created string `json:"created"`
+				Items   []struct {
+					Field string `json:"field"`
+				} `json:"items"`
+			} `json:"histories"`
+		} `json:"changelog"`
+	} `json:"issues"`
+	NextPageToken string   `json:"nextPageToken"`
+	ErrorMessages []string `json:"errorMessages"`
+}
+
+// cloud reports whether the client talks to Jira Cloud, which is the deployment API tokens with an email are for.
+func (c *Client) cloud() bool {
+	return c.Email != ""
+}
+
+func (c *Client) fetchBatch(keys []string, issues map[string]Issue) error {
+	status, err := c.search(keys, issues)
+	if err == nil || status != http.StatusBadRequest || !c.cloud() || len(keys) == 1 {
+		return err
+	}
+
+	// /search/jql has no validateQuery=warn, so one unknown key rejects the whole batch; look the keys up
+	// one by one and skip those Jira rejects.
+	for _, key := range keys {
+		if status, err := c.search([]string{key}, issues); err != nil && status != http.StatusBadRequest {
+			return err
+		}
+	}
+	return nil
+}
+
+// search runs the JQL lookup for keys and adds the issues found to issues, returning the HTTP status of
+// the failing response along with the error.
+func (c *Client) search(keys []string, issues map[string]Issue) (int, error) {
+	params := url.Values{}
+	params.Set("jql", fmt.Sprintf("key in (%s)", strings.Join(keys, ",")))
+	params.Set("fields", "created,status")
+	params.Set("expand", "changelog")
+	params.Set("maxResults", fmt.Sprintf("%d", len(keys)))
+
+	endpoint := "/rest/api/3/search/jql"
+	if !c.cloud() {
+		// Server/Data Center only has the v2 search; validateQuery=warn keeps unknown keys from failing the whole batch
+		endpoint = "/rest/api/2/search"
+		params.Set("validateQuery", "warn")
+	}
+
+	for {
+		result, status, err := c.get(endpoint + "?" + params.Encode())
+		if err != nil {
+			return status, err
+		}
+		addIssues(result, issues)
+		if result.NextPageToken == "" {
+			return status, nil
+		}
+		params.Set("nextPageToken", result.NextPageToken)
+	}
+}
+
+// get requests path from the Jira instance and decodes the search response.
+func (c *Client) get(path string) (searchResponse, int, error) {
+	var result searchResponse
+	req, err := http.NewRequest(http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return result, 0, fmt.Errorf("failed to build Jira request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.cloud() {
+		req.SetBasicAuth(c.Email, c.Token)
+	} else if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return result, 0, fmt.Errorf("Jira request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, resp.StatusCode, fmt.Errorf("failed to read Jira response: %w", err)
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return result, resp.StatusCode, fmt.Errorf("failed to unmarshal Jira response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return result, resp.StatusCode, fmt.Errorf("Jira returned HTTP %d: %s", resp.StatusCode, strings.Join(result.ErrorMessages, "; "))
+	}
+	return result, resp.StatusCode, nil
+}
+
+// addIssues converts the issues of a search response and stores them by key.
+func addIssues(result searchResponse, issues map[string]Issue) {
+	for _, raw := range result.Issues {
+		issue := Issue{
+			Key:       raw.Key,
+			Status:    raw.Fields.Status.Name,
+			CreatedAt: parseTime(raw.Fields.Created),
+		}
+
+		var transitions []time.Time
+		for _, history := range raw.Changelog.Histories {
+			for _, item := range history.Items {
+				if item.Field == "status" {
+					transitions = append(transitions, parseTime(history.Created))
+					break
+				}
+			}
+		}
+		sort.Slice(transitions, func(i, j int) bool { return transitions[i].Before(transitions[j]) })
+		if len(transitions) > 0 {
+			issue.StartedAt = transitions[0]
+		}
+
+		issues[issue.Key] = issue
+	}
+}
+
+// parseTime parses Jira timestamps (2024-05-01T09:00:00.000+0000).
+func parseTime(value string) time.Time {
+	for _, layout := range []string{"2006-01-02T15:04:05.000-0700", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
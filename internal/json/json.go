@@ -0,0 +1,226 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/metadata"
+	"visuche/internal/stats"
+)
+
+// Document is the shape of every JSON export: the records with a metadata block describing how they were computed.
+type Document struct {
+	Metadata metadata.Metadata `json:"metadata"`
+	Records  interface{}       `json:"records"`
+}
+
+// PullRequestRecord is the exported JSON shape of a pull request.
+type PullRequestRecord struct {
+	Number         int      `json:"number"`
+	Title          string   `json:"title"`
+	URL            string   `json:"url,omitempty"`
+	CreatedAt      string   `json:"createdAt"`
+	MergedAt       string   `json:"mergedAt,omitempty"`
+	ClosedAt       string   `json:"closedAt,omitempty"`
+	Merged         bool     `json:"merged"`
+	LeadTimeHours  float64  `json:"leadTimeHours"`
+	Author         string   `json:"author"`
+	Additions      int      `json:"additions"`
+	Deletions      int      `json:"deletions"`
+	ChangedFiles   int      `json:"changedFiles"`
+	IsDraft        bool     `json:"isDraft"`
+	State          string   `json:"state"`
+	BaseRef        string   `json:"baseRef"`
+	HeadRef        string   `json:"headRef"`
+	MergedBy       string   `json:"mergedBy,omitempty"`
+	AutomatedMerge bool     `json:"automatedMerge"`
+	Reviewers      []string `json:"reviewers"` // Distinct logins that submitted a review, excluding the author
+	Labels         []string `json:"labels"`
+
+	FirstCommitAt   string  `json:"firstCommitAt,omitempty"`
+	CodingTimeHours float64 `json:"codingTimeHours"`
+	PickupTimeHours float64 `json:"pickupTimeHours"`
+	ReviewTimeHours float64 `json:"reviewTimeHours"`
+	MergeTimeHours  float64 `json:"mergeTimeHours"`
+}
+
+// WritePullRequestsToJSON writes a slice of PullRequests to a JSON file.
+func WritePullRequestsToJSON(filename string, prs []github.PullRequest, meta metadata.Metadata) error {
+	return writeJSON(filename, Document{Metadata: meta, Records: PullRequestRecords(prs)})
+}
+
+// PullRequestRecords converts PullRequests to their exported JSON shape.
+func PullRequestRecords(prs []github.PullRequest) []PullRequestRecord {
+	records := make([]PullRequestRecord, 0, len(prs))
+	for _, pr := range prs {
+		records = append(records, PullRequestRecord{
+			Number:         pr.Number,
+			Title:          pr.Title,
+			URL:            pr.URL,
+			CreatedAt:      formatTime(pr.CreatedAt),
+			MergedAt:       formatTime(pr.MergedAt),
+			ClosedAt:       formatTime(pr.ClosedAt),
+			Merged:         pr.Merged,
+			LeadTimeHours:  pr.LeadTime.Hours(),
+			Author:         pr.Author.Login,
+			Additions:      pr.Additions,
+			Deletions:      pr.Deletions,
+			ChangedFiles:   pr.ChangedFiles,
+			IsDraft:        pr.IsDraft,
+			State:          pr.State,
+			BaseRef:        pr.BaseRefName,
+			HeadRef:        pr.HeadRefName,
+			MergedBy:       pr.MergedBy.Login,
+			AutomatedMerge: pr.IsAutomatedMerge(),
+			Reviewers:      pr.ReviewerLogins(),
+			Labels:         pr.LabelNames(),
+
+			FirstCommitAt:   formatTime(pr.FirstCommitAt),
+			CodingTimeHours: pr.CodingTime.Hours(),
+			PickupTimeHours: pr.PickupTime.Hours(),
+			ReviewTimeHours: pr.ReviewTime.Hours(),
+			MergeTimeHours:  pr.MergeTime.Hours(),
+		})
+	}
+	return records
+}
+
+// StatsRecord converts Stats to a JSON-friendly map: keys are lowerCamelCase field names and
+// durations become hours (with an "Hours" suffix) so dashboards don't have to deal with nanoseconds.
+// Distribution summaries become nested records of the same shape.
+func StatsRecord(s stats.Stats) map[string]interface{} {
+	return structRecord(reflect.ValueOf(s))
+}
+
+// structRecord converts the exported fields of struct v as described by StatsRecord.
+func structRecord(v reflect.Value) map[string]interface{} {
+	record := make(map[string]interface{})
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.ToLower(field.Name[:1]) + field.Name[1:]
+		value := v.Field(i).Interface()
+		switch value := value.(type) {
+		case time.Duration:
+			record[name+"Hours"] = math.Round(value.Hours()*100) / 100
+		case stats.DurationSummary, stats.CountSummary:
+			record[name] = structRecord(v.Field(i))
+		default:
+			record[name] = value
+		}
+	}
+	return record
+}
+
+// ActivityRecord is the exported JSON shape of one author's activity on one day.
+type ActivityRecord struct {
+	Date         string `json:"date"`
+	Author       string `json:"author"`
+	PRsOpened    int    `json:"prsOpened"`
+	PRsMerged    int    `json:"prsMerged"`
+	ReviewsGiven int    `json:"reviewsGiven"`
+}
+
+// WriteActivityCalendarToJSON writes a per-author activity calendar to a JSON file.
+func WriteActivityCalendarToJSON(filename string, days []stats.ActivityDay, meta metadata.Metadata) error {
+	records := make([]ActivityRecord, 0, len(days))
+	for _, d := range days {
+		records = append(records, ActivityRecord{
+			Date:         d.Date.Format("2006-01-02"),
+			Author:       d.Author,
+			PRsOpened:    d.PRsOpened,
+			PRsMerged:    d.PRsMerged,
+			ReviewsGiven: d.ReviewsGiven,
+		})
+	}
+	return writeJSON(filename, Document{Metadata: meta, Records: records})
+}
+
+// ScorecardRecord is the exported JSON shape of one repository's scorecard row.
+type ScorecardRecord struct {
+	Repo                string  `json:"repo"`
+	MergedPRs           int     `json:"mergedPRs"`
+	MedianLeadTimeHours float64 `json:"medianLeadTimeHours"`
+	MergeRate           float64 `json:"mergeRate"`
+	CIRuns              int     `json:"ciRuns"`
+	CISuccessRate       float64 `json:"ciSuccessRate"`
+	OpenPRs             int     `json:"openPRs"`
+	MedianOpenAgeHours  float64 `json:"medianOpenAgeHours"`
+	Error               string  `json:"error,omitempty"`
+}
+
+// WriteScorecardToJSON writes a repository scorecard to a JSON file.
+func WriteScorecardToJSON(filename string, rows []stats.ScorecardRow, meta metadata.Metadata) error {
+	records := make([]ScorecardRecord, 0, len(rows))
+	for _, r := range rows {
+		records = append(records, ScorecardRecord{
+			Repo:                r.Repo,
+			MergedPRs:           r.MergedPRs,
+			MedianLeadTimeHours: r.MedianLeadTime.Hours(),
+			MergeRate:           r.MergeRate,
+			CIRuns:              r.CIRuns,
+			CISuccessRate:       r.CISuccessRate,
+			OpenPRs:             r.OpenPRs,
+			MedianOpenAgeHours:  r.MedianOpenAge.Hours(),
+			Error:               r.Err,
+		})
+	}
+	return writeJSON(filename, Document{Metadata: meta, Records: records})
+}
+
+// FailureRecord is the exported JSON shape of a failed workflow run.
+type FailureRecord struct {
+	Workflow        string  `json:"workflow"`
+	Title           string  `json:"title"`
+	CreatedAt       string  `json:"createdAt"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	FailedJob       string  `json:"failedJob,omitempty"`
+	FailedStep      string  `json:"failedStep,omitempty"`
+	URL             string  `json:"url"`
+}
+
+// WriteFailureDetailsToJSON writes failed workflow runs to a JSON file.
+func WriteFailureDetailsToJSON(filename string, failures []actions.FailureDetail, meta metadata.Metadata) error {
+	records := make([]FailureRecord, 0, len(failures))
+	for _, f := range failures {
+		records = append(records, FailureRecord{
+			Workflow:        f.WorkflowName,
+			Title:           f.DisplayTitle,
+			CreatedAt:       formatTime(f.CreatedAt),
+			DurationSeconds: f.Duration.Seconds(),
+			FailedJob:       f.FailedJob,
+			FailedStep:      f.FailedStep,
+			URL:             f.URL,
+		})
+	}
+	return writeJSON(filename, Document{Metadata: meta, Records: records})
+}
+
+// writeJSON marshals v with indentation and writes it to filename.
+func writeJSON(filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := os.WriteFile(filename, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+	return nil
+}
+
+// formatTime formats t as RFC3339, or returns an empty string for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
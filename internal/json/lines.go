@@ -0,0 +1,159 @@
+package json
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/metadata"
+)
+
+// Line types of a JSON Lines export.
+const (
+	LineMetadata    = "metadata"
+	LinePullRequest = "pull_request"
+	LineWorkflowRun = "workflow_run"
+)
+
+// line is one line of a JSON Lines export: the metadata block first, then one record per line.
+type line struct {
+	Type     string             `json:"type"`
+	Metadata *metadata.Metadata `json:"metadata,omitempty"`
+	Record   interface{}        `json:"record,omitempty"`
+}
+
+// StreamedPullRequestRecord is the JSON Lines shape of a PR: what is known as soon as it is fetched,
+// before enrichment (so no stage times).
+type StreamedPullRequestRecord struct {
+	Number        int      `json:"number"`
+	Title         string   `json:"title"`
+	URL           string   `json:"url,omitempty"`
+	Author        string   `json:"author"`
+	State         string   `json:"state"`
+	IsDraft       bool     `json:"isDraft"`
+	BaseRef       string   `json:"baseRef"`
+	HeadRef       string   `json:"headRef"`
+	CreatedAt     string   `json:"createdAt"`
+	MergedAt      string   `json:"mergedAt,omitempty"`
+	ClosedAt      string   `json:"closedAt,omitempty"`
+	Merged        bool     `json:"merged"`
+	MergedBy      string   `json:"mergedBy,omitempty"`
+	LeadTimeHours float64  `json:"leadTimeHours"`
+	Additions     int      `json:"additions"`
+	Deletions     int      `json:"deletions"`
+	ChangedFiles  int      `json:"changedFiles"`
+	Labels        []string `json:"labels"`
+	Reviewers     []string `json:"reviewers"`
+}
+
+// WorkflowRunRecord is the exported JSON shape of a workflow run.
+type WorkflowRunRecord struct {
+	ID              int64   `json:"id"`
+	Workflow        string  `json:"workflow"`
+	Name            string  `json:"name"`
+	DisplayTitle    string  `json:"displayTitle"`
+	RunNumber       int     `json:"runNumber"`
+	Attempt         int     `json:"attempt"`
+	Event           string  `json:"event"`
+	HeadBranch      string  `json:"headBranch"`
+	Status          string  `json:"status"`
+	Conclusion      string  `json:"conclusion,omitempty"`
+	CreatedAt       string  `json:"createdAt"`
+	StartedAt       string  `json:"startedAt,omitempty"`
+	UpdatedAt       string  `json:"updatedAt,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"` // Start to last update of completed runs
+	URL             string  `json:"url"`
+}
+
+// LinesWriter streams records to a JSON Lines file, one object per line, flushing each line so readers
+// can follow the file while the scan is still running.
+type LinesWriter struct {
+	file    *os.File
+	buf     *bufio.Writer
+	encoder *json.Encoder
+	Count   int // Records written
+}
+
+// CreateLines creates filename and writes the metadata line.
+func CreateLines(filename string, meta metadata.Metadata) (*LinesWriter, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JSON Lines file: %w", err)
+	}
+	buf := bufio.NewWriter(file)
+	w := &LinesWriter{file: file, buf: buf, encoder: json.NewEncoder(buf)}
+	if err := w.write(line{Type: LineMetadata, Metadata: &meta}); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// WritePullRequest appends a PR line.
+func (w *LinesWriter) WritePullRequest(pr github.PullRequest) error {
+	w.Count++
+	return w.write(line{Type: LinePullRequest, Record: StreamedPullRequestRecord{
+		Number:        pr.Number,
+		Title:         pr.Title,
+		URL:           pr.URL,
+		Author:        pr.Author.Login,
+		State:         pr.State,
+		IsDraft:       pr.IsDraft,
+		BaseRef:       pr.BaseRefName,
+		HeadRef:       pr.HeadRefName,
+		CreatedAt:     formatTime(pr.CreatedAt),
+		MergedAt:      formatTime(pr.MergedAt),
+		ClosedAt:      formatTime(pr.ClosedAt),
+		Merged:        pr.Merged,
+		MergedBy:      pr.MergedBy.Login,
+		LeadTimeHours: pr.LeadTime.Hours(),
+		Additions:     pr.Additions,
+		Deletions:     pr.Deletions,
+		ChangedFiles:  pr.ChangedFiles,
+		Labels:        pr.LabelNames(),
+		Reviewers:     pr.ReviewerLogins(),
+	}})
+}
+
+// WriteWorkflowRun appends a workflow run line.
+func (w *LinesWriter) WriteWorkflowRun(run actions.WorkflowRun) error {
+	record := WorkflowRunRecord{
+		ID:           run.DatabaseId,
+		Workflow:     run.WorkflowName,
+		Name:         run.Name,
+		DisplayTitle: run.DisplayTitle,
+		RunNumber:    run.Number,
+		Attempt:      run.Attempt,
+		Event:        run.Event,
+		HeadBranch:   run.HeadBranch,
+		Status:       run.Status,
+		Conclusion:   run.Conclusion,
+		CreatedAt:    formatTime(run.CreatedAt),
+		StartedAt:    formatTime(run.StartedAt),
+		UpdatedAt:    formatTime(run.UpdatedAt),
+		URL:          run.URL,
+	}
+	if run.Status == "completed" && !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+		record.DurationSeconds = run.UpdatedAt.Sub(run.StartedAt).Seconds()
+	}
+	w.Count++
+	return w.write(line{Type: LineWorkflowRun, Record: record})
+}
+
+// write encodes one line and flushes it to the file.
+func (w *LinesWriter) write(l line) error {
+	if err := w.encoder.Encode(l); err != nil {
+		return fmt.Errorf("failed to write JSON Lines record: %w", err)
+	}
+	if err := w.buf.Flush(); err != nil {
+		return fmt.Errorf("failed to write JSON Lines record: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (w *LinesWriter) Close() error {
+	return w.file.Close()
+}
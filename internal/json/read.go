@@ -0,0 +1,68 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// ReadPullRequestsFromJSON reads PRs back from a file written by WritePullRequestsToJSON, or a bare array
+// of records as written before the metadata block was added. Calculated fields (lead, coding, pickup,
+// review and merge time) are ignored and recomputed by the analysis.
+func ReadPullRequestsFromJSON(filename string) ([]github.PullRequest, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JSON file: %w", err)
+	}
+	var records []PullRequestRecord
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(data, &records)
+	} else {
+		var doc struct {
+			Records []PullRequestRecord `json:"records"`
+		}
+		err = json.Unmarshal(data, &doc)
+		records = doc.Records
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	prs := make([]github.PullRequest, 0, len(records))
+	for i, r := range records {
+		var pr github.PullRequest
+		pr.Number = r.Number
+		if pr.CreatedAt, err = parseTime(r.CreatedAt); err != nil || pr.CreatedAt.IsZero() {
+			return nil, fmt.Errorf("record %d: invalid createdAt %q", i+1, r.CreatedAt)
+		}
+		pr.MergedAt, _ = parseTime(r.MergedAt)
+		pr.ClosedAt, _ = parseTime(r.ClosedAt)
+		pr.Title = r.Title
+		pr.URL = r.URL
+		pr.Author.Login = r.Author
+		pr.MergedBy.Login = r.MergedBy
+		pr.State = strings.ToUpper(r.State)
+		pr.BaseRefName = r.BaseRef
+		pr.HeadRefName = r.HeadRef
+		pr.IsDraft = r.IsDraft
+		pr.Additions = r.Additions
+		pr.Deletions = r.Deletions
+		pr.ChangedFiles = r.ChangedFiles
+		for _, name := range r.Labels {
+			pr.Labels = append(pr.Labels, github.Label{Name: name})
+		}
+		prs = append(prs, pr)
+	}
+	return prs, nil
+}
+
+// parseTime parses an RFC3339 timestamp written by formatTime; an empty value is the zero time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,74 @@
+package mermaid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// ganttTimeFormat is the Go layout matching the diagram's dateFormat.
+const ganttTimeFormat = "2006-01-02T15:04"
+
+// Stage is one bar of a PR's gantt section.
+type Stage struct {
+	Name       string
+	Start, End time.Time
+}
+
+// PRStages returns the cycle-time stages of pr (see stats.CalculateCycleStages) as time ranges.
+// Stages of zero length are left out; an unmerged PR ends with its open stage.
+func PRStages(pr github.PullRequest) []Stage {
+	reviewable := pr.ReviewableAt()
+	firstReview := reviewable.Add(pr.PickupTime)
+	approval := firstReview.Add(pr.ReviewTime)
+
+	candidates := []Stage{
+		{"Coding", pr.FirstCommitAt, pr.CreatedAt},
+		{"Pickup", reviewable, firstReview},
+		{"Review", firstReview, approval},
+	}
+	if pr.Merged {
+		candidates = append(candidates, Stage{"Merge", pr.MergedAt.Add(-pr.MergeTime), pr.MergedAt})
+	}
+
+	var stages []Stage
+	for _, s := range candidates {
+		if !s.Start.IsZero() && s.End.After(s.Start) {
+			stages = append(stages, s)
+		}
+	}
+	return stages
+}
+
+// PRGantt renders a Mermaid gantt diagram with one section per PR and a bar per stage, in UTC.
+func PRGantt(title string, prs []github.PullRequest) string {
+	var b strings.Builder
+	b.WriteString("gantt\n")
+	fmt.Fprintf(&b, "    title %s\n", sanitize(title))
+	b.WriteString("    dateFormat YYYY-MM-DDTHH:mm\n")
+	b.WriteString("    axisFormat %m-%d %H:%M\n")
+	for _, pr := range prs {
+		stages := PRStages(pr)
+		if len(stages) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "    section #%d %s\n", pr.Number, sanitize(pr.Title))
+		for i, s := range stages {
+			fmt.Fprintf(&b, "    %s :pr%d_%d, %s, %s\n", s.Name, pr.Number, i,
+				s.Start.UTC().Format(ganttTimeFormat), s.End.UTC().Format(ganttTimeFormat))
+		}
+	}
+	return b.String()
+}
+
+// sanitize keeps titles from breaking the gantt syntax, where ':' separates a task from its data,
+// ';' ends a statement and '%%' starts a comment.
+func sanitize(s string) string {
+	s = strings.NewReplacer(":", " -", ";", ",", "%", "", "\n", " ", "\r", "").Replace(s)
+	const maxLen = 60
+	if runes := []rune(s); len(runes) > maxLen {
+		s = string(runes[:maxLen-1]) + "…"
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,165 @@
+// Package metadata describes how exported numbers were computed, so CSV/JSON consumers can tell which
+// metric definitions, heuristics and sampling limits a file was produced with.
+package metadata
+
+import "time"
+
+// SchemaVersion is the version of the export columns and metric definitions. Bump it and add a
+// Changelog entry whenever a column is added or a definition changes.
+const SchemaVersion = 9
+
+// Export kinds.
+const (
+	PullRequests     = "pull_requests"
+	ActivityCalendar = "activity_calendar"
+	Scorecard        = "scorecard"
+	WorkflowRuns     = "workflow_runs"
+	PRStream         = "pull_request_stream"
+	WorkflowFailures = "workflow_failures"
+)
+
+// Metadata is the block embedded in every export.
+type Metadata struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	Generator     string       `json:"generator"`
+	GeneratedAt   string       `json:"generatedAt"`
+	Export        string       `json:"export"`
+	Repo          string       `json:"repo,omitempty"`
+	Since         string       `json:"since,omitempty"`
+	Until         string       `json:"until,omitempty"`
+	Definitions   []Definition `json:"definitions"`
+	Heuristics    []string     `json:"heuristics"`
+	Limits        []string     `json:"limits"` // Sampling limits that may truncate the underlying data
+	Changelog     []Change     `json:"changelog"`
+}
+
+// Definition explains how one exported field is computed.
+type Definition struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Change lists the definition changes introduced by a schema version.
+type Change struct {
+	SchemaVersion int      `json:"schemaVersion"`
+	Changes       []string `json:"changes"`
+}
+
+// Changelog is the history of schema versions, oldest first.
+var Changelog = []Change{
+	{SchemaVersion: 1, Changes: []string{"First versioned export format with an embedded metadata block."}},
+	{SchemaVersion: 2, Changes: []string{"Added the workflow_runs export (Parquet) and Parquet output of pull_requests."}},
+	{SchemaVersion: 3, Changes: []string{"Added JSON Lines output: the pull_request_stream export (PRs as fetched, before enrichment) and workflow_runs."}},
+	{SchemaVersion: 4, Changes: []string{"Added labels to the pull_requests export."}},
+	{SchemaVersion: 5, Changes: []string{"Added the base and head branch names (baseRef, headRef) to the pull_requests export."}},
+	{SchemaVersion: 6, Changes: []string{"Added the PR web URL (url) to the pull_requests and pull_request_stream exports."}},
+	{SchemaVersion: 7, Changes: []string{"Added reviewers, reviews, approvals, firstReviewAt and reviewComments to the pull_requests CSV export; reviewers was JSON only."}},
+	{SchemaVersion: 8, Changes: []string{"Added the workflow_failures export (CSV and JSON)."}},
+	{SchemaVersion: 9, Changes: []string{"The scorecard mergeRate is the share of all PRs created in the period that were merged, as in the PR report; it was the share of closed PRs."}},
+}
+
+// Scope is the repository and period an export covers; empty fields are omitted.
+type Scope struct {
+	Repo  string
+	Since string
+	Until string
+}
+
+// New returns the metadata of an export kind covering scope, generated at now.
+func New(export string, scope Scope, now time.Time) Metadata {
+	return Metadata{
+		SchemaVersion: SchemaVersion,
+		Generator:     "visuche",
+		GeneratedAt:   now.UTC().Format(time.RFC3339),
+		Export:        export,
+		Repo:          scope.Repo,
+		Since:         scope.Since,
+		Until:         scope.Until,
+		Definitions:   definitions[export],
+		Heuristics:    heuristics[export],
+		Limits:        limits[export],
+		Changelog:     Changelog,
+	}
+}
+
+// prHeuristics apply to every export built from PRs.
+var prHeuristics = []string{
+	"PRs authored by bots (logins ending in [bot], app/ logins and known merge bots such as bors or mergify) are excluded.",
+	"The period selects PRs by creation date (inclusive, UTC).",
+}
+
+// prLimits apply to every export built from PRs fetched from GitHub.
+var prLimits = []string{
+	"Up to 100 reviews, 20 pending review requests and 100 labels are fetched per PR.",
+}
+
+// runLimit applies to every export built from workflow runs.
+const runLimit = "Up to 500 workflow runs are fetched per repository."
+
+// failureDetailLimit applies to the failed job and step of workflow failures.
+const failureDetailLimit = "The failed job and step are looked up for every failure, or for the --failure-detail-limit most recent ones."
+
+var definitions = map[string][]Definition{
+	PullRequests: {
+		{"leadTime", "Merged PRs only: from creation (or the last ready-for-review with --exclude-draft-time) to merge, in hours; 0 for unmerged PRs."},
+		{"codingTime", "From the earliest commit on the PR to PR creation, in hours; 0 when commits are unknown."},
+		{"pickupTime", "From creation (or ready-for-review) to the first submitted review, in hours."},
+		{"reviewTime", "From the first submitted review to the last approval, in hours."},
+		{"mergeTime", "From the last approval to merge, in hours."},
+		{"automatedMerge", "Merged with GitHub auto-merge or by a bot account."},
+		{"reviewers", "Distinct logins that submitted a review, excluding the PR author; separated by \";\" in CSV."},
+		{"reviews", "Reviews submitted by people other than the PR author, approvals included (CSV only)."},
+		{"approvals", "Approving reviews submitted by people other than the PR author (CSV only)."},
+		{"firstReviewAt", "When the first review by someone other than the PR author was submitted, empty when nobody did (CSV only)."},
+		{"reviewComments", "Inline review comments plus approvals; counted for merged and closed PRs only, 0 otherwise (CSV only)."},
+		{"baseRef", "Branch the PR targets (merges into)."},
+		{"headRef", "Branch the PR was opened from."},
+		{"labels", "Label names of the PR; separated by \";\" in CSV and Parquet."},
+		{"commits", "Always 0 in CSV; commit counts are not fetched (CSV only)."},
+	},
+	PRStream: {
+		{"leadTimeHours", "Merged PRs only: from creation to merge, in hours; 0 for unmerged PRs. --exclude-draft-time is not applied."},
+		{"reviewers", "Distinct logins that submitted a review, excluding the PR author."},
+	},
+	WorkflowFailures: {
+		{"durationSeconds", "From the run's start to its last update, in seconds; 0 when it never started."},
+		{"failedJob", "First failed job of the run (GitLab: failed job of the pipeline); empty when not looked up."},
+		{"failedStep", "First failed step of the failed job (GitLab: its stage); empty when not looked up."},
+	},
+	ActivityCalendar: {
+		{"prsOpened", "PRs the author created on that day (UTC)."},
+		{"prsMerged", "PRs of the author merged on that day (UTC)."},
+		{"reviewsGiven", "Reviews the author submitted on other people's PRs on that day (UTC)."},
+	},
+	Scorecard: {
+		{"mergedPRs", "PRs created in the period and merged."},
+		{"medianLeadTime", "Median time from creation to merge of the merged PRs, in hours."},
+		{"mergeRate", "Percentage of the PRs created in the period (open ones included) that were merged, as in the PR report."},
+		{"ciSuccessRate", "Percentage of completed workflow runs in the period that succeeded."},
+		{"medianOpenAge", "Median age of the currently open PRs, in hours."},
+	},
+}
+
+var heuristics = map[string][]string{
+	PullRequests:     prHeuristics,
+	ActivityCalendar: prHeuristics,
+	Scorecard:        prHeuristics,
+	WorkflowRuns:     {"The period selects runs by creation date (inclusive, UTC)."},
+	WorkflowFailures: {
+		"Runs concluding in failure, cancelled or timed_out count as failures.",
+		"The period selects runs by creation date (inclusive, UTC).",
+	},
+	PRStream: {
+		"PRs are written as they are fetched, so bot PRs are included; filter on author downstream if needed.",
+		"The period selects PRs by creation date (inclusive, UTC).",
+	},
+}
+
+var limits = map[string][]string{
+	PullRequests:     prLimits,
+	ActivityCalendar: prLimits,
+	Scorecard:        append([]string{runLimit}, prLimits...),
+	WorkflowRuns:     {runLimit},
+	WorkflowFailures: {runLimit, failureDetailLimit},
+	PRStream:         prLimits,
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/stats"
+)
+
+// Gauge is a computed value pushed to an external metrics backend.
+type Gauge struct {
+	Name   string
+	Unit   string // UCUM unit: h, %, s or a {annotation} for counts
+	Value  float64
+	Labels map[string]string
+}
+
+// PRGauges returns the key PR metrics of s, each carrying labels. Metrics left out with --metrics are skipped.
+func PRGauges(s stats.Stats, labels map[string]string) []Gauge {
+	hours := func(d time.Duration) float64 { return d.Hours() }
+	all := []struct {
+		metric string // Calculator the value comes from
+		Gauge
+	}{
+		{"volume", Gauge{Name: "visuche.pr.total", Unit: "{pr}", Value: float64(s.TotalPRs)}},
+		{"volume", Gauge{Name: "visuche.pr.merged", Unit: "{pr}", Value: float64(s.MergedPRs)}},
+		{"lead-time", Gauge{Name: "visuche.pr.lead_time.avg", Unit: "h", Value: hours(s.AverageLeadTime)}},
+		{"lead-time", Gauge{Name: "visuche.pr.lead_time.median", Unit: "h", Value: hours(s.MedianLeadTime)}},
+		{"lead-time", Gauge{Name: "visuche.pr.lead_time.p90", Unit: "h", Value: hours(s.LeadTimeSummary.P90)}},
+		{"comment-timing", Gauge{Name: "visuche.pr.first_review.median", Unit: "h", Value: hours(s.MedianTimeToFirstReview)}},
+		{"cycle-stages", Gauge{Name: "visuche.pr.pickup_time.median", Unit: "h", Value: hours(s.MedianPickupTime)}},
+		{"cycle-stages", Gauge{Name: "visuche.pr.review_time.median", Unit: "h", Value: hours(s.MedianReviewStageTime)}},
+		{"approval-to-merge", Gauge{Name: "visuche.pr.approval_to_merge.median", Unit: "h", Value: hours(s.MedianApprovalToMerge)}},
+		{"comments", Gauge{Name: "visuche.pr.comments_per_pr.avg", Unit: "{comment}", Value: s.AverageCommentsPerPR}},
+		{"self-merge", Gauge{Name: "visuche.pr.self_merge_rate", Unit: "%", Value: s.SelfMergeRate}},
+		{"reopens", Gauge{Name: "visuche.pr.reopen_rate", Unit: "%", Value: s.ReopenRate}},
+		{"releases", Gauge{Name: "visuche.release.count", Unit: "{release}", Value: float64(s.ReleaseCount)}},
+		{"releases", Gauge{Name: "visuche.release.per_week", Unit: "{release}/wk", Value: s.ReleasesPerWeek}},
+	}
+	var gauges []Gauge
+	for _, g := range all {
+		if s.Computed(g.metric) {
+			g.Labels = labels
+			gauges = append(gauges, g.Gauge)
+		}
+	}
+	return gauges
+}
+
+// CIGauges returns the key workflow run metrics of a, each carrying labels.
+func CIGauges(a actions.WorkflowAnalytics, labels map[string]string) []Gauge {
+	var successRate float64
+	if a.TotalRuns > 0 {
+		successRate = float64(a.TotalSuccesses) / float64(a.TotalRuns) * 100
+	}
+	return []Gauge{
+		{Name: "visuche.ci.runs", Unit: "{run}", Value: float64(a.TotalRuns), Labels: labels},
+		{Name: "visuche.ci.failures", Unit: "{run}", Value: float64(a.TotalFailures), Labels: labels},
+		{Name: "visuche.ci.success_rate", Unit: "%", Value: successRate, Labels: labels},
+		{Name: "visuche.ci.duration.avg", Unit: "s", Value: float64(a.AverageDurationMs) / 1000, Labels: labels},
+	}
+}
+
+// WithLabel returns a copy of labels with key set to value.
+func WithLabel(labels map[string]string, key, value string) map[string]string {
+	out := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
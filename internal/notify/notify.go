@@ -0,0 +1,194 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Supported destination formats.
+const (
+	FormatSlack   = "slack"
+	FormatTeams   = "teams"
+	FormatDiscord = "discord"
+)
+
+// Summary is the format-independent content of a run notification.
+type Summary struct {
+	Title  string
+	Text   string // Subtitle such as the analyzed period
+	Fields []Field
+	URL    string // Optional link to the full report
+}
+
+// Field is one labelled metric in a summary.
+type Field struct {
+	Label string
+	Value string
+}
+
+// Destination is a webhook to deliver summaries to.
+type Destination struct {
+	Type string `json:"type"` // slack, teams or discord
+	URL  string `json:"url"`
+}
+
+// Validate checks the destination type and URL.
+func (d Destination) Validate() error {
+	switch d.Type {
+	case FormatSlack, FormatTeams, FormatDiscord:
+	default:
+		return fmt.Errorf("unsupported notification type %q (supported: slack, teams, discord)", d.Type)
+	}
+	if !strings.HasPrefix(d.URL, "https://") && !strings.HasPrefix(d.URL, "http://") {
+		return fmt.Errorf("%s notification needs a webhook url", d.Type)
+	}
+	return nil
+}
+
+// Payload renders s as the webhook body expected by format.
+func Payload(format string, s Summary) (interface{}, error) {
+	switch format {
+	case FormatSlack:
+		return slackPayload(s), nil
+	case FormatTeams:
+		return teamsPayload(s), nil
+	case FormatDiscord:
+		return discordPayload(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported notification type %q", format)
+	}
+}
+
+// Send posts s to the destination's webhook.
+func Send(d Destination, s Summary) error {
+	payload, err := Payload(d.Type, s)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Post(d.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s webhook returned %s: %s", d.Type, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Markdown renders s as a GitHub-flavored Markdown section with a metrics table.
+func Markdown(s Summary) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n", s.Title)
+	if s.Text != "" {
+		fmt.Fprintf(&b, "%s\n\n", s.Text)
+	}
+	if len(s.Fields) > 0 {
+		b.WriteString("| Metric | Value |\n| --- | --- |\n")
+		for _, f := range s.Fields {
+			fmt.Fprintf(&b, "| %s | %s |\n", escapeCell(f.Label), escapeCell(f.Value))
+		}
+	}
+	if s.URL != "" {
+		fmt.Fprintf(&b, "\n[Full report](%s)\n", s.URL)
+	}
+	return b.String()
+}
+
+// escapeCell keeps pipes from splitting a Markdown table cell.
+func escapeCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// slackPayload uses Block Kit with a plain-text fallback for notifications.
+func slackPayload(s Summary) map[string]interface{} {
+	var fields []map[string]string
+	for _, f := range s.Fields {
+		fields = append(fields, map[string]string{"type": "mrkdwn", "text": fmt.Sprintf("*%s*\n%s", f.Label, f.Value)})
+	}
+	blocks := []map[string]interface{}{
+		{"type": "header", "text": map[string]string{"type": "plain_text", "text": s.Title}},
+	}
+	if s.Text != "" {
+		blocks = append(blocks, map[string]interface{}{"type": "context", "elements": []map[string]string{{"type": "mrkdwn", "text": s.Text}}})
+	}
+	// Slack allows at most 10 fields per section
+	for start := 0; start < len(fields); start += 10 {
+		end := start + 10
+		if end > len(fields) {
+			end = len(fields)
+		}
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields[start:end]})
+	}
+	if s.URL != "" {
+		blocks = append(blocks, map[string]interface{}{"type": "section", "text": map[string]string{"type": "mrkdwn", "text": fmt.Sprintf("<%s|Full report>", s.URL)}})
+	}
+	return map[string]interface{}{"text": s.Title, "blocks": blocks}
+}
+
+// teamsPayload wraps an Adaptive Card in the message envelope accepted by Teams incoming webhooks and workflows.
+func teamsPayload(s Summary) map[string]interface{} {
+	body := []map[string]interface{}{
+		{"type": "TextBlock", "text": s.Title, "weight": "Bolder", "size": "Medium", "wrap": true},
+	}
+	if s.Text != "" {
+		body = append(body, map[string]interface{}{"type": "TextBlock", "text": s.Text, "isSubtle": true, "spacing": "None", "wrap": true})
+	}
+	var facts []map[string]string
+	for _, f := range s.Fields {
+		facts = append(facts, map[string]string{"title": f.Label, "value": f.Value})
+	}
+	if len(facts) > 0 {
+		body = append(body, map[string]interface{}{"type": "FactSet", "facts": facts})
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if s.URL != "" {
+		card["actions"] = []map[string]string{{"type": "Action.OpenUrl", "title": "Full report", "url": s.URL}}
+	}
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []map[string]interface{}{
+			{"contentType": "application/vnd.microsoft.card.adaptive", "content": card},
+		},
+	}
+}
+
+// discordPayload renders a single embed with inline fields.
+func discordPayload(s Summary) map[string]interface{} {
+	var fields []map[string]interface{}
+	for _, f := range s.Fields {
+		fields = append(fields, map[string]interface{}{"name": f.Label, "value": f.Value, "inline": true})
+	}
+	// Discord allows at most 25 fields per embed
+	if len(fields) > 25 {
+		fields = fields[:25]
+	}
+	embed := map[string]interface{}{
+		"title":       s.Title,
+		"description": s.Text,
+		"fields":      fields,
+		"color":       0x2EA043,
+	}
+	if s.URL != "" {
+		embed["url"] = s.URL
+	}
+	return map[string]interface{}{"embeds": []map[string]interface{}{embed}}
+}
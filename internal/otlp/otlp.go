@@ -0,0 +1,234 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"visuche/internal/metrics"
+)
+
+const defaultEndpoint = "http://localhost:4318"
+
+// Exporter pushes gauges to an OpenTelemetry collector over OTLP/HTTP with JSON encoding.
+type Exporter struct {
+	Endpoint string            // Full URL of the metrics endpoint, e.g. http://localhost:4318/v1/metrics
+	Headers  map[string]string // Extra request headers (e.g. authentication)
+	Resource map[string]string // Resource attributes; service.name is always present
+	HTTP     *http.Client
+}
+
+// FromEnv configures an exporter from the standard OTEL_* environment variables:
+// OTEL_EXPORTER_OTLP_[METRICS_]ENDPOINT, _HEADERS, _TIMEOUT and _PROTOCOL, OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES.
+// Only the http/json protocol is supported.
+func FromEnv() (*Exporter, error) {
+	protocol := envFirst("OTEL_EXPORTER_OTLP_METRICS_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL")
+	if protocol != "" && protocol != "http/json" {
+		return nil, fmt.Errorf("unsupported OTLP protocol %q (supported: http/json)", protocol)
+	}
+
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT")
+	if endpoint == "" {
+		base := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		if base == "" {
+			base = defaultEndpoint
+		}
+		endpoint = strings.TrimRight(base, "/") + "/v1/metrics"
+	}
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: %w", endpoint, err)
+	}
+
+	headers, err := parseKeyValues(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_HEADERS: %w", err)
+	}
+	metricHeaders, err := parseKeyValues(os.Getenv("OTEL_EXPORTER_OTLP_METRICS_HEADERS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_METRICS_HEADERS: %w", err)
+	}
+	for k, v := range metricHeaders {
+		headers[k] = v
+	}
+
+	timeout := 10 * time.Second
+	if raw := envFirst("OTEL_EXPORTER_OTLP_METRICS_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT"); raw != "" {
+		ms, err := strconv.Atoi(raw)
+		if err != nil || ms <= 0 {
+			return nil, fmt.Errorf("invalid OTLP timeout %q: expected milliseconds", raw)
+		}
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	resource, err := parseKeyValues(os.Getenv("OTEL_RESOURCE_ATTRIBUTES"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTEL_RESOURCE_ATTRIBUTES: %w", err)
+	}
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		resource["service.name"] = name
+	} else if resource["service.name"] == "" {
+		resource["service.name"] = "visuche"
+	}
+
+	return &Exporter{
+		Endpoint: endpoint,
+		Headers:  headers,
+		Resource: resource,
+		HTTP:     &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Export sends gauges as a single OTLP metrics request, timestamped now.
+func (e *Exporter) Export(gauges []metrics.Gauge) error {
+	body, err := json.Marshal(buildRequest(e.Resource, gauges, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("OTLP collector returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// The types below mirror the OTLP/JSON encoding of ExportMetricsServiceRequest, limited to gauges.
+
+type exportRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type metric struct {
+	Name  string `json:"name"`
+	Unit  string `json:"unit,omitempty"`
+	Gauge gauge  `json:"gauge"`
+}
+
+type gauge struct {
+	DataPoints []dataPoint `json:"dataPoints"`
+}
+
+type dataPoint struct {
+	Attributes   []keyValue `json:"attributes,omitempty"`
+	TimeUnixNano string     `json:"timeUnixNano"`
+	AsDouble     float64    `json:"asDouble"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// buildRequest groups gauges with the same name into one metric with a data point per label set.
+func buildRequest(resourceAttrs map[string]string, gauges []metrics.Gauge, now time.Time) exportRequest {
+	timestamp := strconv.FormatInt(now.UnixNano(), 10)
+	var ordered []metric
+	index := make(map[string]int)
+	for _, g := range gauges {
+		i, ok := index[g.Name]
+		if !ok {
+			i = len(ordered)
+			index[g.Name] = i
+			ordered = append(ordered, metric{Name: g.Name, Unit: g.Unit})
+		}
+		ordered[i].Gauge.DataPoints = append(ordered[i].Gauge.DataPoints, dataPoint{
+			Attributes:   attributes(g.Labels),
+			TimeUnixNano: timestamp,
+			AsDouble:     g.Value,
+		})
+	}
+
+	return exportRequest{ResourceMetrics: []resourceMetrics{{
+		Resource:     resource{Attributes: attributes(resourceAttrs)},
+		ScopeMetrics: []scopeMetrics{{Scope: scope{Name: "visuche"}, Metrics: ordered}},
+	}}}
+}
+
+// attributes converts labels to OTLP attributes sorted by key.
+func attributes(labels map[string]string) []keyValue {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]keyValue, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, keyValue{Key: k, Value: anyValue{StringValue: labels[k]}})
+	}
+	return attrs
+}
+
+// parseKeyValues parses the W3C baggage-like "k1=v1,k2=v2" format used by OTEL_* variables.
+// Values are URL-decoded.
+func parseKeyValues(raw string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("malformed entry %q", pair)
+		}
+		decoded, err := url.QueryUnescape(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("malformed value for %q: %w", key, err)
+		}
+		out[strings.TrimSpace(key)] = decoded
+	}
+	return out, nil
+}
+
+// envFirst returns the first non-empty value among the named variables.
+func envFirst(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/metadata"
+)
+
+// MetadataKey is the footer key/value entry holding the metadata block (JSON).
+const MetadataKey = "visuche.metadata"
+
+var pullRequestColumns = []Column{
+	{"number", Int64, false},
+	{"title", String, false},
+	{"url", String, true},
+	{"author", String, false},
+	{"state", String, false},
+	{"is_draft", Boolean, false},
+	{"base_ref", String, false},
+	{"head_ref", String, false},
+	{"created_at", Timestamp, false},
+	{"merged_at", Timestamp, true},
+	{"closed_at", Timestamp, true},
+	{"merged", Boolean, false},
+	{"merged_by", String, true},
+	{"automated_merge", Boolean, false},
+	{"labels", String, false},
+	{"additions", Int64, false},
+	{"deletions", Int64, false},
+	{"changed_files", Int64, false},
+	{"lead_time_hours", Double, false},
+	{"first_commit_at", Timestamp, true},
+	{"coding_time_hours", Double, false},
+	{"pickup_time_hours", Double, false},
+	{"review_time_hours", Double, false},
+	{"merge_time_hours", Double, false},
+}
+
+var workflowRunColumns = []Column{
+	{"id", Int64, false},
+	{"workflow", String, false},
+	{"name", String, false},
+	{"display_title", String, false},
+	{"run_number", Int64, false},
+	{"attempt", Int64, false},
+	{"event", String, false},
+	{"head_branch", String, false},
+	{"status", String, false},
+	{"conclusion", String, true},
+	{"created_at", Timestamp, false},
+	{"started_at", Timestamp, true},
+	{"updated_at", Timestamp, true},
+	{"duration_seconds", Double, true},
+	{"url", String, false},
+}
+
+// WritePullRequestsToParquet writes one row per PR to a Parquet file.
+func WritePullRequestsToParquet(filename string, prs []github.PullRequest, meta metadata.Metadata) error {
+	rows := make([]Row, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, Row{
+			pr.Number,
+			pr.Title,
+			nullIfEmpty(pr.URL),
+			pr.Author.Login,
+			pr.State,
+			pr.IsDraft,
+			pr.BaseRefName,
+			pr.HeadRefName,
+			pr.CreatedAt,
+			pr.MergedAt,
+			pr.ClosedAt,
+			pr.Merged,
+			nullIfEmpty(pr.MergedBy.Login),
+			pr.IsAutomatedMerge(),
+			strings.Join(pr.LabelNames(), ";"),
+			pr.Additions,
+			pr.Deletions,
+			pr.ChangedFiles,
+			pr.LeadTime.Hours(),
+			pr.FirstCommitAt,
+			pr.CodingTime.Hours(),
+			pr.PickupTime.Hours(),
+			pr.ReviewTime.Hours(),
+			pr.MergeTime.Hours(),
+		})
+	}
+	return writeFile(filename, pullRequestColumns, rows, meta)
+}
+
+// WriteWorkflowRunsToParquet writes one row per workflow run to a Parquet file. The duration runs from
+// start to the last update and is null for runs that have not started or finished.
+func WriteWorkflowRunsToParquet(filename string, runs []actions.WorkflowRun, meta metadata.Metadata) error {
+	rows := make([]Row, 0, len(runs))
+	for _, run := range runs {
+		var duration interface{}
+		if run.Status == "completed" && !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+			duration = run.UpdatedAt.Sub(run.StartedAt).Seconds()
+		}
+		rows = append(rows, Row{
+			run.DatabaseId,
+			run.WorkflowName,
+			run.Name,
+			run.DisplayTitle,
+			run.Number,
+			run.Attempt,
+			run.Event,
+			run.HeadBranch,
+			run.Status,
+			nullIfEmpty(run.Conclusion),
+			run.CreatedAt,
+			run.StartedAt,
+			run.UpdatedAt,
+			duration,
+			run.URL,
+		})
+	}
+	return writeFile(filename, workflowRunColumns, rows, meta)
+}
+
+// nullIfEmpty maps an empty string to null.
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// writeFile writes rows to filename with meta in the footer.
+func writeFile(filename string, columns []Column, rows []Row, meta metadata.Metadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create Parquet file: %w", err)
+	}
+	defer file.Close()
+
+	if err := Write(file, columns, rows, map[string]string{MetadataKey: string(data)}); err != nil {
+		return err
+	}
+	return file.Close()
+}
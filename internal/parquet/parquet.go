@@ -0,0 +1,315 @@
+// Package parquet writes flat datasets as Parquet files for data lakes and warehouses. It implements the
+// subset of the format visuche needs: one row group of uncompressed, PLAIN-encoded columns (int64,
+// double, boolean, UTF-8 string and millisecond UTC timestamp), optionally nullable, with key/value
+// metadata in the footer.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// Type is the logical type of a column.
+type Type int
+
+const (
+	Int64 Type = iota
+	Double
+	Boolean
+	String
+	Timestamp // time.Time, stored as milliseconds since the epoch (UTC)
+)
+
+// Column describes one column of a dataset. Null values are only allowed in optional columns; a zero
+// time.Time is null in an optional Timestamp column.
+type Column struct {
+	Name     string
+	Type     Type
+	Optional bool
+}
+
+// Row holds one value per column, in column order: int64 (or int), float64, bool, string or time.Time,
+// or nil for null.
+type Row []interface{}
+
+// Parquet physical types, converted types, encodings and the data page type.
+const (
+	physicalBoolean   = 0
+	physicalInt64     = 2
+	physicalDouble    = 5
+	physicalByteArray = 6
+
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageTypeData = 0
+)
+
+const magic = "PAR1"
+
+// createdBy identifies the writer in the footer.
+const createdBy = "visuche"
+
+// Write writes rows as a Parquet file with the given columns and footer key/value metadata.
+func Write(w io.Writer, columns []Column, rows []Row, keyValues map[string]string) error {
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			return fmt.Errorf("row %d has %d values, expected %d", i, len(row), len(columns))
+		}
+	}
+
+	var file bytes.Buffer
+	file.WriteString(magic)
+
+	chunks := make([]columnChunk, len(columns))
+	for i, col := range columns {
+		page, err := encodePage(col, i, rows)
+		if err != nil {
+			return err
+		}
+		chunks[i] = columnChunk{offset: int64(file.Len()), size: int64(len(page))}
+		file.Write(page)
+	}
+
+	footer := encodeFileMetaData(columns, chunks, int64(len(rows)), keyValues)
+	file.Write(footer)
+	binary.Write(&file, binary.LittleEndian, uint32(len(footer)))
+	file.WriteString(magic)
+
+	if _, err := w.Write(file.Bytes()); err != nil {
+		return fmt.Errorf("failed to write Parquet file: %w", err)
+	}
+	return nil
+}
+
+// columnChunk is where a column's single data page landed in the file.
+type columnChunk struct {
+	offset, size int64
+}
+
+// encodePage encodes column index of rows as a data page with its header.
+func encodePage(col Column, index int, rows []Row) ([]byte, error) {
+	var levels []byte
+	var values bytes.Buffer
+	var booleans []bool
+	for i, row := range rows {
+		v := row[index]
+		if t, ok := v.(time.Time); ok && t.IsZero() && col.Optional {
+			v = nil
+		}
+		if v == nil {
+			if !col.Optional {
+				return nil, fmt.Errorf("row %d: null value in required column %s", i, col.Name)
+			}
+			levels = append(levels, 0)
+			continue
+		}
+		levels = append(levels, 1)
+
+		if err := encodeValue(&values, &booleans, col, v); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	if col.Type == Boolean {
+		values.Write(packBooleans(booleans))
+	}
+
+	var data bytes.Buffer
+	if col.Optional {
+		encoded := encodeLevels(levels)
+		binary.Write(&data, binary.LittleEndian, uint32(len(encoded)))
+		data.Write(encoded)
+	}
+	data.Write(values.Bytes())
+
+	header := thriftWriter{}
+	header.beginStruct()
+	header.i32Field(1, pageTypeData)
+	header.i32Field(2, int32(data.Len()))
+	header.i32Field(3, int32(data.Len()))
+	header.structField(5)
+	header.i32Field(1, int32(len(rows)))
+	header.i32Field(2, encodingPlain)
+	header.i32Field(3, encodingRLE)
+	header.i32Field(4, encodingRLE)
+	header.endStruct()
+	header.endStruct()
+
+	return append(header.buf.Bytes(), data.Bytes()...), nil
+}
+
+// encodeValue appends a PLAIN-encoded value; booleans are collected and bit-packed at the end.
+func encodeValue(values *bytes.Buffer, booleans *[]bool, col Column, v interface{}) error {
+	mismatch := fmt.Errorf("column %s: unexpected value %v (%T)", col.Name, v, v)
+	switch col.Type {
+	case Int64:
+		switch n := v.(type) {
+		case int64:
+			binary.Write(values, binary.LittleEndian, n)
+		case int:
+			binary.Write(values, binary.LittleEndian, int64(n))
+		default:
+			return mismatch
+		}
+	case Double:
+		f, ok := v.(float64)
+		if !ok {
+			return mismatch
+		}
+		binary.Write(values, binary.LittleEndian, math.Float64bits(f))
+	case Boolean:
+		b, ok := v.(bool)
+		if !ok {
+			return mismatch
+		}
+		*booleans = append(*booleans, b)
+	case String:
+		s, ok := v.(string)
+		if !ok {
+			return mismatch
+		}
+		binary.Write(values, binary.LittleEndian, uint32(len(s)))
+		values.WriteString(s)
+	case Timestamp:
+		t, ok := v.(time.Time)
+		if !ok {
+			return mismatch
+		}
+		binary.Write(values, binary.LittleEndian, t.UnixMilli())
+	}
+	return nil
+}
+
+// packBooleans bit-packs booleans LSB first, as PLAIN encoding stores them.
+func packBooleans(booleans []bool) []byte {
+	packed := make([]byte, (len(booleans)+7)/8)
+	for i, b := range booleans {
+		if b {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	return packed
+}
+
+// encodeLevels encodes definition levels (0 or 1) with the RLE/bit-packing hybrid, using RLE runs only.
+func encodeLevels(levels []byte) []byte {
+	var out bytes.Buffer
+	var b [binary.MaxVarintLen64]byte
+	for i := 0; i < len(levels); {
+		j := i
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		n := binary.PutUvarint(b[:], uint64(j-i)<<1)
+		out.Write(b[:n])
+		out.WriteByte(levels[i]) // Bit width 1 fits in one byte
+		i = j
+	}
+	return out.Bytes()
+}
+
+// physicalType maps a column type to its Parquet physical and converted type (-1 for none).
+func physicalType(t Type) (physical, converted int32) {
+	switch t {
+	case Double:
+		return physicalDouble, -1
+	case Boolean:
+		return physicalBoolean, -1
+	case String:
+		return physicalByteArray, convertedUTF8
+	case Timestamp:
+		return physicalInt64, convertedTimestampMillis
+	default:
+		return physicalInt64, -1
+	}
+}
+
+// encodeFileMetaData encodes the footer: the schema, one row group with a chunk per column and the
+// key/value metadata.
+func encodeFileMetaData(columns []Column, chunks []columnChunk, numRows int64, keyValues map[string]string) []byte {
+	t := thriftWriter{}
+	t.beginStruct()
+	t.i32Field(1, 1) // Format version
+
+	t.listField(2, thriftStruct, len(columns)+1)
+	t.beginStruct()
+	t.stringField(4, "schema")
+	t.i32Field(5, int32(len(columns)))
+	t.endStruct()
+	for _, col := range columns {
+		physical, converted := physicalType(col.Type)
+		repetition := int32(repetitionRequired)
+		if col.Optional {
+			repetition = repetitionOptional
+		}
+		t.beginStruct()
+		t.i32Field(1, physical)
+		t.i32Field(3, repetition)
+		t.stringField(4, col.Name)
+		if converted >= 0 {
+			t.i32Field(6, converted)
+		}
+		t.endStruct()
+	}
+
+	t.i64Field(3, numRows)
+
+	var totalSize int64
+	for _, c := range chunks {
+		totalSize += c.size
+	}
+	t.listField(4, thriftStruct, 1)
+	t.beginStruct()
+	t.listField(1, thriftStruct, len(columns))
+	for i, col := range columns {
+		physical, _ := physicalType(col.Type)
+		t.beginStruct()
+		t.i64Field(2, chunks[i].offset)
+		t.structField(3)
+		t.i32Field(1, physical)
+		t.listField(2, thriftI32, 2)
+		t.zigzag(encodingPlain)
+		t.zigzag(encodingRLE)
+		t.listField(3, thriftBinary, 1)
+		t.binary(col.Name)
+		t.i32Field(4, 0) // Uncompressed
+		t.i64Field(5, numRows)
+		t.i64Field(6, chunks[i].size)
+		t.i64Field(7, chunks[i].size)
+		t.i64Field(9, chunks[i].offset)
+		t.endStruct()
+		t.endStruct()
+	}
+	t.i64Field(2, totalSize)
+	t.i64Field(3, numRows)
+	t.endStruct()
+
+	if len(keyValues) > 0 {
+		keys := make([]string, 0, len(keyValues))
+		for k := range keyValues {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		t.listField(5, thriftStruct, len(keys))
+		for _, k := range keys {
+			t.beginStruct()
+			t.stringField(1, k)
+			t.stringField(2, keyValues[k])
+			t.endStruct()
+		}
+	}
+	t.stringField(6, createdBy)
+	t.endStruct()
+	return t.buf.Bytes()
+}
@@ -0,0 +1,136 @@
+// Package progress reports the progress of long-running stages (fetching PRs, per-PR API calls,
+// failure log downloads) as machine-readable JSON lines on stderr, for wrapper tools and CI dashboards.
+// Reporting is off until SetFormat("json") is called.
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Formats accepted by SetFormat.
+const (
+	FormatNone = ""
+	FormatJSON = "json"
+)
+
+// Event types.
+const (
+	EventStart    = "start"
+	EventProgress = "progress"
+	EventEnd      = "end"
+)
+
+// Event is one progress line.
+type Event struct {
+	Event          string   `json:"event"`
+	Stage          string   `json:"stage"`
+	Done           int      `json:"done"`
+	Total          int      `json:"total,omitempty"` // Omitted when the number of items is not known upfront
+	ElapsedSeconds float64  `json:"elapsedSeconds"`
+	ETASeconds     *float64 `json:"etaSeconds,omitempty"` // Linear estimate; only when total is known and items are done
+	Time           string   `json:"time"`
+}
+
+// minInterval throttles progress events of a stage; start and end events are always written.
+const minInterval = 250 * time.Millisecond
+
+var (
+	mu      sync.Mutex
+	enabled bool
+	out     io.Writer = os.Stderr
+)
+
+// SetFormat sets the progress output format: FormatJSON writes events to stderr, FormatNone disables them.
+func SetFormat(format string) error {
+	switch format {
+	case FormatNone, FormatJSON:
+	default:
+		return fmt.Errorf("unsupported progress format %q (supported: json)", format)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = format == FormatJSON
+	return nil
+}
+
+// Stage tracks the items of one stage. A nil or disabled Stage ignores all calls, so callers need no checks.
+type Stage struct {
+	name     string
+	total    int
+	done     int
+	started  time.Time
+	lastSent time.Time
+	mu       sync.Mutex
+}
+
+// Start begins a stage of total items (0 when unknown) and writes its start event.
+func Start(name string, total int) *Stage {
+	mu.Lock()
+	on := enabled
+	mu.Unlock()
+	if !on {
+		return nil
+	}
+	s := &Stage{name: name, total: total, started: time.Now()}
+	s.send(EventStart, s.started)
+	return s
+}
+
+// Add marks n more items done. It is safe to call from several goroutines.
+func (s *Stage) Add(n int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.done += n
+	now := time.Now()
+	if now.Sub(s.lastSent) >= minInterval {
+		s.send(EventProgress, now)
+	}
+}
+
+// End writes the end event of the stage; done may be below total when the stage stopped early.
+func (s *Stage) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.send(EventEnd, time.Now())
+}
+
+// send writes an event; s.mu must be held (or s not yet shared).
+func (s *Stage) send(event string, now time.Time) {
+	s.lastSent = now
+	elapsed := now.Sub(s.started).Seconds()
+	e := Event{
+		Event:          event,
+		Stage:          s.name,
+		Done:           s.done,
+		Total:          s.total,
+		ElapsedSeconds: round(elapsed),
+		Time:           now.UTC().Format(time.RFC3339),
+	}
+	if event != EventEnd && s.total > 0 && s.done > 0 {
+		eta := round(elapsed / float64(s.done) * float64(s.total-s.done))
+		e.ETASeconds = &eta
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	out.Write(append(line, '\n'))
+}
+
+func round(seconds float64) float64 {
+	return float64(int64(seconds*10+0.5)) / 10
+}
@@ -0,0 +1,478 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+)
+
+const (
+	// PullRequestsFixture is the fixture file holding `gh pr list --json` output.
+	PullRequestsFixture = "pull_requests.json"
+	// WorkflowRunsFixture is the fixture file holding `gh run list --json` output.
+	WorkflowRunsFixture = "workflow_runs.json"
+	// LinkedIssuesFixture is the optional fixture mapping PR numbers to the issues they close.
+	LinkedIssuesFixture = "linked_issues.json"
+	// ReviewRequestsFixture is the optional fixture listing the reviewers requested on each PR.
+	ReviewRequestsFixture = "review_requests.json"
+	// MergeCommitsFixture is the optional fixture listing the merge method and landed size of merged PRs.
+	MergeCommitsFixture = "merge_commits.json"
+	// ReviewCommentsFixture is the optional fixture holding review bodies and inline review comments of PRs.
+	ReviewCommentsFixture = "review_comments.json"
+	// MaintainerResponsesFixture is the optional fixture listing the first maintainer response on PRs from forks.
+	MaintainerResponsesFixture = "maintainer_responses.json"
+	// MilestonesFixture is the optional fixture holding milestones (REST API shape) with their issues and PRs under "items".
+	MilestonesFixture = "milestones.json"
+	// TagsFixture is the optional fixture mapping tag names to the date of their commit.
+	TagsFixture = "tags.json"
+	// PRTimelinesFixture is the optional fixture holding the timeline events (review requests, comments, ...) of PRs.
+	PRTimelinesFixture = "pr_timelines.json"
+	// WorkflowJobsFixture is the optional fixture holding the jobs (`gh run view --json jobs`) of workflow runs.
+	WorkflowJobsFixture = "workflow_jobs.json"
+	// RunArtifactsFixture is the optional fixture holding the artifacts (GitHub REST API shape) of workflow runs.
+	RunArtifactsFixture = "run_artifacts.json"
+	// WorkflowFilesFixture is the optional directory holding the repository's workflow files.
+	WorkflowFilesFixture = "workflows"
+)
+
+// Mock is a fixture-backed provider that never touches the network.
+// Fixtures use the same JSON shape as the gh CLI output so real responses can be dropped in as-is.
+type Mock struct {
+	Dir string
+}
+
+// NewMock returns a mock provider reading fixtures from dir.
+func NewMock(dir string) Mock {
+	return Mock{Dir: dir}
+}
+
+// Name returns the provider name.
+func (Mock) Name() string { return "mock" }
+
+// FetchPullRequests loads pull requests from the fixture and applies the same filters as the real backends.
+func (m Mock) FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error) {
+	data, err := os.ReadFile(filepath.Join(m.Dir, PullRequestsFixture))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	prs, err := github.ParsePullRequests(data)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]github.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if !includeOpen && pr.State == "OPEN" {
+			continue
+		}
+		if author != "" && pr.Author.Login != author {
+			continue
+		}
+		if !inDateRange(pr.CreatedAt, since, until) {
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered, nil
+}
+
+// FetchOpenPullRequests returns the open PRs from the fixture.
+func (m Mock) FetchOpenPullRequests(repo string) ([]github.PullRequest, error) {
+	prs, err := m.FetchPullRequests(repo, "", "", "", "", true)
+	if err != nil {
+		return nil, err
+	}
+	open := make([]github.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if pr.State == "OPEN" {
+			open = append(open, pr)
+		}
+	}
+	return open, nil
+}
+
+// FetchPullRequest returns the PR with the given number from the fixture.
+func (m Mock) FetchPullRequest(repo string, number int) (github.PullRequest, error) {
+	prs, err := m.FetchPullRequests(repo, "", "", "", "", true)
+	if err != nil {
+		return github.PullRequest{}, err
+	}
+	for _, pr := range prs {
+		if pr.Number == number {
+			return pr, nil
+		}
+	}
+	return github.PullRequest{}, fmt.Errorf("PR #%d not found in %s", number, PullRequestsFixture)
+}
+
+// FetchTimelineEvents loads the PR's timeline events from the optional fixture; nil when it is absent.
+func (m Mock) FetchTimelineEvents(repo string, number int) ([]github.TimelineEvent, error) {
+	data, err := os.ReadFile(filepath.Join(m.Dir, PRTimelinesFixture))
+	if err != nil {
+		return nil, nil
+	}
+
+	var timelines []struct {
+		Number int                    `json:"number"`
+		Events []github.TimelineEvent `json:"events"`
+	}
+	if err := json.Unmarshal(data, &timelines); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", PRTimelinesFixture, err)
+	}
+	for _, timeline := range timelines {
+		if timeline.Number == number {
+			return timeline.Events, nil
+		}
+	}
+	return nil, nil
+}
+
+// mockMilestone is a milestone of the milestones fixture.
+type mockMilestone struct {
+	github.Milestone
+	Items []github.MilestoneItem `json:"items"`
+}
+
+func (m Mock) readMilestones() ([]mockMilestone, error) {
+	data, err := os.ReadFile(filepath.Join(m.Dir, MilestonesFixture))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	var milestones []mockMilestone
+	if err := json.Unmarshal(data, &milestones); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", MilestonesFixture, err)
+	}
+	return milestones, nil
+}
+
+// FetchMilestones returns the milestones of the optional fixture.
+func (m Mock) FetchMilestones(repo string) ([]github.Milestone, error) {
+	fixtures, err := m.readMilestones()
+	if err != nil {
+		return nil, err
+	}
+	milestones := make([]github.Milestone, len(fixtures))
+	for i, f := range fixtures {
+		milestones[i] = f.Milestone
+	}
+	return milestones, nil
+}
+
+// FetchMilestoneItems returns the items of a milestone from the optional fixture.
+func (m Mock) FetchMilestoneItems(repo string, number int) ([]github.MilestoneItem, error) {
+	fixtures, err := m.readMilestones()
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fixtures {
+		if f.Number == number {
+			return f.Items, nil
+		}
+	}
+	return nil, nil
+}
+
+// EnrichPullRequests is a no-op; fixtures carry everything the mock can provide.
+func (Mock) EnrichPullRequests(repo string, prs []github.PullRequest) []github.PullRequest {
+	return prs
+}
+
+// FetchTagDate looks tag up in the optional tags fixture.
+func (m Mock) FetchTagDate(repo, tag string) (time.Time, error) {
+	data, err := os.ReadFile(filepath.Join(m.Dir, TagsFixture))
+	if err != nil && !os.IsNotExist(err) {
+		return time.Time{}, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	tags := make(map[string]time.Time)
+	if err == nil {
+		if err := json.Unmarshal(data, &tags); err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse %s: %w", TagsFixture, err)
+		}
+	}
+	date, ok := tags[tag]
+	if !ok {
+		return time.Time{}, fmt.Errorf("tag %s not found", tag)
+	}
+	return date, nil
+}
+
+// FetchLinkedIssues attaches linked issues from the optional fixture; PRs are left as-is when it is absent.
+func (m Mock) FetchLinkedIssues(repo string, prs []github.PullRequest) []github.PullRequest {
+	data, err := os.ReadFile(filepath.Join(m.Dir, LinkedIssuesFixture))
+	if err != nil {
+		return prs
+	}
+
+	var links []struct {
+		PullRequest int       `json:"pullRequest"`
+		Repo        string    `json:"repo"`
+		Number      int       `json:"number"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}
+	if err := json.Unmarshal(data, &links); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", LinkedIssuesFixture, err)
+		return prs
+	}
+
+	byPR := make(map[int][]github.LinkedIssue)
+	for _, link := range links {
+		byPR[link.PullRequest] = append(byPR[link.PullRequest], github.LinkedIssue{
+			Repo:      link.Repo,
+			Number:    link.Number,
+			CreatedAt: link.CreatedAt,
+		})
+	}
+	for i := range prs {
+		prs[i].LinkedIssues = byPR[prs[i].Number]
+	}
+	return prs
+}
+
+// FetchRequestedReviewers attaches requested reviewers from the optional fixture; PRs are left as-is when it is absent.
+func (m Mock) FetchRequestedReviewers(repo string, prs []github.PullRequest) []github.PullRequest {
+	data, err := os.ReadFile(filepath.Join(m.Dir, ReviewRequestsFixture))
+	if err != nil {
+		return prs
+	}
+
+	var requests []struct {
+		PullRequest int    `json:"pullRequest"`
+		Reviewer    string `json:"reviewer"`
+	}
+	if err := json.Unmarshal(data, &requests); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", ReviewRequestsFixture, err)
+		return prs
+	}
+
+	byPR := make(map[int][]string)
+	for _, request := range requests {
+		byPR[request.PullRequest] = append(byPR[request.PullRequest], request.Reviewer)
+	}
+	for i := range prs {
+		prs[i].RequestedReviewers = byPR[prs[i].Number]
+	}
+	return prs
+}
+
+// FetchMergeCommits attaches merge methods and landed sizes from the optional fixture; PRs are left as-is when it is absent.
+func (m Mock) FetchMergeCommits(repo string, prs []github.PullRequest) []github.PullRequest {
+	data, err := os.ReadFile(filepath.Join(m.Dir, MergeCommitsFixture))
+	if err != nil {
+		return prs
+	}
+
+	var commits []struct {
+		PullRequest int    `json:"pullRequest"`
+		Method      string `json:"method"`
+		Additions   int    `json:"additions"`
+		Deletions   int    `json:"deletions"`
+	}
+	if err := json.Unmarshal(data, &commits); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", MergeCommitsFixture, err)
+		return prs
+	}
+
+	for _, c := range commits {
+		for i := range prs {
+			if prs[i].Number == c.PullRequest && prs[i].Merged {
+				prs[i].MergeMethod = c.Method
+				prs[i].LandedAdditions = c.Additions
+				prs[i].LandedDeletions = c.Deletions
+			}
+		}
+	}
+	return prs
+}
+
+// FetchReviewComments attaches review comments from the optional fixture; PRs are left as-is when it is absent.
+func (m Mock) FetchReviewComments(repo string, prs []github.PullRequest) []github.PullRequest {
+	data, err := os.ReadFile(filepath.Join(m.Dir, ReviewCommentsFixture))
+	if err != nil {
+		return prs
+	}
+
+	var comments []struct {
+		PullRequest int       `json:"pullRequest"`
+		Author      string    `json:"author"`
+		Body        string    `json:"body"`
+		State       string    `json:"state"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}
+	if err := json.Unmarshal(data, &comments); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", ReviewCommentsFixture, err)
+		return prs
+	}
+
+	byPR := make(map[int][]github.ReviewComment)
+	for _, c := range comments {
+		byPR[c.PullRequest] = append(byPR[c.PullRequest], github.ReviewComment{
+			Author:    c.Author,
+			Body:      c.Body,
+			State:     c.State,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+	for i := range prs {
+		prs[i].ReviewComments = byPR[prs[i].Number]
+	}
+	return prs
+}
+
+// FetchMaintainerResponses attaches first maintainer responses from the optional fixture; PRs are left as-is when it is absent.
+func (m Mock) FetchMaintainerResponses(repo string, prs []github.PullRequest) []github.PullRequest {
+	data, err := os.ReadFile(filepath.Join(m.Dir, MaintainerResponsesFixture))
+	if err != nil {
+		return prs
+	}
+
+	var responses []struct {
+		PullRequest int       `json:"pullRequest"`
+		Responder   string    `json:"responder"`
+		RespondedAt time.Time `json:"respondedAt"`
+	}
+	if err := json.Unmarshal(data, &responses); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", MaintainerResponsesFixture, err)
+		return prs
+	}
+
+	for _, r := range responses {
+		for i := range prs {
+			if prs[i].Number == r.PullRequest && prs[i].IsCrossRepository {
+				prs[i].FirstMaintainerResponseAt = r.RespondedAt
+				prs[i].FirstMaintainerResponder = r.Responder
+			}
+		}
+	}
+	return prs
+}
+
+// DefaultBranch returns "main", the branch the bundled fixtures target.
+func (Mock) DefaultBranch(repo string) (string, error) {
+	return "main", nil
+}
+
+// FetchWorkflowRuns loads workflow runs from the fixture.
+func (m Mock) FetchWorkflowRuns(repo string, since, until string) ([]actions.WorkflowRun, error) {
+	data, err := os.ReadFile(filepath.Join(m.Dir, WorkflowRunsFixture))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	return actions.ParseWorkflowRuns(data)
+}
+
+// FetchFailureDetails fills in the failed job and step from the optional workflow jobs fixture.
+func (m Mock) FetchFailureDetails(repo string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail {
+	jobsByRun := m.FetchRunJobs(repo, runs)
+	if limit <= 0 || limit > len(failures) {
+		limit = len(failures)
+	}
+	runByURL := make(map[string]actions.WorkflowRun, len(runs))
+	for _, run := range runs {
+		runByURL[run.URL] = run
+	}
+	for i := 0; i < limit; i++ {
+		if run, ok := runByURL[failures[i].URL]; ok {
+			info := actions.FailedJob(jobsByRun[run.DatabaseId])
+			failures[i].FailedJob = info.FailedJob
+			failures[i].FailedStep = info.FailedStep
+		}
+	}
+	return failures
+}
+
+// FetchRunJobs loads run jobs from the optional fixture; nil when it is absent.
+func (m Mock) FetchRunJobs(repo string, runs []actions.WorkflowRun) map[int64][]actions.WorkflowJob {
+	data, err := os.ReadFile(filepath.Join(m.Dir, WorkflowJobsFixture))
+	if err != nil {
+		return nil
+	}
+
+	var entries []struct {
+		RunID int64                 `json:"runId"`
+		Jobs  []actions.WorkflowJob `json:"jobs"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", WorkflowJobsFixture, err)
+		return nil
+	}
+
+	jobsByRun := make(map[int64][]actions.WorkflowJob, len(entries))
+	for _, entry := range entries {
+		jobsByRun[entry.RunID] = entry.Jobs
+	}
+	return jobsByRun
+}
+
+// FetchRunActors returns runs unchanged; their actors come from the "actor" field of the runs fixture.
+func (Mock) FetchRunActors(repo string, runs []actions.WorkflowRun) []actions.WorkflowRun {
+	return runs
+}
+
+// FetchRunArtifacts loads run artifacts from the optional fixture; nil when it is absent.
+func (m Mock) FetchRunArtifacts(repo string, runs []actions.WorkflowRun) map[int64][]actions.Artifact {
+	data, err := os.ReadFile(filepath.Join(m.Dir, RunArtifactsFixture))
+	if err != nil {
+		return nil
+	}
+
+	var entries []struct {
+		RunID     int64              `json:"runId"`
+		Artifacts []actions.Artifact `json:"artifacts"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		fmt.Printf("⚠️  Failed to parse %s: %v\n", RunArtifactsFixture, err)
+		return nil
+	}
+
+	artifactsByRun := make(map[int64][]actions.Artifact, len(entries))
+	for _, entry := range entries {
+		artifactsByRun[entry.RunID] = entry.Artifacts
+	}
+	return artifactsByRun
+}
+
+// FetchWorkflowFiles reads workflow files from the optional workflows fixture directory.
+func (m Mock) FetchWorkflowFiles(repo string) ([]actions.WorkflowFile, error) {
+	entries, err := os.ReadDir(filepath.Join(m.Dir, WorkflowFilesFixture))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var files []actions.WorkflowFile
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(m.Dir, WorkflowFilesFixture, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+		files = append(files, actions.WorkflowFile{Path: actions.WorkflowsDir + "/" + entry.Name(), Content: content})
+	}
+	return files, nil
+}
+
+// inDateRange reports whether t falls within the inclusive YYYY-MM-DD range.
+func inDateRange(t time.Time, since, until string) bool {
+	if since != "" {
+		if sinceDate, err := time.Parse("2006-01-02", since); err == nil && t.Before(sinceDate) {
+			return false
+		}
+	}
+	if until != "" {
+		if untilDate, err := time.Parse("2006-01-02", until); err == nil && !t.Before(untilDate.AddDate(0, 0, 1)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/git"
+	"visuche/internal/github"
+	"visuche/internal/gitlab"
+)
+
+// PRProvider fetches pull requests and enriches them with review and lifecycle data.
+type PRProvider interface {
+	FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error)
+	FetchOpenPullRequests(repo string) ([]github.PullRequest, error)
+	EnrichPullRequests(repo string, prs []github.PullRequest) []github.PullRequest
+	DefaultBranch(repo string) (string, error)
+}
+
+// CIProvider fetches CI runs and the details of failed runs.
+type CIProvider interface {
+	FetchWorkflowRuns(repo string, since, until string) ([]actions.WorkflowRun, error)
+	FetchFailureDetails(repo string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail // limit 0 = all
+}
+
+// PullRequestStreamer is implemented by providers that can hand out PRs while later pages are still being fetched.
+type PullRequestStreamer interface {
+	StreamPullRequests(repo string, since, until, author, label string, includeOpen bool, emit func(github.PullRequest)) ([]github.PullRequest, error)
+}
+
+// ReadyForReviewFetcher is implemented by providers that can report when draft PRs became ready for review.
+type ReadyForReviewFetcher interface {
+	FetchReadyForReviewTimes(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// AutoMergeFetcher is implemented by providers that can tell whether a PR was merged via auto-merge.
+type AutoMergeFetcher interface {
+	FetchAutoMergeEvents(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// LinkedIssueFetcher is implemented by providers that can resolve the issues a PR closes.
+type LinkedIssueFetcher interface {
+	FetchLinkedIssues(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// ChangedFilesFetcher is implemented by providers that can list the files changed by merged PRs.
+type ChangedFilesFetcher interface {
+	FetchChangedFiles(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// RequestedReviewersFetcher is implemented by providers that can list every reviewer ever requested on a PR.
+type RequestedReviewersFetcher interface {
+	FetchRequestedReviewers(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// MergeCommitFetcher is implemented by providers that can measure the commit each merged PR landed as.
+type MergeCommitFetcher interface {
+	FetchMergeCommits(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// ReviewCommentFetcher is implemented by providers that can list the text of the review comments on PRs.
+type ReviewCommentFetcher interface {
+	FetchReviewComments(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// MaintainerResponseFetcher is implemented by providers that can find the first maintainer response on PRs from forks.
+type MaintainerResponseFetcher interface {
+	FetchMaintainerResponses(repo string, prs []github.PullRequest) []github.PullRequest
+}
+
+// PRDetailFetcher is implemented by providers that can fetch a single PR and its timeline.
+type PRDetailFetcher interface {
+	FetchPullRequest(repo string, number int) (github.PullRequest, error)
+	FetchTimelineEvents(repo string, number int) ([]github.TimelineEvent, error)
+}
+
+// MilestoneFetcher is implemented by providers that can list milestones and the issues and PRs assigned to them.
+type MilestoneFetcher interface {
+	FetchMilestones(repo string) ([]github.Milestone, error)
+	FetchMilestoneItems(repo string, number int) ([]github.MilestoneItem, error)
+}
+
+// TagFetcher is implemented by providers that can resolve when a git tag was made.
+type TagFetcher interface {
+	FetchTagDate(repo, tag string) (time.Time, error)
+}
+
+// RepoLister is implemented by providers that can list the repositories of an organization or user.
+type RepoLister interface {
+	ListRepositories(owner string, limit int) ([]string, error)
+}
+
+// RunJobsFetcher is implemented by providers that can list the jobs and steps of CI runs.
+type RunJobsFetcher interface {
+	FetchRunJobs(repo string, runs []actions.WorkflowRun) map[int64][]actions.WorkflowJob
+}
+
+// RunActorFetcher is implemented by providers that can tell who triggered CI runs.
+type RunActorFetcher interface {
+	FetchRunActors(repo string, runs []actions.WorkflowRun) []actions.WorkflowRun
+}
+
+// RunArtifactsFetcher is implemented by providers that can list the artifacts uploaded by CI runs.
+type RunArtifactsFetcher interface {
+	FetchRunArtifacts(repo string, runs []actions.WorkflowRun) map[int64][]actions.Artifact
+}
+
+// WorkflowFileFetcher is implemented by providers that can read the CI workflow definitions of a repository.
+type WorkflowFileFetcher interface {
+	FetchWorkflowFiles(repo string) ([]actions.WorkflowFile, error)
+}
+
+// Provider bundles the PR and CI backends of a single hosting service.
+type Provider interface {
+	PRProvider
+	CIProvider
+	Name() string
+}
+
+// New returns the provider registered under name. dir is the fixture directory of the mock provider
+// and the data directory of the store provider; the others ignore it.
+func New(name, dir string) (Provider, error) {
+	switch name {
+	case "github":
+		return GitHub{}, nil
+	case "gitlab":
+		return GitLab{}, nil
+	case "mock":
+		if dir == "" {
+			return nil, fmt.Errorf("the mock provider requires --fixtures <dir>")
+		}
+		return NewMock(dir), nil
+	case "store":
+		return NewStore(dir), nil
+	default:
+		return nil, fmt.Errorf("unsupported provider: %s", name)
+	}
+}
+
+// GitHub is the gh CLI backed provider.
+type GitHub struct {
+	Remote string // Git remote the repository was detected from (empty: the preferred remote)
+}
+
+// Name returns the provider name.
+func (GitHub) Name() string { return "github" }
+
+// FetchPullRequests fetches pull requests with gh pr list.
+func (GitHub) FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error) {
+	return github.FetchPullRequests(repo, since, until, author, label, includeOpen)
+}
+
+// StreamPullRequests fetches pull requests, passing each to emit as its page arrives.
+func (GitHub) StreamPullRequests(repo string, since, until, author, label string, includeOpen bool, emit func(github.PullRequest)) ([]github.PullRequest, error) {
+	return github.StreamPullRequests(repo, since, until, author, label, includeOpen, emit)
+}
+
+// FetchOpenPullRequests fetches currently open PRs with their pending review requests.
+func (GitHub) FetchOpenPullRequests(repo string) ([]github.PullRequest, error) {
+	return github.FetchOpenPullRequests(repo)
+}
+
+// EnrichPullRequests adds review comment counts, reopen events, commits, and auto-merge flags.
+func (GitHub) EnrichPullRequests(repo string, prs []github.PullRequest) []github.PullRequest {
+	prs = github.FetchPRCommentTiming(repo, prs)
+	prs = github.FetchReopenEvents(repo, prs)
+	prs = github.FetchCommits(repo, prs)
+	return github.FetchAutoMergeEvents(repo, prs)
+}
+
+// FetchReadyForReviewTimes records when PRs were last marked ready for review.
+func (GitHub) FetchReadyForReviewTimes(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchReadyForReviewTimes(repo, prs)
+}
+
+// FetchAutoMergeEvents flags PRs that had auto-merge enabled.
+func (GitHub) FetchAutoMergeEvents(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchAutoMergeEvents(repo, prs)
+}
+
+// FetchLinkedIssues resolves the issues merged PRs close.
+func (GitHub) FetchLinkedIssues(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchLinkedIssues(repo, prs)
+}
+
+// FetchChangedFiles lists the files changed by merged PRs.
+func (GitHub) FetchChangedFiles(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchChangedFiles(repo, prs)
+}
+
+// FetchRequestedReviewers lists the reviewers requested on merged PRs.
+func (GitHub) FetchRequestedReviewers(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchRequestedReviewers(repo, prs)
+}
+
+// FetchMergeCommits records the merge method and landed size of merged PRs.
+func (GitHub) FetchMergeCommits(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchMergeCommits(repo, prs)
+}
+
+// FetchReviewComments records the review bodies and inline review comments of PRs.
+func (GitHub) FetchReviewComments(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchReviewComments(repo, prs)
+}
+
+// FetchMaintainerResponses records the first maintainer comment or review on PRs from forks.
+func (GitHub) FetchMaintainerResponses(repo string, prs []github.PullRequest) []github.PullRequest {
+	return github.FetchMaintainerResponses(repo, prs)
+}
+
+// FetchPullRequest fetches one PR with its reviews and commits.
+func (GitHub) FetchPullRequest(repo string, number int) (github.PullRequest, error) {
+	return github.FetchPullRequest(repo, number)
+}
+
+// FetchTimelineEvents fetches the review requests, comments and draft changes of a PR.
+func (GitHub) FetchTimelineEvents(repo string, number int) ([]github.TimelineEvent, error) {
+	return github.FetchTimelineEvents(repo, number)
+}
+
+// FetchMilestones lists the repository's milestones.
+func (GitHub) FetchMilestones(repo string) ([]github.Milestone, error) {
+	return github.FetchMilestones(repo)
+}
+
+// FetchMilestoneItems lists the issues and PRs of a milestone.
+func (GitHub) FetchMilestoneItems(repo string, number int) ([]github.MilestoneItem, error) {
+	return github.FetchMilestoneItems(repo, number)
+}
+
+// FetchTagDate returns the committer date of the tagged commit.
+func (GitHub) FetchTagDate(repo, tag string) (time.Time, error) {
+	return github.FetchTagDate(repo, tag)
+}
+
+// ListRepositories lists the non-archived repositories of owner via gh.
+func (GitHub) ListRepositories(owner string, limit int) ([]string, error) {
+	return github.ListRepositories(owner, limit)
+}
+
+// DefaultBranch returns the repository's default branch via gh. When gh fails, the HEAD recorded for
+// the selected git remote is used, but only if that remote points at repo.
+func (g GitHub) DefaultBranch(repo string) (string, error) {
+	branch, err := github.FetchDefaultBranch(repo)
+	if err == nil {
+		return branch, nil
+	}
+	remote, remoteErr := git.FindGitHubRemote(g.Remote)
+	if remoteErr != nil || !strings.EqualFold(remote.Repo, repo) {
+		return "", err
+	}
+	if local, localErr := git.GetDefaultBranch(remote.Name); localErr == nil {
+		return local, nil
+	}
+	return "", err
+}
+
+// FetchWorkflowRuns fetches Actions workflow runs with gh run list.
+func (GitHub) FetchWorkflowRuns(repo string, since, until string) ([]actions.WorkflowRun, error) {
+	return actions.FetchWorkflowRuns(repo, since, until)
+}
+
+// FetchFailureDetails looks up the failed job and step of failed runs.
+func (GitHub) FetchFailureDetails(repo string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail {
+	return actions.FetchFailureDetails(repo, runs, failures, limit)
+}
+
+// FetchRunJobs fetches the jobs and steps of completed runs (one API call per run).
+func (GitHub) FetchRunJobs(repo string, runs []actions.WorkflowRun) map[int64][]actions.WorkflowJob {
+	return actions.FetchRunJobs(repo, runs)
+}
+
+// FetchRunActors fills in the actor that triggered each run (one paginated API call).
+func (GitHub) FetchRunActors(repo string, runs []actions.WorkflowRun) []actions.WorkflowRun {
+	return actions.FetchRunActors(repo, runs)
+}
+
+// FetchRunArtifacts fetches the artifacts of completed runs (one API call per run).
+func (GitHub) FetchRunArtifacts(repo string, runs []actions.WorkflowRun) map[int64][]actions.Artifact {
+	return actions.FetchRunArtifacts(repo, runs)
+}
+
+// FetchWorkflowFiles fetches the .github/workflows files from the default branch.
+func (GitHub) FetchWorkflowFiles(repo string) ([]actions.WorkflowFile, error) {
+	return actions.FetchWorkflowFiles(repo)
+}
+
+// GitLab is the glab CLI backed provider.
+type GitLab struct{}
+
+// Name returns the provider name.
+func (GitLab) Name() string { return "gitlab" }
+
+// FetchPullRequests fetches merge requests.
+func (GitLab) FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error) {
+	return gitlab.FetchMergeRequests(repo, since, until, author, label, includeOpen)
+}
+
+// FetchOpenPullRequests fetches currently open merge requests with their assigned reviewers.
+func (GitLab) FetchOpenPullRequests(repo string) ([]github.PullRequest, error) {
+	return gitlab.FetchOpenMergeRequests(repo)
+}
+
+// EnrichPullRequests is a no-op until GitLab review data is supported.
+func (GitLab) EnrichPullRequests(repo string, prs []github.PullRequest) []github.PullRequest {
+	return prs
+}
+
+// FetchLinkedIssues resolves the issues merged MRs close.
+func (GitLab) FetchLinkedIssues(repo string, prs []github.PullRequest) []github.PullRequest {
+	return gitlab.FetchLinkedIssues(repo, prs)
+}
+
+// FetchChangedFiles lists the files changed by merged MRs.
+func (GitLab) FetchChangedFiles(repo string, prs []github.PullRequest) []github.PullRequest {
+	return gitlab.FetchChangedFiles(repo, prs)
+}
+
+// DefaultBranch returns the project's default branch.
+func (GitLab) DefaultBranch(repo string) (string, error) {
+	return gitlab.FetchDefaultBranch(repo)
+}
+
+// FetchWorkflowRuns fetches CI pipelines.
+func (GitLab) FetchWorkflowRuns(repo string, since, until string) ([]actions.WorkflowRun, error) {
+	return gitlab.FetchPipelines(repo, since, until)
+}
+
+// FetchFailureDetails looks up the failed job and stage of failed pipelines.
+func (GitLab) FetchFailureDetails(repo string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail {
+	return gitlab.FetchFailureDetails(repo, runs, failures, limit)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/store"
+)
+
+// Store is a provider backed by the local store that `visuche collect` fills from webhooks.
+// It never touches the network; each repository's directory is read like mock fixtures.
+type Store struct {
+	Store *store.Store
+}
+
+// NewStore returns a provider reading the store under dir.
+func NewStore(dir string) Store {
+	return Store{Store: store.New(dir)}
+}
+
+// Name returns the provider name.
+func (Store) Name() string { return "store" }
+
+// fixtures returns the mock provider reading repo's directory.
+func (s Store) fixtures(repo string) (Mock, error) {
+	dir, err := s.Store.RepoDir(repo)
+	if err != nil {
+		return Mock{}, err
+	}
+	return NewMock(dir), nil
+}
+
+// FetchPullRequests loads the stored PRs of repo and applies the same filters as the real backends.
+func (s Store) FetchPullRequests(repo string, since, until, author, label string, includeOpen bool) ([]github.PullRequest, error) {
+	prs, err := s.Store.PullRequests(repo)
+	if err != nil {
+		return nil, err
+	}
+	if prs == nil {
+		return nil, fmt.Errorf("no pull requests of %s in the store %s; run visuche collect first", repo, s.Store.Dir)
+	}
+	fixtures, err := s.fixtures(repo)
+	if err != nil {
+		return nil, err
+	}
+	return fixtures.FetchPullRequests(repo, since, until, author, label, includeOpen)
+}
+
+// FetchOpenPullRequests returns the stored PRs of repo that are still open.
+func (s Store) FetchOpenPullRequests(repo string) ([]github.PullRequest, error) {
+	fixtures, err := s.fixtures(repo)
+	if err != nil {
+		return nil, err
+	}
+	return fixtures.FetchOpenPullRequests(repo)
+}
+
+// FetchPullRequest returns the stored PR with the given number.
+func (s Store) FetchPullRequest(repo string, number int) (github.PullRequest, error) {
+	fixtures, err := s.fixtures(repo)
+	if err != nil {
+		return github.PullRequest{}, err
+	}
+	return fixtures.FetchPullRequest(repo, number)
+}
+
+// EnrichPullRequests returns prs unchanged; the collector stores PRs with their reviews and commits.
+func (Store) EnrichPullRequests(repo string, prs []github.PullRequest) []github.PullRequest {
+	return prs
+}
+
+// DefaultBranch returns the most common base branch of the stored merged PRs, or "main".
+func (s Store) DefaultBranch(repo string) (string, error) {
+	prs, _ := s.Store.PullRequests(repo)
+	counts := make(map[string]int)
+	branch := "main"
+	for _, pr := range prs {
+		if !pr.Merged || pr.BaseRefName == "" {
+			continue
+		}
+		counts[pr.BaseRefName]++
+		if counts[pr.BaseRefName] > counts[branch] {
+			branch = pr.BaseRefName
+		}
+	}
+	return branch, nil
+}
+
+// FetchWorkflowRuns returns the stored workflow runs of repo.
+func (s Store) FetchWorkflowRuns(repo string, since, until string) ([]actions.WorkflowRun, error) {
+	return s.Store.WorkflowRuns(repo)
+}
+
+// FetchRunActors returns runs unchanged; runs stored from webhooks carry their actor.
+func (Store) FetchRunActors(repo string, runs []actions.WorkflowRun) []actions.WorkflowRun {
+	return runs
+}
+
+// FetchFailureDetails returns failures unchanged; job details are not stored.
+func (Store) FetchFailureDetails(repo string, runs []actions.WorkflowRun, failures []actions.FailureDetail, limit int) []actions.FailureDetail {
+	return failures
+}
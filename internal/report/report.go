@@ -0,0 +1,141 @@
+// Package report separates what a report shows from how it is printed: commands build a Report model
+// and a renderer writes it as terminal tables, Markdown or JSON. Renderers only depend on the model, so
+// their output can be checked against golden files (see cmd/golden_test.go).
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// Output formats.
+const (
+	FormatTable    = "table"
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+)
+
+// Formats lists the supported output formats.
+var Formats = []string{FormatTable, FormatMarkdown, FormatJSON}
+
+// Report is a titled list of sections, ready to render.
+type Report struct {
+	Title    string     `json:"title"`
+	Sections []*Section `json:"sections"`
+}
+
+// Section is a titled table, optionally followed by free-text notes. A section without a header is
+// rendered as its notes only.
+type Section struct {
+	Title  string     `json:"title"`
+	Header []string   `json:"header,omitempty"`
+	Rows   [][]string `json:"rows,omitempty"`
+	Notes  []string   `json:"notes,omitempty"`
+}
+
+// AddSection appends a section with header and returns it for adding rows.
+func (r *Report) AddSection(title string, header ...string) *Section {
+	s := &Section{Title: title, Header: header}
+	r.Sections = append(r.Sections, s)
+	return s
+}
+
+// Append adds a row.
+func (s *Section) Append(row ...string) {
+	s.Rows = append(s.Rows, row)
+}
+
+// Note adds a line of text below the table.
+func (s *Section) Note(text string) {
+	s.Notes = append(s.Notes, text)
+}
+
+// Validate checks an output format name.
+func Validate(format string) error {
+	for _, f := range Formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported report format %q (supported: %s)", format, strings.Join(Formats, ", "))
+}
+
+// Render writes r to w in format.
+func Render(w io.Writer, format string, r Report) error {
+	switch format {
+	case FormatTable:
+		return renderTable(w, r)
+	case FormatMarkdown:
+		return renderMarkdown(w, r)
+	case FormatJSON:
+		return renderJSON(w, r)
+	default:
+		return Validate(format)
+	}
+}
+
+// renderTable prints the terminal layout: a title underlined with "=", then a bordered table per section.
+func renderTable(w io.Writer, r Report) error {
+	fmt.Fprintln(w, "\n"+r.Title)
+	fmt.Fprintln(w, "="+strings.Repeat("=", 50))
+	for _, s := range r.Sections {
+		fmt.Fprintln(w, "\n"+s.Title)
+		if len(s.Header) > 0 {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader(s.Header)
+			table.SetBorder(true)
+			table.AppendBulk(s.Rows)
+			table.Render()
+		}
+		for _, note := range s.Notes {
+			fmt.Fprintln(w, note)
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// renderMarkdown writes GitHub-flavored Markdown: a heading per section and pipe tables.
+func renderMarkdown(w io.Writer, r Report) error {
+	fmt.Fprintf(w, "## %s\n", r.Title)
+	for _, s := range r.Sections {
+		fmt.Fprintf(w, "\n### %s\n", strings.TrimSuffix(s.Title, ":"))
+		if len(s.Header) > 0 {
+			fmt.Fprintf(w, "\n%s\n", markdownRow(s.Header))
+			separators := make([]string, len(s.Header))
+			for i := range separators {
+				separators[i] = "---"
+			}
+			fmt.Fprintf(w, "%s\n", markdownRow(separators))
+			for _, row := range s.Rows {
+				fmt.Fprintf(w, "%s\n", markdownRow(row))
+			}
+		}
+		if len(s.Notes) > 0 {
+			fmt.Fprintln(w)
+			for _, note := range s.Notes {
+				fmt.Fprintf(w, "%s\n", strings.TrimSpace(note))
+			}
+		}
+	}
+	return nil
+}
+
+// markdownRow joins cells into a table row, escaping pipes so they do not split cells.
+func markdownRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, c := range cells {
+		escaped[i] = strings.ReplaceAll(c, "|", "\\|")
+	}
+	return "| " + strings.Join(escaped, " | ") + " |"
+}
+
+func renderJSON(w io.Writer, r Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// NamedCount is a name (author, label, …) with the number of PRs it appears on.
+type NamedCount struct {
+	Name  string
+	Count int
+}
+
+// AbandonedReport summarizes PRs closed without merging, i.e. effort that never shipped.
+type AbandonedReport struct {
+	Closed          int     // PRs closed without merging
+	Finished        int     // Merged + closed without merging
+	Rate            float64 // Closed / Finished, in percent
+	AverageTimeOpen time.Duration
+	MedianTimeOpen  time.Duration
+	TopAuthors      []NamedCount // Most abandoned PRs first
+	TopLabels       []NamedCount
+}
+
+// CalculateAbandonedReport measures PRs closed without merging against all finished PRs.
+func CalculateAbandonedReport(prs []github.PullRequest) AbandonedReport {
+	var report AbandonedReport
+	var timesOpen []time.Duration
+	authors := make(map[string]int)
+	labels := make(map[string]int)
+
+	for _, pr := range prs {
+		if pr.Merged {
+			report.Finished++
+			continue
+		}
+		if !pr.IsAbandoned() {
+			continue
+		}
+		report.Finished++
+		report.Closed++
+		if !pr.ClosedAt.IsZero() {
+			timesOpen = append(timesOpen, pr.ClosedAt.Sub(pr.CreatedAt))
+		}
+		authors[pr.Author.Login]++
+		for _, label := range pr.Labels {
+			labels[label.Name]++
+		}
+	}
+
+	if report.Finished > 0 {
+		report.Rate = float64(report.Closed) / float64(report.Finished) * 100
+	}
+	report.AverageTimeOpen, report.MedianTimeOpen = averageAndMedian(timesOpen)
+	report.TopAuthors = topCounts(authors, 5)
+	report.TopLabels = topCounts(labels, 5)
+	return report
+}
+
+// topCounts returns the n largest counts, ties broken by name.
+func topCounts(counts map[string]int, n int) []NamedCount {
+	result := make([]NamedCount, 0, len(counts))
+	for name, count := range counts {
+		result = append(result, NamedCount{Name: name, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// ActivityDay is one author's activity on one day (UTC).
+type ActivityDay struct {
+	Date         time.Time
+	Author       string
+	PRsOpened    int
+	PRsMerged    int // PRs of the author merged that day
+	ReviewsGiven int // Reviews submitted on other authors' PRs
+}
+
+// Active reports whether the author did anything that day.
+func (d ActivityDay) Active() bool {
+	return d.PRsOpened > 0 || d.PRsMerged > 0 || d.ReviewsGiven > 0
+}
+
+// CalculateActivityCalendar returns a dense per-author calendar of PRs opened and merged and reviews
+// given for every day from `from` through `to`, so that days without activity (vacations, focus time)
+// appear as zero rows. Bots are left out. Rows are ordered by author, then date.
+func CalculateActivityCalendar(prs []github.PullRequest, from, to time.Time) []ActivityDay {
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) {
+		return nil
+	}
+
+	type key struct {
+		author string
+		day    time.Time
+	}
+	counts := make(map[key]*ActivityDay)
+	authors := make(map[string]bool)
+	record := func(author string, t time.Time) *ActivityDay {
+		if author == "" || github.IsBotLogin(author) || t.IsZero() {
+			return nil
+		}
+		day := truncateDay(t)
+		if day.Before(from) || day.After(to) {
+			return nil
+		}
+		authors[author] = true
+		k := key{author, day}
+		if counts[k] == nil {
+			counts[k] = &ActivityDay{Date: day, Author: author}
+		}
+		return counts[k]
+	}
+
+	for _, pr := range prs {
+		if d := record(pr.Author.Login, pr.CreatedAt); d != nil {
+			d.PRsOpened++
+		}
+		if pr.Merged {
+			if d := record(pr.Author.Login, pr.MergedAt); d != nil {
+				d.PRsMerged++
+			}
+		}
+		for _, review := range pr.Reviews {
+			if strings.EqualFold(review.Author.Login, pr.Author.Login) {
+				continue
+			}
+			if d := record(review.Author.Login, review.SubmittedAt); d != nil {
+				d.ReviewsGiven++
+			}
+		}
+	}
+
+	names := make([]string, 0, len(authors))
+	for author := range authors {
+		names = append(names, author)
+	}
+	sort.Strings(names)
+
+	var calendar []ActivityDay
+	for _, author := range names {
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			if d := counts[key{author, day}]; d != nil {
+				calendar = append(calendar, *d)
+			} else {
+				calendar = append(calendar, ActivityDay{Date: day, Author: author})
+			}
+		}
+	}
+	return calendar
+}
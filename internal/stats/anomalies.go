@@ -0,0 +1,119 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+	"visuche/internal/summary"
+)
+
+// minAnomalyBaseline is how many earlier weeks a week needs before it can be judged against them.
+const minAnomalyBaseline = 4
+
+// anomalyContributors caps the PRs or runs listed for an anomalous week.
+const anomalyContributors = 5
+
+// Anomaly is a week whose value deviates from the rolling baseline of the preceding weeks.
+type Anomaly struct {
+	Week      time.Time
+	Value     float64
+	Baseline  float64 // Median of the preceding weeks
+	MAD       float64 // Median absolute deviation of the preceding weeks
+	Deviation float64 // (Value - Baseline) / MAD; positive when the value is higher than usual
+}
+
+// DetectAnomalies flags the points of a weekly series that are more than threshold MADs away from the
+// median of up to window preceding points. Points with fewer than four preceding points, or whose baseline
+// has no spread (MAD 0), are not judged.
+func DetectAnomalies(series []Point, window int, threshold float64) []Anomaly {
+	var anomalies []Anomaly
+	for i, point := range series {
+		start := i - window
+		if start < 0 {
+			start = 0
+		}
+		if i-start < minAnomalyBaseline {
+			continue
+		}
+
+		baseline := make([]float64, 0, i-start)
+		for _, p := range series[start:i] {
+			baseline = append(baseline, p.Value)
+		}
+		median := summary.Median(baseline)
+		deviations := make([]float64, len(baseline))
+		for j, v := range baseline {
+			deviations[j] = math.Abs(v - median)
+		}
+		mad := summary.Median(deviations)
+		if mad == 0 {
+			continue
+		}
+
+		deviation := (point.Value - median) / mad
+		if math.Abs(deviation) > threshold {
+			anomalies = append(anomalies, Anomaly{Week: point.Time, Value: point.Value, Baseline: median, MAD: mad, Deviation: deviation})
+		}
+	}
+	return anomalies
+}
+
+// LeadTimeAnomaly is an anomalous week of median lead time with the slowest PRs merged that week.
+type LeadTimeAnomaly struct {
+	Anomaly
+	PRs []github.PullRequest // Slowest first
+}
+
+// FailureRateAnomaly is an anomalous week of CI failure rate with the failed runs of that week.
+type FailureRateAnomaly struct {
+	Anomaly
+	Runs []actions.WorkflowRun // Most recent first
+}
+
+// DetectLeadTimeAnomalies flags weeks whose median lead time (in hours) of merged PRs deviates from the
+// rolling baseline, see DetectAnomalies.
+func DetectLeadTimeAnomalies(prs []github.PullRequest, window int, threshold float64) []LeadTimeAnomaly {
+	var anomalies []LeadTimeAnomaly
+	for _, a := range DetectAnomalies(WeeklyPRSeries(prs)[MetricLeadTimeMedianHours], window, threshold) {
+		var week []github.PullRequest
+		for _, pr := range prs {
+			if pr.Merged && !pr.MergedAt.IsZero() && WeekStart(pr.MergedAt).Equal(a.Week) {
+				week = append(week, pr)
+			}
+		}
+		sort.Slice(week, func(i, j int) bool { return week[i].LeadTime > week[j].LeadTime })
+		if len(week) > anomalyContributors {
+			week = week[:anomalyContributors]
+		}
+		anomalies = append(anomalies, LeadTimeAnomaly{Anomaly: a, PRs: week})
+	}
+	return anomalies
+}
+
+// DetectFailureRateAnomalies flags weeks whose CI failure rate (percentage of completed runs that did not
+// succeed) deviates from the rolling baseline, see DetectAnomalies.
+func DetectFailureRateAnomalies(runs []actions.WorkflowRun, window int, threshold float64) []FailureRateAnomaly {
+	var failureRates []Point
+	for _, p := range WeeklyCISeries(runs)[MetricCISuccessRate] {
+		failureRates = append(failureRates, Point{p.Time, 100 - p.Value})
+	}
+
+	var anomalies []FailureRateAnomaly
+	for _, a := range DetectAnomalies(failureRates, window, threshold) {
+		var week []actions.WorkflowRun
+		for _, run := range runs {
+			failed := run.Status == "completed" && run.Conclusion != "success" && run.Conclusion != "skipped" && run.Conclusion != "cancelled"
+			if failed && WeekStart(run.CreatedAt).Equal(a.Week) {
+				week = append(week, run)
+			}
+		}
+		sort.Slice(week, func(i, j int) bool { return week[i].CreatedAt.After(week[j].CreatedAt) })
+		if len(week) > anomalyContributors {
+			week = week[:anomalyContributors]
+		}
+		anomalies = append(anomalies, FailureRateAnomaly{Anomaly: a, Runs: week})
+	}
+	return anomalies
+}
@@ -0,0 +1,65 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+	"visuche/internal/github"
+)
+
+// ReviewerCountBucket is time to approval for merged PRs with the same number of requested reviewers.
+type ReviewerCountBucket struct {
+	Reviewers             string // "0", "1", "2" or "3+"
+	PRs                   int
+	Approved              int // PRs with at least one approval
+	AverageTimeToApproval time.Duration
+	MedianTimeToApproval  time.Duration
+	AverageLeadTime       time.Duration
+}
+
+// reviewerCountLabel buckets a requested-reviewer count, grouping three and more.
+func reviewerCountLabel(count int) string {
+	if count >= 3 {
+		return "3+"
+	}
+	return fmt.Sprintf("%d", count)
+}
+
+// CalculateApprovalByReviewerCount groups merged PRs by how many reviewers were requested and measures
+// the time from review-ready to final approval in each group. Empty groups are omitted.
+func CalculateApprovalByReviewerCount(prs []github.PullRequest) []ReviewerCountBucket {
+	type accumulator struct {
+		prs       int
+		approvals []time.Duration
+		leadTimes []time.Duration
+	}
+	groups := make(map[string]*accumulator)
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+		label := reviewerCountLabel(len(pr.RequestedReviewers))
+		g, ok := groups[label]
+		if !ok {
+			g = &accumulator{}
+			groups[label] = g
+		}
+		g.prs++
+		g.leadTimes = append(g.leadTimes, pr.LeadTime)
+		if d := positiveDuration(pr.ReviewableAt(), lastApprovalTime(pr)); d > 0 {
+			g.approvals = append(g.approvals, d)
+		}
+	}
+
+	var buckets []ReviewerCountBucket
+	for _, label := range []string{"0", "1", "2", "3+"} {
+		g, ok := groups[label]
+		if !ok {
+			continue
+		}
+		bucket := ReviewerCountBucket{Reviewers: label, PRs: g.prs, Approved: len(g.approvals)}
+		bucket.AverageTimeToApproval, bucket.MedianTimeToApproval = averageAndMedian(g.approvals)
+		bucket.AverageLeadTime, _ = averageAndMedian(g.leadTimes)
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
@@ -0,0 +1,98 @@
+package stats
+
+import (
+	"time"
+)
+
+// Performance bands of the DORA State of DevOps reports, best first.
+const (
+	BandElite  = "Elite"
+	BandHigh   = "High"
+	BandMedium = "Medium"
+	BandLow    = "Low"
+)
+
+// DORA metric names.
+const (
+	DORADeploymentFrequency = "Deployment Frequency"
+	DORALeadTime            = "Lead Time for Changes"
+	DORAChangeFailureRate   = "Change Failure Rate"
+	DORATimeToRestore       = "Time to Restore"
+)
+
+// doraBand is the bound a value must reach to be in a band. Bands are checked best first.
+type doraBand struct {
+	Band  string
+	Bound float64
+}
+
+// doraBaseline is the published threshold table of one DORA metric.
+type doraBaseline struct {
+	HigherIsBetter bool
+	Bands          []doraBand // Elite, High, Medium; anything else is Low
+}
+
+// doraBaselines are the thresholds of the 2023 State of DevOps report, in releases per week, hours
+// and percent. They describe the survey population, not this repository's peers.
+var doraBaselines = map[string]doraBaseline{
+	DORADeploymentFrequency: {HigherIsBetter: true, Bands: []doraBand{{BandElite, 7}, {BandHigh, 1}, {BandMedium, 12.0 / 52}}}, // Daily / weekly / monthly
+	DORALeadTime:            {Bands: []doraBand{{BandElite, 24}, {BandHigh, 24 * 7}, {BandMedium, 24 * 30}}},                   // A day / week / month
+	DORAChangeFailureRate:   {Bands: []doraBand{{BandElite, 5}, {BandHigh, 10}, {BandMedium, 15}}},
+	DORATimeToRestore:       {Bands: []doraBand{{BandElite, 1}, {BandHigh, 24}, {BandMedium, 24 * 7}}}, // An hour / day / week
+}
+
+// Benchmark places one metric of the repository in a DORA band.
+type Benchmark struct {
+	Metric string
+	Value  float64 // In Unit
+	Unit   string  // "/week", "h" or "%"
+	Band   string
+	Elite  float64 // The bound of the elite band, in Unit
+	Proxy  string  // What the value is derived from, since visuche sees PRs rather than deployments
+
+	HigherIsBetter bool
+}
+
+// CalculateBenchmarks annotates the DORA-style metrics derivable from PR data with the band they
+// fall into. Metrics without data in the period are left out. The bands are a heuristic: releases,
+// reverts and hotfix PRs only approximate deployments and incidents.
+func CalculateBenchmarks(s Stats) []Benchmark {
+	var benchmarks []Benchmark
+	add := func(metric string, value float64, unit, proxy string) {
+		baseline := doraBaselines[metric]
+		benchmarks = append(benchmarks, Benchmark{
+			Metric: metric,
+			Value:  value,
+			Unit:   unit,
+			Band:   baseline.band(value),
+			Elite:  baseline.Bands[0].Bound,
+			Proxy:  proxy,
+
+			HigherIsBetter: baseline.HigherIsBetter,
+		})
+	}
+
+	if s.ReleaseCount > 0 {
+		add(DORADeploymentFrequency, s.ReleasesPerWeek, "/week", "merges into the default branch")
+	}
+	if s.MergedPRs > 0 && s.MedianLeadTime > 0 {
+		add(DORALeadTime, s.MedianLeadTime.Hours(), "h", "median PR lead time")
+	}
+	if s.MergedPRs > 0 && s.Computed("reverts") && s.Computed("hotfixes") {
+		rate := float64(s.RevertLikeMerges+s.HotfixMerges) / float64(s.MergedPRs) * 100
+		add(DORAChangeFailureRate, rate, "%", "revert and hotfix PRs per merged PR")
+	}
+	if s.HotfixMerges > 0 && s.MedianHotfixAfterRelease > 0 {
+		add(DORATimeToRestore, float64(s.MedianHotfixAfterRelease)/float64(time.Hour), "h", "median release→hotfix gap")
+	}
+	return benchmarks
+}
+
+func (b doraBaseline) band(value float64) string {
+	for _, band := range b.Bands {
+		if (b.HigherIsBetter && value >= band.Bound) || (!b.HigherIsBetter && value <= band.Bound) {
+			return band.Band
+		}
+	}
+	return BandLow
+}
@@ -0,0 +1,91 @@
+package stats
+
+import (
+	"math"
+	"time"
+	"visuche/internal/github"
+)
+
+// BurndownVelocityDays is the trailing window the burndown velocity is measured over.
+const BurndownVelocityDays = 14
+
+// BurndownDay is the state of a milestone at the end of one day (UTC).
+type BurndownDay struct {
+	Date   time.Time
+	Open   int // Items created by the end of the day and not yet closed
+	Closed int // Items closed by the end of the day
+}
+
+// Burndown is the progress of a milestone's issues and PRs over time.
+type Burndown struct {
+	Days         []BurndownDay
+	OpenIssues   int
+	ClosedIssues int
+	OpenPRs      int
+	ClosedPRs    int
+	Velocity     float64   // Items closed per day over the trailing window
+	Projected    time.Time // Completion date at the current velocity; zero when nothing is closing or nothing is open
+}
+
+// CalculateBurndown counts open and closed milestone items at the end of each day from start
+// through now, and projects the completion date from the closures of the last
+// BurndownVelocityDays days (or since start when the milestone is younger).
+func CalculateBurndown(items []github.MilestoneItem, start, now time.Time) Burndown {
+	var b Burndown
+	for _, item := range items {
+		if start.IsZero() || item.CreatedAt.Before(start) {
+			start = item.CreatedAt
+		}
+		closed := item.State == "closed"
+		switch {
+		case item.IsPullRequest() && closed:
+			b.ClosedPRs++
+		case item.IsPullRequest():
+			b.OpenPRs++
+		case closed:
+			b.ClosedIssues++
+		default:
+			b.OpenIssues++
+		}
+	}
+	if start.IsZero() {
+		return b
+	}
+
+	for day := truncateDay(start); !day.After(now); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		point := BurndownDay{Date: day}
+		for _, item := range items {
+			if !item.CreatedAt.Before(end) {
+				continue
+			}
+			if item.State == "closed" && !item.ClosedAt.IsZero() && item.ClosedAt.Before(end) {
+				point.Closed++
+			} else {
+				point.Open++
+			}
+		}
+		b.Days = append(b.Days, point)
+	}
+
+	windowStart := now.AddDate(0, 0, -BurndownVelocityDays)
+	if start.After(windowStart) {
+		windowStart = start
+	}
+	closedInWindow := 0
+	for _, item := range items {
+		if item.State == "closed" && item.ClosedAt.After(windowStart) && !item.ClosedAt.After(now) {
+			closedInWindow++
+		}
+	}
+	if days := now.Sub(windowStart).Hours() / 24; days > 0 {
+		b.Velocity = float64(closedInWindow) / math.Max(days, 1)
+	}
+
+	open := b.OpenIssues + b.OpenPRs
+	if open > 0 && b.Velocity > 0 {
+		daysLeft := math.Ceil(float64(open) / b.Velocity)
+		b.Projected = truncateDay(now).AddDate(0, 0, int(daysLeft))
+	}
+	return b
+}
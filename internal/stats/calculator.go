@@ -0,0 +1,158 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// Calculator computes one group of Stats fields from the PRs. Requires names the calculators whose
+// fields Compute reads; they run first, even when not selected themselves.
+type Calculator struct {
+	Name     string
+	Requires []string
+	Compute  func(in Input, s *Stats)
+}
+
+// Input is what calculators compute from.
+type Input struct {
+	PRs           []github.PullRequest
+	DefaultBranch string    // Drives the release/WIP heuristics; empty falls back to main/master
+	Now           time.Time // Reference time for the age of open PRs
+}
+
+// calculators are the registered metric calculators in registration order.
+var calculators []Calculator
+
+// Register adds a metric calculator. Names must be unique.
+func Register(c Calculator) {
+	for _, existing := range calculators {
+		if existing.Name == c.Name {
+			panic(fmt.Sprintf("stats: calculator %q registered twice", c.Name))
+		}
+	}
+	calculators = append(calculators, c)
+}
+
+// MetricNames returns the names of the registered calculators, for --metrics.
+func MetricNames() []string {
+	names := make([]string, 0, len(calculators))
+	for _, c := range calculators {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// ValidateMetrics checks metric calculator names.
+func ValidateMetrics(names []string) error {
+	for _, name := range names {
+		if findCalculator(name) == nil {
+			return fmt.Errorf("unknown metric %q (available: %s)", name, strings.Join(MetricNames(), ", "))
+		}
+	}
+	return nil
+}
+
+func findCalculator(name string) *Calculator {
+	for i := range calculators {
+		if calculators[i].Name == name {
+			return &calculators[i]
+		}
+	}
+	return nil
+}
+
+// CalculateStats aggregates PR metrics with every registered calculator. defaultBranch drives the
+// release/WIP heuristics; pass an empty string to fall back to main/master. Open PRs are aged at now.
+func CalculateStats(prs []github.PullRequest, defaultBranch string, now time.Time) Stats {
+	s, _ := CalculateMetrics(prs, defaultBranch, now, nil)
+	return s
+}
+
+// CalculateMetrics aggregates PR metrics with the named calculators (all when names is empty) and their
+// requirements. Fields of calculators that did not run stay zero; Stats.Computed tells them apart.
+func CalculateMetrics(prs []github.PullRequest, defaultBranch string, now time.Time, names []string) (Stats, error) {
+	if err := ValidateMetrics(names); err != nil {
+		return Stats{}, err
+	}
+
+	s := Stats{DefaultBranch: defaultBranch}
+	in := Input{PRs: prs, DefaultBranch: defaultBranch, Now: now}
+	if len(names) > 0 {
+		s.computed = make(map[string]bool)
+	}
+	done := make(map[string]bool)
+	var run func(c *Calculator, path []string) error
+	run = func(c *Calculator, path []string) error {
+		if done[c.Name] {
+			return nil
+		}
+		for _, p := range path {
+			if p == c.Name {
+				return fmt.Errorf("metric calculators depend on each other: %s", strings.Join(append(path, c.Name), " → "))
+			}
+		}
+		for _, required := range c.Requires {
+			dep := findCalculator(required)
+			if dep == nil {
+				return fmt.Errorf("metric %q requires unknown metric %q", c.Name, required)
+			}
+			if err := run(dep, append(path, c.Name)); err != nil {
+				return err
+			}
+		}
+		c.Compute(in, &s)
+		done[c.Name] = true
+		if s.computed != nil {
+			s.computed[c.Name] = true
+		}
+		return nil
+	}
+
+	for i := range calculators {
+		if len(names) > 0 && !contains(names, calculators[i].Name) {
+			continue
+		}
+		if err := run(&calculators[i], nil); err != nil {
+			return Stats{}, err
+		}
+	}
+	return s, nil
+}
+
+// Computed reports whether the named calculator ran for s. Stats from CalculateStats have every metric.
+func (s Stats) Computed(name string) bool {
+	return s.computed == nil || s.computed[name]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sumDurations returns the total of values.
+func sumDurations(values []time.Duration) time.Duration {
+	var total time.Duration
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// createdRange returns the earliest and latest PR creation times (zero when there are no PRs).
+func createdRange(prs []github.PullRequest) (earliest, latest time.Time) {
+	for _, pr := range prs {
+		if earliest.IsZero() || pr.CreatedAt.Before(earliest) {
+			earliest = pr.CreatedAt
+		}
+		if latest.IsZero() || pr.CreatedAt.After(latest) {
+			latest = pr.CreatedAt
+		}
+	}
+	return earliest, latest
+}
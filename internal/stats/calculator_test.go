@@ -0,0 +1,234 @@
+package stats
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"visuche/internal/github"
+)
+
+// withCalculators replaces the registered calculators for the duration of the test.
+func withCalculators(t *testing.T, cs ...Calculator) {
+	t.Helper()
+	saved := calculators
+	calculators = nil
+	t.Cleanup(func() { calculators = saved })
+	for _, c := range cs {
+		Register(c)
+	}
+}
+
+// recording returns a calculator that appends its name to order when it runs.
+func recording(name string, order *[]string, requires ...string) Calculator {
+	return Calculator{Name: name, Requires: requires, Compute: func(Input, *Stats) { *order = append(*order, name) }}
+}
+
+func TestCalculateMetricsResolvesRequirements(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected []string
+		wantRun  []string
+	}{
+		{name: "all when none selected", selected: nil, wantRun: []string{"a", "b", "c", "d"}},
+		{name: "requirements run first", selected: []string{"c"}, wantRun: []string{"a", "b", "c"}},
+		{name: "direct requirement only", selected: []string{"b"}, wantRun: []string{"a", "b"}},
+		{name: "no requirements", selected: []string{"d"}, wantRun: []string{"d"}},
+		{name: "shared requirement runs once", selected: []string{"c", "b"}, wantRun: []string{"a", "b", "c"}},
+		{name: "registration order, not selection order", selected: []string{"d", "a"}, wantRun: []string{"a", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var order []string
+			withCalculators(t,
+				recording("a", &order),
+				recording("b", &order, "a"),
+				recording("c", &order, "b"),
+				recording("d", &order),
+			)
+
+			s, err := CalculateMetrics(nil, "", time.Time{}, tt.selected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(order, tt.wantRun) {
+				t.Errorf("ran %v, want %v", order, tt.wantRun)
+			}
+			for _, name := range []string{"a", "b", "c", "d"} {
+				if got, want := s.Computed(name), contains(tt.wantRun, name); got != want {
+					t.Errorf("Computed(%q) = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateMetricsErrors(t *testing.T) {
+	noop := func(Input, *Stats) {}
+	tests := []struct {
+		name        string
+		calculators []Calculator
+		selected    []string
+		wantErr     string
+	}{
+		{
+			name: "direct cycle",
+			calculators: []Calculator{
+				{Name: "x", Requires: []string{"y"}, Compute: noop},
+				{Name: "y", Requires: []string{"x"}, Compute: noop},
+			},
+			wantErr: "depend on each other: x → y → x",
+		},
+		{
+			name: "self cycle",
+			calculators: []Calculator{
+				{Name: "x", Requires: []string{"x"}, Compute: noop},
+			},
+			wantErr: "depend on each other: x → x",
+		},
+		{
+			name: "cycle reached through a selected metric",
+			calculators: []Calculator{
+				{Name: "w", Requires: []string{"x"}, Compute: noop},
+				{Name: "x", Requires: []string{"y"}, Compute: noop},
+				{Name: "y", Requires: []string{"x"}, Compute: noop},
+			},
+			selected: []string{"w"},
+			wantErr:  "depend on each other: w → x → y → x",
+		},
+		{
+			name: "unknown requirement",
+			calculators: []Calculator{
+				{Name: "x", Requires: []string{"missing"}, Compute: noop},
+			},
+			wantErr: `metric "x" requires unknown metric "missing"`,
+		},
+		{
+			name: "unknown selected metric",
+			calculators: []Calculator{
+				{Name: "x", Compute: noop},
+			},
+			selected: []string{"nope"},
+			wantErr:  `unknown metric "nope" (available: x)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withCalculators(t, tt.calculators...)
+
+			_, err := CalculateMetrics(nil, "", time.Time{}, tt.selected)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegisterRejectsDuplicateNames(t *testing.T) {
+	withCalculators(t, Calculator{Name: "x", Compute: func(Input, *Stats) {}})
+	defer func() {
+		if recover() == nil {
+			t.Error("registering x twice did not panic")
+		}
+	}()
+	Register(Calculator{Name: "x", Compute: func(Input, *Stats) {}})
+}
+
+func TestComputed(t *testing.T) {
+	var order []string
+	withCalculators(t, recording("a", &order), recording("b", &order))
+	selected, err := CalculateMetrics(nil, "", time.Time{}, []string{"a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		stats  Stats
+		metric string
+		want   bool
+	}{
+		{name: "zero stats have everything", stats: Stats{}, metric: "a", want: true},
+		{name: "CalculateStats has everything", stats: CalculateStats(nil, "", time.Time{}), metric: "b", want: true},
+		{name: "selected metric", stats: selected, metric: "a", want: true},
+		{name: "unselected metric", stats: selected, metric: "b", want: false},
+		{name: "unknown metric of a selection", stats: selected, metric: "nope", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.Computed(tt.metric); got != tt.want {
+				t.Errorf("Computed(%q) = %v, want %v", tt.metric, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMetricsSelection runs --metrics selections against the registered calculators.
+func TestMetricsSelection(t *testing.T) {
+	created := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	prs := []github.PullRequest{
+		{Number: 1, CreatedAt: created, Merged: true, MergedAt: created.Add(4 * time.Hour), LeadTime: 4 * time.Hour},
+		{Number: 2, CreatedAt: created, Merged: true, MergedAt: created.Add(8 * time.Hour), LeadTime: 8 * time.Hour},
+		{Number: 3, CreatedAt: created},
+	}
+
+	tests := []struct {
+		name         string
+		selected     []string
+		computed     []string
+		notComputed  []string
+		wantTotal    int
+		wantLeadTime time.Duration
+	}{
+		{
+			name:         "everything",
+			computed:     []string{"volume", "lead-time", "merge-wait", "comment-timing"},
+			wantTotal:    3,
+			wantLeadTime: 6 * time.Hour,
+		},
+		{
+			name:         "lead time only",
+			selected:     []string{"lead-time"},
+			computed:     []string{"lead-time"},
+			notComputed:  []string{"volume", "merge-wait"},
+			wantLeadTime: 6 * time.Hour,
+		},
+		{
+			name:        "requirement pulled in",
+			selected:    []string{"merge-wait"},
+			computed:    []string{"merge-wait", "volume"},
+			notComputed: []string{"lead-time", "self-merge"},
+			wantTotal:   3,
+		},
+		{
+			name:        "comment timing needs comments",
+			selected:    []string{"comment-timing"},
+			computed:    []string{"comment-timing", "comments"},
+			notComputed: []string{"volume", "review-comments"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := CalculateMetrics(prs, "main", created.Add(24*time.Hour), tt.selected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range tt.computed {
+				if !s.Computed(name) {
+					t.Errorf("%s was not computed", name)
+				}
+			}
+			for _, name := range tt.notComputed {
+				if s.Computed(name) {
+					t.Errorf("%s was computed", name)
+				}
+			}
+			if s.TotalPRs != tt.wantTotal {
+				t.Errorf("TotalPRs = %d, want %d", s.TotalPRs, tt.wantTotal)
+			}
+			if s.MedianLeadTime != tt.wantLeadTime {
+				t.Errorf("MedianLeadTime = %v, want %v", s.MedianLeadTime, tt.wantLeadTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"visuche/internal/github"
+)
+
+// Review comment categories assigned by ClassifyReviewComment.
+const (
+	CommentNit      = "nit"
+	CommentBlocking = "blocking"
+	CommentQuestion = "question"
+	CommentApproval = "approval"
+	CommentOther    = "other"
+)
+
+// CommentCategories lists the categories in display order.
+var CommentCategories = []string{CommentBlocking, CommentQuestion, CommentNit, CommentApproval, CommentOther}
+
+var (
+	nitPattern      = regexp.MustCompile(`(?i)^\W*(nit(pick)?|minor|optional|style|tiny)\b|\bnit(pick)?:|\(nit\)|\bnon-?blocking\b`)
+	blockingPattern = regexp.MustCompile(`(?i)\b(must|blocking|blocker|needs to|have to|has to|required|please (fix|change|revert|remove)|breaks?|broken|won'?t work|doesn'?t work|bug|crash(es)?|panics?|race|leaks?|security|vulnerab\w*|incorrect|wrong)\b`)
+	questionPattern = regexp.MustCompile(`(?i)\?|^\W*(why|what|how|is|are|does|can|could|should|would)\b`)
+	approvalPattern = regexp.MustCompile(`(?i)\b(lgtm|looks good|ship it|approved?|nice|great|awesome|well done|thanks?|thank you)\b|:\+1:|\+1|👍|🚀|🎉`)
+)
+
+// ClassifyReviewComment assigns a review comment to a category with keyword rules, checked in order:
+// explicit nit markers, blocking requests (a changes-requested review or words like "must" or "bug"),
+// questions, approval remarks (an approving review or words like "LGTM"), else CommentOther.
+func ClassifyReviewComment(c github.ReviewComment) string {
+	body := strings.TrimSpace(c.Body)
+	switch {
+	case nitPattern.MatchString(body):
+		return CommentNit
+	case c.State == "CHANGES_REQUESTED" || blockingPattern.MatchString(body):
+		return CommentBlocking
+	case questionPattern.MatchString(body):
+		return CommentQuestion
+	case c.State == "APPROVED" || approvalPattern.MatchString(body):
+		return CommentApproval
+	}
+	return CommentOther
+}
+
+// CommentMix counts review comments per category.
+type CommentMix struct {
+	Total  int
+	Counts map[string]int // Category → comments
+}
+
+// Share returns the percentage of comments in category.
+func (m CommentMix) Share(category string) float64 {
+	if m.Total == 0 {
+		return 0
+	}
+	return float64(m.Counts[category]) / float64(m.Total) * 100
+}
+
+func (m *CommentMix) add(category string) {
+	if m.Counts == nil {
+		m.Counts = make(map[string]int)
+	}
+	m.Counts[category]++
+	m.Total++
+}
+
+// ReviewerCommentMix is the comment mix of one reviewer.
+type ReviewerCommentMix struct {
+	Reviewer string
+	CommentMix
+}
+
+// CommentCategoryReport is the mix of review comment categories for the repository and per reviewer.
+type CommentCategoryReport struct {
+	Repo      CommentMix
+	PRs       int                  // PRs with at least one categorized comment
+	Reviewers []ReviewerCommentMix // Most comments first
+}
+
+// CalculateCommentCategories classifies the review comments attached by FetchReviewComments. Comments
+// by the PR author (replies to feedback) and by bots are left out.
+func CalculateCommentCategories(prs []github.PullRequest) CommentCategoryReport {
+	var report CommentCategoryReport
+	byReviewer := make(map[string]*ReviewerCommentMix)
+	for _, pr := range prs {
+		counted := false
+		for _, c := range pr.ReviewComments {
+			if c.Author == pr.Author.Login || github.IsBotLogin(c.Author) || strings.TrimSpace(c.Body) == "" {
+				continue
+			}
+			category := ClassifyReviewComment(c)
+			report.Repo.add(category)
+			r, ok := byReviewer[c.Author]
+			if !ok {
+				r = &ReviewerCommentMix{Reviewer: c.Author}
+				byReviewer[c.Author] = r
+			}
+			r.add(category)
+			counted = true
+		}
+		if counted {
+			report.PRs++
+		}
+	}
+
+	for _, r := range byReviewer {
+		report.Reviewers = append(report.Reviewers, *r)
+	}
+	sort.Slice(report.Reviewers, func(i, j int) bool {
+		if report.Reviewers[i].Total != report.Reviewers[j].Total {
+			return report.Reviewers[i].Total > report.Reviewers[j].Total
+		}
+		return report.Reviewers[i].Reviewer < report.Reviewers[j].Reviewer
+	})
+	return report
+}
@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"visuche/internal/actions"
+)
+
+// CrossMetrics relates CI usage to delivered PRs over the same period.
+type CrossMetrics struct {
+	MergedPRs             int
+	CIRuns                int
+	FailedRuns            int
+	PRTriggeredRuns       int     // Runs triggered by pull_request / pull_request_target events
+	CIMinutes             float64 // Wall-clock minutes of completed runs
+	CIMinutesPerMergedPR  float64
+	CIRunsPerMergedPR     float64
+	FailedRunsPerMergedPR float64
+}
+
+// CalculateCrossMetrics combines PR stats with the workflow runs of the same period. Per-PR
+// ratios are zero when nothing was merged.
+func CalculateCrossMetrics(s Stats, runs []actions.WorkflowRun) CrossMetrics {
+	m := CrossMetrics{MergedPRs: s.MergedPRs, CIRuns: len(runs)}
+	for _, run := range runs {
+		if run.Conclusion == "failure" || run.Conclusion == "timed_out" {
+			m.FailedRuns++
+		}
+		if run.Event == "pull_request" || run.Event == "pull_request_target" {
+			m.PRTriggeredRuns++
+		}
+		if run.Status == "completed" && !run.StartedAt.IsZero() && run.UpdatedAt.After(run.StartedAt) {
+			m.CIMinutes += run.UpdatedAt.Sub(run.StartedAt).Minutes()
+		}
+	}
+
+	if m.MergedPRs > 0 {
+		merged := float64(m.MergedPRs)
+		m.CIMinutesPerMergedPR = m.CIMinutes / merged
+		m.CIRunsPerMergedPR = float64(m.CIRuns) / merged
+		m.FailedRunsPerMergedPR = float64(m.FailedRuns) / merged
+	}
+	return m
+}
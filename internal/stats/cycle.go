@@ -0,0 +1,70 @@
+package stats
+
+import (
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// CalculateCycleStages fills in the per-PR cycle-time stages:
+// coding (first commit → open), pickup (open → first review),
+// review (first review → final approval) and merge (final approval → merge).
+// Stages whose boundary timestamps are unknown are left at zero.
+func CalculateCycleStages(prs []github.PullRequest) []github.PullRequest {
+	for i := range prs {
+		pr := &prs[i]
+
+		firstCommit := pr.FirstCommitAt
+		for _, c := range pr.Commits {
+			if firstCommit.IsZero() || c.CommittedDate.Before(firstCommit) {
+				firstCommit = c.CommittedDate
+			}
+		}
+		if pr.FirstCommitAt.IsZero() {
+			pr.FirstCommitAt = firstCommit
+		}
+
+		var firstReview, lastApproval time.Time
+		for _, r := range pr.Reviews {
+			if firstReview.IsZero() || r.SubmittedAt.Before(firstReview) {
+				firstReview = r.SubmittedAt
+			}
+			if strings.EqualFold(r.State, "APPROVED") && r.SubmittedAt.After(lastApproval) {
+				lastApproval = r.SubmittedAt
+			}
+		}
+
+		pr.CodingTime = positiveDuration(pr.FirstCommitAt, pr.CreatedAt)
+		pr.PickupTime = positiveDuration(pr.ReviewableAt(), firstReview)
+		pr.ReviewTime = positiveDuration(firstReview, lastApproval)
+		if pr.Merged {
+			pr.MergeTime = positiveDuration(lastApproval, pr.MergedAt)
+		}
+	}
+	return prs
+}
+
+// lastApprovalTime returns when pr was last approved, or zero when it never was.
+func lastApprovalTime(pr github.PullRequest) time.Time {
+	var lastApproval time.Time
+	for _, r := range pr.Reviews {
+		if strings.EqualFold(r.State, "APPROVED") && r.SubmittedAt.After(lastApproval) {
+			lastApproval = r.SubmittedAt
+		}
+	}
+	return lastApproval
+}
+
+// positiveDuration returns end-start when both are known and end is after start, otherwise zero.
+func positiveDuration(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// averageAndMedian returns the mean and median of the non-zero durations.
+func averageAndMedian(durations []time.Duration) (time.Duration, time.Duration) {
+	summary := Summary(nonZero(durations))
+	return summary.Mean, summary.Median
+}
@@ -0,0 +1,91 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// StuckDependencyAge is how long a dependency update may stay open before it counts as stuck.
+const StuckDependencyAge = 7 * 24 * time.Hour
+
+// DependencyToolStats holds dependency update metrics for a single tool (dependabot/renovate).
+type DependencyToolStats struct {
+	Tool       string
+	Opened     int
+	Merged     int
+	AutoMerged int
+	Closed     int // Closed without merging
+	Open       int
+	StuckOpen  int
+}
+
+// DependencyStats summarizes dependency update PRs, kept apart from human PR metrics.
+type DependencyStats struct {
+	Opened             int
+	Merged             int
+	AutoMerged         int
+	Closed             int // Closed without merging
+	Open               int
+	StuckOpen          int
+	AverageTimeToMerge time.Duration
+	MedianTimeToMerge  time.Duration
+	Tools              []DependencyToolStats
+	StuckPRs           []github.PullRequest // Oldest first
+}
+
+// CalculateDependencyStats aggregates Dependabot/Renovate PRs. PRs that are not dependency updates are ignored.
+func CalculateDependencyStats(prs []github.PullRequest, now time.Time) DependencyStats {
+	var result DependencyStats
+	tools := make(map[string]*DependencyToolStats)
+	var mergeTimes []time.Duration
+
+	for _, pr := range prs {
+		tool := pr.DependencyTool()
+		if tool == "" {
+			continue
+		}
+		t, ok := tools[tool]
+		if !ok {
+			t = &DependencyToolStats{Tool: tool}
+			tools[tool] = t
+		}
+
+		result.Opened++
+		t.Opened++
+
+		switch {
+		case pr.Merged:
+			result.Merged++
+			t.Merged++
+			if pr.IsAutomatedMerge() {
+				result.AutoMerged++
+				t.AutoMerged++
+			}
+			if !pr.MergedAt.IsZero() {
+				mergeTimes = append(mergeTimes, pr.MergedAt.Sub(pr.CreatedAt))
+			}
+		case pr.State == "OPEN":
+			result.Open++
+			t.Open++
+			if now.Sub(pr.CreatedAt) > StuckDependencyAge {
+				result.StuckOpen++
+				t.StuckOpen++
+				result.StuckPRs = append(result.StuckPRs, pr)
+			}
+		default:
+			result.Closed++
+			t.Closed++
+		}
+	}
+
+	result.AverageTimeToMerge, result.MedianTimeToMerge = averageAndMedian(mergeTimes)
+
+	for _, t := range tools {
+		result.Tools = append(result.Tools, *t)
+	}
+	sort.Slice(result.Tools, func(i, j int) bool { return result.Tools[i].Tool < result.Tools[j].Tool })
+	sort.Slice(result.StuckPRs, func(i, j int) bool { return result.StuckPRs[i].CreatedAt.Before(result.StuckPRs[j].CreatedAt) })
+
+	return result
+}
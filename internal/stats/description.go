@@ -0,0 +1,197 @@
+package stats
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+var (
+	// linkedIssuePattern matches closing keywords followed by an issue reference (#123, owner/repo#123 or an issue URL).
+	linkedIssuePattern = regexp.MustCompile(`(?i)\b(close[sd]?|fix(e[sd])?|resolve[sd]?)\s*:?\s+(\S+/\S+)?#\d+|(close[sd]?|fix(e[sd])?|resolve[sd]?)\s*:?\s+https?://\S+/issues/\d+`)
+	// checklistPattern matches Markdown task list items and captures the checkbox state.
+	checklistPattern = regexp.MustCompile(`(?m)^\s*[-*+]\s+\[([ xX])\]`)
+	// htmlCommentPattern matches HTML comments, which PR templates use for instructions.
+	htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+)
+
+// Body length thresholds (in characters, template comments excluded) used to bucket descriptions.
+const (
+	ShortDescriptionLength = 100
+	LongDescriptionLength  = 500
+)
+
+// DescriptionQuality describes how well a PR body is written.
+type DescriptionQuality struct {
+	Length           int  // Characters, excluding HTML comments and surrounding whitespace
+	HasLinkedIssue   bool // Body contains "fixes #123" style references
+	ChecklistTotal   int
+	ChecklistChecked int
+}
+
+// ChecklistComplete reports whether the body has a checklist with every item checked.
+func (q DescriptionQuality) ChecklistComplete() bool {
+	return q.ChecklistTotal > 0 && q.ChecklistChecked == q.ChecklistTotal
+}
+
+// AnalyzeDescription extracts description quality signals from a PR body.
+func AnalyzeDescription(body string) DescriptionQuality {
+	text := strings.TrimSpace(htmlCommentPattern.ReplaceAllString(body, ""))
+	quality := DescriptionQuality{
+		Length:         len([]rune(text)),
+		HasLinkedIssue: linkedIssuePattern.MatchString(text),
+	}
+	for _, match := range checklistPattern.FindAllStringSubmatch(text, -1) {
+		quality.ChecklistTotal++
+		if match[1] != " " {
+			quality.ChecklistChecked++
+		}
+	}
+	return quality
+}
+
+// DescriptionGroup holds review outcomes for PRs sharing a description trait.
+type DescriptionGroup struct {
+	Label                string
+	PRs                  int
+	MedianTimeToApproval time.Duration // PR open → final approval (pickup + review stages)
+	AverageComments      float64
+	MedianComments       float64
+}
+
+// DescriptionReport correlates description quality with review speed and discussion volume.
+type DescriptionReport struct {
+	AnalyzedPRs         int
+	AverageLength       float64
+	LinkedIssueRate     float64 // Percentage of PRs referencing an issue
+	ChecklistRate       float64 // Percentage of PRs with a checklist
+	ChecklistCompletion float64 // Percentage of checklist items checked, across PRs with checklists
+	ByLength            []DescriptionGroup
+	ByLinkedIssue       []DescriptionGroup
+	ByChecklist         []DescriptionGroup
+	LengthVsApproval    float64 // Pearson correlation between body length and time to approval
+	LengthVsComments    float64 // Pearson correlation between body length and comment count
+	HasApprovalSamples  bool
+}
+
+// CalculateDescriptionReport builds the description quality report. Open PRs are included for
+// comment counts; only PRs that reached approval contribute review times.
+func CalculateDescriptionReport(prs []github.PullRequest) DescriptionReport {
+	var report DescriptionReport
+	if len(prs) == 0 {
+		return report
+	}
+
+	type sample struct {
+		quality  DescriptionQuality
+		approval time.Duration
+		comments int
+	}
+
+	samples := make([]sample, 0, len(prs))
+	totalLength, linked, withChecklist, checklistItems, checklistChecked := 0, 0, 0, 0, 0
+	for _, pr := range prs {
+		q := AnalyzeDescription(pr.Body)
+		samples = append(samples, sample{
+			quality:  q,
+			approval: pr.PickupTime + pr.ReviewTime,
+			comments: pr.CommentCount + pr.ReviewCommentCount,
+		})
+		totalLength += q.Length
+		if q.HasLinkedIssue {
+			linked++
+		}
+		if q.ChecklistTotal > 0 {
+			withChecklist++
+			checklistItems += q.ChecklistTotal
+			checklistChecked += q.ChecklistChecked
+		}
+	}
+
+	report.AnalyzedPRs = len(samples)
+	report.AverageLength = float64(totalLength) / float64(len(samples))
+	report.LinkedIssueRate = float64(linked) / float64(len(samples)) * 100
+	report.ChecklistRate = float64(withChecklist) / float64(len(samples)) * 100
+	if checklistItems > 0 {
+		report.ChecklistCompletion = float64(checklistChecked) / float64(checklistItems) * 100
+	}
+
+	group := func(label string, match func(DescriptionQuality) bool) DescriptionGroup {
+		g := DescriptionGroup{Label: label}
+		var approvals []time.Duration
+		var comments []int
+		for _, s := range samples {
+			if !match(s.quality) {
+				continue
+			}
+			g.PRs++
+			approvals = append(approvals, s.approval)
+			comments = append(comments, s.comments)
+		}
+		_, g.MedianTimeToApproval = averageAndMedian(approvals)
+		counts := SummarizeCounts(comments)
+		g.AverageComments, g.MedianComments = counts.Mean, counts.Median
+		return g
+	}
+
+	report.ByLength = []DescriptionGroup{
+		group("Short", func(q DescriptionQuality) bool { return q.Length < ShortDescriptionLength }),
+		group("Medium", func(q DescriptionQuality) bool {
+			return q.Length >= ShortDescriptionLength && q.Length < LongDescriptionLength
+		}),
+		group("Long", func(q DescriptionQuality) bool { return q.Length >= LongDescriptionLength }),
+	}
+	report.ByLinkedIssue = []DescriptionGroup{
+		group("Linked issue", func(q DescriptionQuality) bool { return q.HasLinkedIssue }),
+		group("No linked issue", func(q DescriptionQuality) bool { return !q.HasLinkedIssue }),
+	}
+	report.ByChecklist = []DescriptionGroup{
+		group("Checklist complete", func(q DescriptionQuality) bool { return q.ChecklistComplete() }),
+		group("Checklist incomplete", func(q DescriptionQuality) bool { return q.ChecklistTotal > 0 && !q.ChecklistComplete() }),
+		group("No checklist", func(q DescriptionQuality) bool { return q.ChecklistTotal == 0 }),
+	}
+
+	var lengths, approvalHours, allLengths, commentCounts []float64
+	for _, s := range samples {
+		allLengths = append(allLengths, float64(s.quality.Length))
+		commentCounts = append(commentCounts, float64(s.comments))
+		if s.approval > 0 {
+			lengths = append(lengths, float64(s.quality.Length))
+			approvalHours = append(approvalHours, s.approval.Hours())
+		}
+	}
+	report.HasApprovalSamples = len(approvalHours) > 1
+	report.LengthVsApproval = pearson(lengths, approvalHours)
+	report.LengthVsComments = pearson(allLengths, commentCounts)
+
+	return report
+}
+
+// pearson returns the Pearson correlation coefficient of xs and ys, or 0 when it is undefined.
+func pearson(xs, ys []float64) float64 {
+	n := len(xs)
+	if n < 2 || n != len(ys) {
+		return 0
+	}
+	var meanX, meanY float64
+	for i := 0; i < n; i++ {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	var cov, varX, varY float64
+	for i := 0; i < n; i++ {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
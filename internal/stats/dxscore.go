@@ -0,0 +1,173 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/actions"
+)
+
+// DX score component names.
+const (
+	DXLeadTime      = "Lead Time"
+	DXReviewLatency = "Review Latency"
+	DXCIWait        = "CI Wait"
+	DXFailureRate   = "CI Failure Rate"
+)
+
+// DXWeights weighs the components of the developer experience score. Weights are relative; a zero
+// weight leaves the component out.
+type DXWeights struct {
+	LeadTime      float64
+	ReviewLatency float64
+	CIWait        float64
+	FailureRate   float64
+}
+
+// dxThreshold maps a raw value onto 0–100 points: Good or better scores 100, Poor or worse scores 0,
+// with a linear scale in between. The thresholds are opinionated defaults, not benchmarks.
+type dxThreshold struct {
+	Good, Poor float64
+}
+
+var dxThresholds = map[string]dxThreshold{
+	DXLeadTime:      {Good: 24, Poor: 168}, // Hours
+	DXReviewLatency: {Good: 4, Poor: 48},   // Hours
+	DXCIWait:        {Good: 10, Poor: 60},  // Minutes
+	DXFailureRate:   {Good: 5, Poor: 30},   // Percent
+}
+
+// DXComponent is one input of the developer experience score.
+type DXComponent struct {
+	Name         string
+	Value        float64 // Raw value in Unit
+	Unit         string  // "h", "min" or "%"
+	Score        float64 // 0–100
+	Weight       float64 // Normalized over the components with data, sums to 1
+	Contribution float64 // Score × Weight: the points the component adds to the total
+}
+
+// DXScore is a composite developer experience score with its per-component breakdown.
+type DXScore struct {
+	Score      float64 // 0–100
+	Grade      string  // A–F
+	Components []DXComponent
+	Missing    []string // Weighted components without data in the period
+}
+
+// CalculateDXScore combines the median lead time, median time to first review (pickup time when
+// review timestamps are unavailable), median CI wait for PR-triggered runs (all runs when none are)
+// and the CI failure rate into one weighted score. Components without data are left out and the
+// remaining weights renormalized.
+func CalculateDXScore(s Stats, runs []actions.WorkflowRun, weights DXWeights) DXScore {
+	type input struct {
+		name   string
+		weight float64
+		value  float64
+		unit   string
+		ok     bool
+	}
+
+	reviewLatency := s.MedianTimeToFirstReview
+	if reviewLatency == 0 {
+		reviewLatency = s.MedianPickupTime
+	}
+	ciWait, failureRate, ciOK := ciWaitAndFailureRate(runs)
+
+	inputs := []input{
+		{DXLeadTime, weights.LeadTime, s.MedianLeadTime.Hours(), "h", s.MergedPRs > 0 && s.MedianLeadTime > 0},
+		{DXReviewLatency, weights.ReviewLatency, reviewLatency.Hours(), "h", reviewLatency > 0},
+		{DXCIWait, weights.CIWait, ciWait.Minutes(), "min", ciOK},
+		{DXFailureRate, weights.FailureRate, failureRate * 100, "%", ciOK},
+	}
+
+	var score DXScore
+	totalWeight := 0.0
+	for _, in := range inputs {
+		if in.weight <= 0 {
+			continue
+		}
+		if !in.ok {
+			score.Missing = append(score.Missing, in.name)
+			continue
+		}
+		totalWeight += in.weight
+		score.Components = append(score.Components, DXComponent{
+			Name:   in.name,
+			Value:  in.value,
+			Unit:   in.unit,
+			Score:  dxThresholds[in.name].points(in.value),
+			Weight: in.weight,
+		})
+	}
+	if totalWeight == 0 {
+		return score
+	}
+
+	for i := range score.Components {
+		c := &score.Components[i]
+		c.Weight /= totalWeight
+		c.Contribution = c.Score * c.Weight
+		score.Score += c.Contribution
+	}
+	score.Grade = dxGrade(score.Score)
+	return score
+}
+
+func (t dxThreshold) points(value float64) float64 {
+	switch {
+	case value <= t.Good:
+		return 100
+	case value >= t.Poor:
+		return 0
+	default:
+		return 100 * (t.Poor - value) / (t.Poor - t.Good)
+	}
+}
+
+// dxGrade maps a score onto a school letter grade.
+func dxGrade(score float64) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	case score >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// ciWaitAndFailureRate returns the median duration of completed PR-triggered runs (all completed
+// runs when there are none) and the share of completed runs that failed or timed out.
+func ciWaitAndFailureRate(runs []actions.WorkflowRun) (time.Duration, float64, bool) {
+	var prDurations, allDurations []time.Duration
+	completed, failed := 0, 0
+	for _, run := range runs {
+		if run.Status != "completed" || run.Conclusion == "cancelled" || run.Conclusion == "skipped" {
+			continue
+		}
+		completed++
+		if run.Conclusion == "failure" || run.Conclusion == "timed_out" {
+			failed++
+		}
+		if run.StartedAt.IsZero() || !run.UpdatedAt.After(run.StartedAt) {
+			continue
+		}
+		d := run.UpdatedAt.Sub(run.StartedAt)
+		allDurations = append(allDurations, d)
+		if run.Event == "pull_request" || run.Event == "pull_request_target" {
+			prDurations = append(prDurations, d)
+		}
+	}
+	if completed == 0 || len(allDurations) == 0 {
+		return 0, 0, false
+	}
+
+	durations := prDurations
+	if len(durations) == 0 {
+		durations = allDurations
+	}
+	return Summary(durations).Median, float64(failed) / float64(completed), true
+}
@@ -0,0 +1,25 @@
+package stats
+
+import "visuche/internal/github"
+
+// PRAuthors counts PRs per author, most active first.
+func PRAuthors(prs []github.PullRequest) []NamedCount {
+	counts := make(map[string]int)
+	for _, pr := range prs {
+		if pr.Author.Login != "" {
+			counts[pr.Author.Login]++
+		}
+	}
+	return topCounts(counts, len(counts))
+}
+
+// PRLabels counts PRs per label, most used first.
+func PRLabels(prs []github.PullRequest) []NamedCount {
+	counts := make(map[string]int)
+	for _, pr := range prs {
+		for _, l := range pr.Labels {
+			counts[l.Name]++
+		}
+	}
+	return topCounts(counts, len(counts))
+}
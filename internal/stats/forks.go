@@ -0,0 +1,70 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/github"
+)
+
+// ContributionGroup holds review and merge outcomes for PRs opened from forks or from branches of the
+// repository itself.
+type ContributionGroup struct {
+	PRs                int
+	Contributors       int // Distinct authors
+	Merged             int
+	Closed             int // Closed without merging
+	Open               int
+	UnreviewedOpen     int     // Open PRs without any review yet
+	MergeRate          float64 // Percentage of closed PRs that were merged
+	MedianTimeToReview time.Duration
+	MedianLeadTime     time.Duration
+}
+
+// ForkReport compares PRs from forks (external contributions) with PRs from same-repository branches.
+type ForkReport struct {
+	Fork     ContributionGroup
+	SameRepo ContributionGroup
+}
+
+// CalculateForkReport splits the PRs by whether they were opened from a fork.
+func CalculateForkReport(prs []github.PullRequest) ForkReport {
+	var fork, sameRepo []github.PullRequest
+	for _, pr := range prs {
+		if pr.IsCrossRepository {
+			fork = append(fork, pr)
+		} else {
+			sameRepo = append(sameRepo, pr)
+		}
+	}
+	return ForkReport{Fork: contributionGroup(fork), SameRepo: contributionGroup(sameRepo)}
+}
+
+func contributionGroup(prs []github.PullRequest) ContributionGroup {
+	group := ContributionGroup{PRs: len(prs)}
+	authors := make(map[string]bool)
+	var pickup, lead []time.Duration
+	for _, pr := range prs {
+		authors[pr.Author.Login] = true
+		switch {
+		case pr.Merged:
+			group.Merged++
+			lead = append(lead, pr.LeadTime)
+		case pr.State == "OPEN":
+			group.Open++
+			if len(pr.Reviews) == 0 {
+				group.UnreviewedOpen++
+			}
+		default:
+			group.Closed++
+		}
+		if pr.PickupTime > 0 {
+			pickup = append(pickup, pr.PickupTime)
+		}
+	}
+	group.Contributors = len(authors)
+	if closed := group.Merged + group.Closed; closed > 0 {
+		group.MergeRate = float64(group.Merged) / float64(closed) * 100
+	}
+	_, group.MedianTimeToReview = averageAndMedian(pickup)
+	_, group.MedianLeadTime = averageAndMedian(lead)
+	return group
+}
@@ -0,0 +1,122 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// UnassignedGroup is the group name used for logins without a mapping.
+const UnassignedGroup = "(unassigned)"
+
+// NoLabelGroup is the group name used for PRs without labels.
+const NoLabelGroup = "(no label)"
+
+// GroupStats holds the PR metrics of one group (e.g. a team) along with the reviews its members gave.
+type GroupStats struct {
+	Name         string
+	Stats        Stats // Metrics of PRs authored by the group
+	ReviewsGiven int   // Reviews submitted by group members on other people's PRs
+	PRsReviewed  int   // Distinct PRs group members reviewed
+}
+
+// CalculateGroupStats splits PRs by the group of their author and calculates full stats per group.
+// groupOf maps a login to its group; an empty result puts the login in UnassignedGroup.
+func CalculateGroupStats(prs []github.PullRequest, groupOf func(login string) string, defaultBranch string, now time.Time) []GroupStats {
+	resolve := func(login string) string {
+		if group := groupOf(login); group != "" {
+			return group
+		}
+		return UnassignedGroup
+	}
+
+	authored := make(map[string][]github.PullRequest)
+	reviewsGiven := make(map[string]int)
+	prsReviewed := make(map[string]int)
+	for _, pr := range prs {
+		authored[resolve(pr.Author.Login)] = append(authored[resolve(pr.Author.Login)], pr)
+
+		reviewedBy := make(map[string]bool)
+		for _, review := range pr.Reviews {
+			if review.Author.Login == "" || review.Author.Login == pr.Author.Login {
+				continue
+			}
+			group := resolve(review.Author.Login)
+			reviewsGiven[group]++
+			reviewedBy[group] = true
+		}
+		for group := range reviewedBy {
+			prsReviewed[group]++
+		}
+	}
+
+	names := make(map[string]bool)
+	for name := range authored {
+		names[name] = true
+	}
+	for name := range reviewsGiven {
+		names[name] = true
+	}
+
+	groups := make([]GroupStats, 0, len(names))
+	for name := range names {
+		groups = append(groups, GroupStats{
+			Name:         name,
+			Stats:        CalculateStats(authored[name], defaultBranch, now),
+			ReviewsGiven: reviewsGiven[name],
+			PRsReviewed:  prsReviewed[name],
+		})
+	}
+
+	sortGroups(groups, UnassignedGroup)
+	return groups
+}
+
+// CalculateLabelStats calculates full stats per PR label; a PR with several labels counts in each, and PRs
+// without labels form NoLabelGroup. ReviewsGiven and PRsReviewed count the reviews the label's PRs received
+// from people other than their author.
+func CalculateLabelStats(prs []github.PullRequest, defaultBranch string, now time.Time) []GroupStats {
+	labeled := make(map[string][]github.PullRequest)
+	for _, pr := range prs {
+		names := pr.LabelNames()
+		if len(names) == 0 {
+			names = []string{NoLabelGroup}
+		}
+		for _, name := range names {
+			labeled[name] = append(labeled[name], pr)
+		}
+	}
+
+	groups := make([]GroupStats, 0, len(labeled))
+	for name, labelPRs := range labeled {
+		group := GroupStats{Name: name, Stats: CalculateStats(labelPRs, defaultBranch, now)}
+		for _, pr := range labelPRs {
+			reviewed := false
+			for _, review := range pr.Reviews {
+				if review.Author.Login != "" && review.Author.Login != pr.Author.Login {
+					group.ReviewsGiven++
+					reviewed = true
+				}
+			}
+			if reviewed {
+				group.PRsReviewed++
+			}
+		}
+		groups = append(groups, group)
+	}
+	sortGroups(groups, NoLabelGroup)
+	return groups
+}
+
+// sortGroups orders groups busiest first, with the catch-all group last.
+func sortGroups(groups []GroupStats, catchAll string) {
+	sort.Slice(groups, func(i, j int) bool {
+		if (groups[i].Name == catchAll) != (groups[j].Name == catchAll) {
+			return groups[j].Name == catchAll
+		}
+		if groups[i].Stats.TotalPRs != groups[j].Stats.TotalPRs {
+			return groups[i].Stats.TotalPRs > groups[j].Stats.TotalPRs
+		}
+		return groups[i].Name < groups[j].Name
+	})
+}
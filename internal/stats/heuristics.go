@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"regexp"
+	"strings"
+	"visuche/internal/github"
+)
+
+// Heuristics holds the tunable rules behind the release, hotfix and WIP metrics.
+type Heuristics struct {
+	ReleaseBranches []string         // Base branches whose merges count as releases; empty means the default branch (or main/master)
+	HotfixPatterns  []*regexp.Regexp // Head branch patterns of hotfix PRs
+	HotfixLabels    []string         // PRs with one of these labels are hotfixes too
+	RevertLabels    []string         // PRs with one of these labels are reverts too, besides "revert" titles
+	WIPLabels       []string         // Open PRs with one of these labels count as WIP, like drafts
+	WIPTitlePattern *regexp.Regexp   // Open PRs whose title matches count as WIP, like drafts; nil disables
+	// Long-lived integration branches (git-flow's develop) that PRs are never stacked on, besides the
+	// default and release branches
+	LongLivedBranches []string
+}
+
+// DefaultHeuristics returns the built-in rules: releases are merges into the default branch, hotfixes
+// come from branches starting with "hotfix", only drafts are WIP and develop/development/dev are
+// long-lived.
+func DefaultHeuristics() Heuristics {
+	return Heuristics{
+		HotfixPatterns:    []*regexp.Regexp{regexp.MustCompile(`(?i)^hotfix`)},
+		LongLivedBranches: []string{"develop", "development", "dev"},
+	}
+}
+
+// heuristics are the rules in effect, see SetHeuristics.
+var heuristics = DefaultHeuristics()
+
+// SetHeuristics replaces the rules used by CalculateStats and the reports built on it.
+func SetHeuristics(h Heuristics) {
+	heuristics = h
+}
+
+// CurrentHeuristics returns the rules in effect.
+func CurrentHeuristics() Heuristics {
+	return heuristics
+}
+
+// isHotfix reports whether pr is a hotfix (head branch pattern or hotfix label).
+func isHotfix(pr github.PullRequest) bool {
+	for _, pattern := range heuristics.HotfixPatterns {
+		if pattern.MatchString(pr.HeadRefName) {
+			return true
+		}
+	}
+	return hasAnyLabel(pr, heuristics.HotfixLabels)
+}
+
+// hasAnyLabel reports whether pr carries one of labels.
+func hasAnyLabel(pr github.PullRequest, labels []string) bool {
+	for _, label := range labels {
+		if pr.HasLabel(label) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReleaseBranch reports whether merges into branch count as releases.
+func isReleaseBranch(branch, defaultBranch string) bool {
+	if len(heuristics.ReleaseBranches) == 0 {
+		return IsDefaultBranch(branch, defaultBranch)
+	}
+	for _, b := range heuristics.ReleaseBranches {
+		if strings.EqualFold(branch, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLongLivedBranch reports whether branch is the default branch, a release branch or a configured
+// long-lived branch.
+func isLongLivedBranch(branch, defaultBranch string) bool {
+	if IsDefaultBranch(branch, defaultBranch) || isReleaseBranch(branch, defaultBranch) {
+		return true
+	}
+	for _, b := range heuristics.LongLivedBranches {
+		if strings.EqualFold(branch, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWIP reports whether an open PR is work in progress: a draft, a WIP label or a WIP title.
+func isWIP(pr github.PullRequest) bool {
+	if pr.State != "OPEN" {
+		return false
+	}
+	if pr.IsDraft {
+		return true
+	}
+	if hasAnyLabel(pr, heuristics.WIPLabels) {
+		return true
+	}
+	return heuristics.WIPTitlePattern != nil && heuristics.WIPTitlePattern.MatchString(pr.Title)
+}
@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/github"
+	"visuche/internal/jira"
+)
+
+// JiraReport measures issue-to-production lead time for PRs merged into the default branch that reference Jira issues.
+type JiraReport struct {
+	MergedPRs int     // PRs merged into the default branch
+	LinkedPRs int     // Of those, PRs referencing at least one resolvable Jira issue
+	LinkRate  float64 // Percentage of those merged PRs linked to Jira
+
+	AverageCreatedToStarted time.Duration // Issue created → first status transition
+	MedianCreatedToStarted  time.Duration
+	AverageStartedToMerge   time.Duration // First status transition → PR merged
+	MedianStartedToMerge    time.Duration
+	AverageIssueToMerge     time.Duration // Issue created → PR merged (issue-to-production)
+	MedianIssueToMerge      time.Duration
+}
+
+// CalculateJiraReport builds the Jira lead time report. issuesByPR maps PR numbers to the Jira issues
+// they reference; when a PR references several issues the oldest one marks the start of the work.
+// Only merges into defaultBranch reach production, so PRs merged into other bases are left out.
+func CalculateJiraReport(prs []github.PullRequest, issuesByPR map[int][]jira.Issue, defaultBranch string) JiraReport {
+	var report JiraReport
+	var createdToStarted, startedToMerge, issueToMerge []time.Duration
+
+	for _, pr := range prs {
+		if !pr.Merged || pr.MergedAt.IsZero() || !IsDefaultBranch(pr.BaseRefName, defaultBranch) {
+			continue
+		}
+		report.MergedPRs++
+
+		issues := issuesByPR[pr.Number]
+		if len(issues) == 0 {
+			continue
+		}
+		report.LinkedPRs++
+
+		oldest := issues[0]
+		for _, issue := range issues[1:] {
+			if issue.CreatedAt.Before(oldest.CreatedAt) {
+				oldest = issue
+			}
+		}
+
+		createdToStarted = append(createdToStarted, positiveDuration(oldest.CreatedAt, oldest.StartedAt))
+		startedToMerge = append(startedToMerge, positiveDuration(oldest.StartedAt, pr.MergedAt))
+		issueToMerge = append(issueToMerge, positiveDuration(oldest.CreatedAt, pr.MergedAt))
+	}
+
+	if report.MergedPRs > 0 {
+		report.LinkRate = float64(report.LinkedPRs) / float64(report.MergedPRs) * 100
+	}
+	report.AverageCreatedToStarted, report.MedianCreatedToStarted = averageAndMedian(createdToStarted)
+	report.AverageStartedToMerge, report.MedianStartedToMerge = averageAndMedian(startedToMerge)
+	report.AverageIssueToMerge, report.MedianIssueToMerge = averageAndMedian(issueToMerge)
+
+	return report
+}
@@ -0,0 +1,65 @@
+package stats
+
+import "visuche/internal/github"
+
+// overstatedShare is how much larger than the landed change a PR's reported size has to be to count as overstated.
+const overstatedShare = 0.2
+
+// LandedSizeReport compares the size GitHub reports for merged PRs with the size of the commit they
+// landed as on the base branch. Only merge and squash merges are measured; rebase merges land several
+// commits and are counted separately.
+type LandedSizeReport struct {
+	Measured         int // Merged PRs with a known landed size
+	Squash           int
+	Merge            int
+	Rebase           int // Not measured
+	PRAdditions      int
+	PRDeletions      int
+	LandedAdditions  int
+	LandedDeletions  int
+	MedianPRSize     float64 // Median additions+deletions as reported for the PR
+	MedianLandedSize float64 // Median additions+deletions of the landed commit
+	Overstated       int     // PRs whose reported size exceeds the landed size by more than 20%
+	Overstatement    float64 // How much larger the total reported size is than the total landed size, in percent
+}
+
+// CalculateLandedSize builds the report from PRs enriched by FetchMergeCommits.
+func CalculateLandedSize(prs []github.PullRequest) LandedSizeReport {
+	var report LandedSizeReport
+	var prSizes, landedSizes []int
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+		switch pr.MergeMethod {
+		case github.MergeMethodSquash:
+			report.Squash++
+		case github.MergeMethodMerge:
+			report.Merge++
+		case github.MergeMethodRebase:
+			report.Rebase++
+		}
+		if !pr.HasLandedSize() {
+			continue
+		}
+		report.Measured++
+		report.PRAdditions += pr.Additions
+		report.PRDeletions += pr.Deletions
+		report.LandedAdditions += pr.LandedAdditions
+		report.LandedDeletions += pr.LandedDeletions
+
+		prSize, landed := pr.Additions+pr.Deletions, pr.LandedAdditions+pr.LandedDeletions
+		prSizes = append(prSizes, prSize)
+		landedSizes = append(landedSizes, landed)
+		if float64(prSize) > float64(landed)*(1+overstatedShare) {
+			report.Overstated++
+		}
+	}
+
+	report.MedianPRSize = SummarizeCounts(prSizes).Median
+	report.MedianLandedSize = SummarizeCounts(landedSizes).Median
+	if landed := report.LandedAdditions + report.LandedDeletions; landed > 0 {
+		report.Overstatement = float64(report.PRAdditions+report.PRDeletions-landed) / float64(landed) * 100
+	}
+	return report
+}
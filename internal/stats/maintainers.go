@@ -0,0 +1,91 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// MaintainerResponseReport summarizes how fast maintainers respond to PRs from forks against the
+// target in SLATarget.MaintainerResponse.
+type MaintainerResponseReport struct {
+	Target         SLATarget
+	ForkPRs        int // Non-draft PRs from forks
+	Responded      int
+	AwaitingOpen   int // Open PRs without a maintainer response yet
+	MedianResponse time.Duration
+	Evaluated      int
+	Met            int
+	Attainment     float64
+	Weekly         []SLABucket
+	Responders     []SLABucket // Responses per maintainer; a bucket is met when the response was within target
+	Violations     []SLAViolation
+}
+
+// CalculateMaintainerResponse evaluates the first maintainer response on non-draft PRs from forks,
+// as recorded by FetchMaintainerResponses. Like CalculateSLA, PRs still waiting count as violations
+// once they exceed the target and closed PRs that never got a response are not evaluated.
+func CalculateMaintainerResponse(prs []github.PullRequest, target SLATarget, now time.Time) MaintainerResponseReport {
+	report := MaintainerResponseReport{Target: target}
+	weekly := make(map[string]*SLABucket)
+	responders := make(map[string]*SLABucket)
+	var responses []time.Duration
+
+	for _, pr := range prs {
+		if !pr.IsCrossRepository || pr.IsDraft {
+			continue
+		}
+		report.ForkPRs++
+
+		var wait time.Duration
+		switch {
+		case !pr.FirstMaintainerResponseAt.IsZero():
+			wait = target.elapsed(pr.ReviewableAt(), pr.FirstMaintainerResponseAt)
+			report.Responded++
+			responses = append(responses, wait)
+		case pr.State == "OPEN":
+			report.AwaitingOpen++
+			wait = target.elapsed(pr.ReviewableAt(), now)
+			if wait <= target.MaintainerResponse {
+				continue // Still within target, nothing to judge yet
+			}
+		default:
+			continue
+		}
+
+		met := wait <= target.MaintainerResponse
+		report.Evaluated++
+		if met {
+			report.Met++
+		}
+
+		year, week := pr.CreatedAt.ISOWeek()
+		addSLAResult(weekly, isoWeekKey(year, week), met)
+		if pr.FirstMaintainerResponder != "" {
+			addSLAResult(responders, pr.FirstMaintainerResponder, met)
+		}
+
+		if !met {
+			report.Violations = append(report.Violations, SLAViolation{
+				Number:    pr.Number,
+				Title:     pr.Title,
+				URL:       pr.URL,
+				Author:    pr.Author.Login,
+				Reviewer:  pr.FirstMaintainerResponder,
+				CreatedAt: pr.CreatedAt,
+				Wait:      wait,
+			})
+		}
+	}
+
+	_, report.MedianResponse = averageAndMedian(responses)
+	if report.Evaluated > 0 {
+		report.Attainment = float64(report.Met) / float64(report.Evaluated) * 100.0
+	}
+	report.Weekly = sortedSLABuckets(weekly)
+	report.Responders = sortedSLABuckets(responders)
+	sort.Slice(report.Violations, func(i, j int) bool {
+		return report.Violations[i].Wait > report.Violations[j].Wait
+	})
+	return report
+}
@@ -0,0 +1,158 @@
+package stats
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"visuche/internal/github"
+)
+
+// conventionalTitlePattern matches "type(scope)!: subject" PR titles.
+var conventionalTitlePattern = regexp.MustCompile(`^\s*([A-Za-z]+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+
+// ConventionalTitle is a PR title split into its conventional-commit parts.
+type ConventionalTitle struct {
+	Type     string // Lower-cased, e.g. "feat" or "fix"
+	Scope    string
+	Breaking bool
+	Subject  string
+}
+
+// conventionalTypes are the type prefixes recognized in titles, so that "WIP: ..." or "Draft: ..." are not taken as types.
+var conventionalTypes = map[string]bool{
+	"feat": true, "feature": true, "fix": true, "bugfix": true, "hotfix": true, "perf": true, "refactor": true,
+	"docs": true, "doc": true, "deps": true, "chore": true, "style": true, "test": true, "tests": true,
+	"build": true, "ci": true, "revert": true, "release": true,
+}
+
+// ParseConventionalTitle splits a "type(scope)!: subject" title. ok is false when the title has no known type prefix.
+func ParseConventionalTitle(title string) (ConventionalTitle, bool) {
+	m := conventionalTitlePattern.FindStringSubmatch(title)
+	if m == nil || !conventionalTypes[strings.ToLower(m[1])] {
+		return ConventionalTitle{Subject: strings.TrimSpace(title)}, false
+	}
+	return ConventionalTitle{
+		Type:     strings.ToLower(m[1]),
+		Scope:    strings.TrimSpace(m[2]),
+		Breaking: m[3] == "!",
+		Subject:  strings.TrimSpace(m[4]),
+	}, true
+}
+
+// ReleaseNoteSection is one heading of the release notes.
+type ReleaseNoteSection struct {
+	Title string
+	Notes []ReleaseNote
+}
+
+// ReleaseNote is one merged PR of the release notes.
+type ReleaseNote struct {
+	PR       github.PullRequest
+	Scope    string
+	Subject  string
+	Breaking bool
+}
+
+// releaseNoteSections lists the headings in output order with the conventional types they collect.
+// PRs whose type is not listed end up under "Other Changes".
+var releaseNoteSections = []struct {
+	Title string
+	Types []string
+}{
+	{"Features", []string{"feat", "feature"}},
+	{"Bug Fixes", []string{"fix", "bugfix", "hotfix"}},
+	{"Performance", []string{"perf"}},
+	{"Refactoring", []string{"refactor"}},
+	{"Documentation", []string{"docs", "doc"}},
+	{"Dependencies", []string{"deps"}},
+	{"Other Changes", nil},
+}
+
+// labelTypes maps common GitHub labels to a conventional type for PRs without a type prefix.
+var labelTypes = map[string]string{
+	"feature":       "feat",
+	"enhancement":   "feat",
+	"bug":           "fix",
+	"bugfix":        "fix",
+	"performance":   "perf",
+	"refactoring":   "refactor",
+	"documentation": "docs",
+	"docs":          "docs",
+	"dependencies":  "deps",
+}
+
+// releaseNoteType returns the type of pr: a custom title rule, its conventional title prefix, else its
+// labels, else "deps" for dependency bot updates. title.Subject is updated when a rule strips a marker.
+func releaseNoteType(pr github.PullRequest, title *ConventionalTitle, ok bool, rules []PRTypeRule) string {
+	if t, subject, matched := matchPRTypeRule(pr.Title, rules); matched {
+		title.Subject = subject
+		return t
+	}
+	if ok {
+		return title.Type
+	}
+	for _, l := range pr.Labels {
+		if t, found := labelTypes[strings.ToLower(l.Name)]; found {
+			return t
+		}
+	}
+	if pr.DependencyTool() != "" {
+		return "deps"
+	}
+	return ""
+}
+
+// BuildReleaseNotes groups merged PRs by type (from custom title rules, the title prefix or labels) into
+// release note sections, oldest merge first within a section. Breaking changes (a "!" after the type,
+// or a "breaking" label) are additionally collected in a leading "Breaking Changes" section.
+// Empty sections are left out.
+func BuildReleaseNotes(prs []github.PullRequest, rules []PRTypeRule) []ReleaseNoteSection {
+	merged := make([]github.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if pr.Merged {
+			merged = append(merged, pr)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].MergedAt.Before(merged[j].MergedAt) })
+
+	sectionOf := make(map[string]int)
+	for i, s := range releaseNoteSections {
+		for _, t := range s.Types {
+			sectionOf[t] = i
+		}
+	}
+	other := len(releaseNoteSections) - 1
+
+	grouped := make([][]ReleaseNote, len(releaseNoteSections))
+	var breaking []ReleaseNote
+	for _, pr := range merged {
+		title, ok := ParseConventionalTitle(pr.Title)
+		t := releaseNoteType(pr, &title, ok, rules)
+		note := ReleaseNote{PR: pr, Scope: title.Scope, Subject: title.Subject, Breaking: title.Breaking}
+		for _, l := range pr.Labels {
+			if strings.Contains(strings.ToLower(l.Name), "breaking") {
+				note.Breaking = true
+			}
+		}
+
+		section, found := sectionOf[t]
+		if !found {
+			section = other
+		}
+		grouped[section] = append(grouped[section], note)
+		if note.Breaking {
+			breaking = append(breaking, note)
+		}
+	}
+
+	var sections []ReleaseNoteSection
+	if len(breaking) > 0 {
+		sections = append(sections, ReleaseNoteSection{Title: "Breaking Changes", Notes: breaking})
+	}
+	for i, notes := range grouped {
+		if len(notes) > 0 {
+			sections = append(sections, ReleaseNoteSection{Title: releaseNoteSections[i].Title, Notes: notes})
+		}
+	}
+	return sections
+}
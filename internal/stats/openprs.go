@@ -0,0 +1,110 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/github"
+)
+
+// Open PR age buckets.
+const (
+	openAgeDay       = 24 * time.Hour
+	openAgeThreeDays = 3 * openAgeDay
+	openAgeWeek      = 7 * openAgeDay
+)
+
+// computeOpenPRs splits the open PRs by review status, buckets them by age at in.Now and finds the oldest
+// one. Only PRs created in the analyzed period are fetched, so older open PRs are missing.
+func computeOpenPRs(in Input, s *Stats) {
+	var total time.Duration
+	for _, pr := range in.PRs {
+		if !isOpen(pr) {
+			continue
+		}
+		age := in.Now.Sub(pr.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		s.OpenPRs++
+		total += age
+		switch {
+		case pr.IsDraft:
+			s.OpenDraftPRs++
+		case isApproved(pr):
+			s.OpenApprovedPRs++
+		default:
+			s.OpenReadyPRs++
+		}
+		switch {
+		case age < openAgeDay:
+			s.OpenPRsUnder1Day++
+		case age < openAgeThreeDays:
+			s.OpenPRs1To3Days++
+		case age < openAgeWeek:
+			s.OpenPRs3To7Days++
+		default:
+			s.OpenPRsOver7Days++
+		}
+		if s.OldestOpenPR == 0 || age > s.OldestOpenPRAge {
+			s.OldestOpenPR = pr.Number
+			s.OldestOpenPRAge = age
+		}
+	}
+	if s.OpenPRs > 0 {
+		s.AverageOpenPRAge = total / time.Duration(s.OpenPRs)
+	}
+}
+
+// computeMergeBlockers classifies what keeps open, non-draft PRs from merging, from GitHub's mergeability
+// fields. A PR can have several blockers. PRs without the fields (other providers) are skipped.
+func computeMergeBlockers(in Input, s *Stats) {
+	for _, pr := range in.PRs {
+		if !isOpen(pr) || pr.IsDraft || (pr.Mergeable == "" && pr.MergeStateStatus == "") {
+			continue
+		}
+		s.MergeStatePRs++
+
+		reviews := pr.ReviewDecision == "REVIEW_REQUIRED" || pr.ReviewDecision == "CHANGES_REQUESTED"
+		blocked := false
+		if pr.Mergeable == "CONFLICTING" || pr.MergeStateStatus == "DIRTY" {
+			s.BlockedByConflicts++
+			blocked = true
+		}
+		// BLOCKED means a branch protection rule is unmet; without a review requirement left, that is
+		// almost always a required check
+		if pr.MergeStateStatus == "UNSTABLE" || (pr.MergeStateStatus == "BLOCKED" && !reviews) {
+			s.BlockedByChecks++
+			blocked = true
+		}
+		if reviews {
+			s.BlockedByReviews++
+			blocked = true
+		}
+		if pr.MergeStateStatus == "BEHIND" {
+			s.BehindBasePRs++
+			blocked = true
+		}
+
+		switch {
+		case blocked:
+		case pr.MergeStateStatus == "CLEAN" || pr.MergeStateStatus == "HAS_HOOKS":
+			s.ReadyToMergePRs++
+		default:
+			// GitHub computes mergeability in the background and reports UNKNOWN until it is done
+			s.UnknownMergeStatePRs++
+		}
+	}
+}
+
+// isApproved reports whether pr has the approval it needs: GitHub's review decision when known, otherwise
+// any approving review (providers without branch protection data).
+func isApproved(pr github.PullRequest) bool {
+	if pr.ReviewDecision != "" {
+		return pr.ReviewDecision == "APPROVED"
+	}
+	return !lastApprovalTime(pr).IsZero()
+}
+
+// isOpen reports whether pr is still open.
+func isOpen(pr github.PullRequest) bool {
+	return pr.State == "OPEN"
+}
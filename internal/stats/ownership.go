@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"visuche/internal/github"
+)
+
+// ComponentOwnership describes how concentrated the merged changes to one directory are.
+type ComponentOwnership struct {
+	Path         string
+	ChangedLines int // Additions + deletions across merged PRs
+	PRs          int
+	Contributors int
+	TopAuthor    string
+	TopShare     float64 // Percentage of changed lines from TopAuthor
+	BusFactor    int     // Fewest authors who together wrote more than half of the changed lines
+}
+
+// OwnershipReport lists per-directory knowledge concentration.
+type OwnershipReport struct {
+	Components   []ComponentOwnership // Lowest bus factor first, then most changed
+	BusFactorOne int
+	AnalyzedPRs  int // Merged PRs with file data
+}
+
+// ComponentPath returns the first depth directories of a file path ("." for top-level files).
+func ComponentPath(file string, depth int) string {
+	dir := path.Dir(file)
+	if dir == "." || depth <= 0 {
+		return "."
+	}
+	parts := strings.Split(dir, "/")
+	if len(parts) > depth {
+		parts = parts[:depth]
+	}
+	return strings.Join(parts, "/")
+}
+
+// CalculateOwnership attributes the changed lines of merged PRs to their authors per component
+// (directory truncated to depth) and computes contributor concentration and bus factor.
+func CalculateOwnership(prs []github.PullRequest, depth int) OwnershipReport {
+	var report OwnershipReport
+
+	type component struct {
+		lines map[string]int
+		prs   map[int]bool
+	}
+	components := make(map[string]*component)
+
+	for _, pr := range prs {
+		if !pr.Merged || len(pr.Files) == 0 {
+			continue
+		}
+		report.AnalyzedPRs++
+
+		for _, file := range pr.Files {
+			name := ComponentPath(file.Path, depth)
+			c, ok := components[name]
+			if !ok {
+				c = &component{lines: make(map[string]int), prs: make(map[int]bool)}
+				components[name] = c
+			}
+			c.lines[pr.Author.Login] += file.Additions + file.Deletions
+			c.prs[pr.Number] = true
+		}
+	}
+
+	for name, c := range components {
+		type share struct {
+			author string
+			lines  int
+		}
+		var shares []share
+		total := 0
+		for author, lines := range c.lines {
+			shares = append(shares, share{author, lines})
+			total += lines
+		}
+		if total == 0 {
+			continue
+		}
+		sort.Slice(shares, func(i, j int) bool {
+			if shares[i].lines != shares[j].lines {
+				return shares[i].lines > shares[j].lines
+			}
+			return shares[i].author < shares[j].author
+		})
+
+		busFactor, covered := 0, 0
+		for _, s := range shares {
+			busFactor++
+			covered += s.lines
+			if covered*2 > total {
+				break
+			}
+		}
+
+		ownership := ComponentOwnership{
+			Path:         name,
+			ChangedLines: total,
+			PRs:          len(c.prs),
+			Contributors: len(shares),
+			TopAuthor:    shares[0].author,
+			TopShare:     float64(shares[0].lines) / float64(total) * 100,
+			BusFactor:    busFactor,
+		}
+		if busFactor == 1 {
+			report.BusFactorOne++
+		}
+		report.Components = append(report.Components, ownership)
+	}
+
+	sort.Slice(report.Components, func(i, j int) bool {
+		a, b := report.Components[i], report.Components[j]
+		if a.BusFactor != b.BusFactor {
+			return a.BusFactor < b.BusFactor
+		}
+		if a.ChangedLines != b.ChangedLines {
+			return a.ChangedLines > b.ChangedLines
+		}
+		return a.Path < b.Path
+	})
+
+	return report
+}
@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// coAuthorPattern matches Co-authored-by trailers and captures the name and email.
+var coAuthorPattern = regexp.MustCompile(`(?im)^\s*co-authored-by:\s*(.+?)\s*<([^>]+)>\s*$`)
+
+// CoAuthor is a person credited through a Co-authored-by trailer.
+type CoAuthor struct {
+	Name  string
+	Email string
+}
+
+// ParseCoAuthors returns the co-authors credited in a commit message, skipping bots.
+func ParseCoAuthors(message string) []CoAuthor {
+	var coAuthors []CoAuthor
+	for _, match := range coAuthorPattern.FindAllStringSubmatch(message, -1) {
+		name, email := strings.TrimSpace(match[1]), strings.ToLower(strings.TrimSpace(match[2]))
+		if strings.Contains(name, "[bot]") || strings.Contains(email, "[bot]") {
+			continue
+		}
+		coAuthors = append(coAuthors, CoAuthor{Name: name, Email: email})
+	}
+	return coAuthors
+}
+
+// PRCoAuthors returns the distinct co-authors across all commits of a PR.
+func PRCoAuthors(pr github.PullRequest) []CoAuthor {
+	seen := make(map[string]bool)
+	var coAuthors []CoAuthor
+	for _, commit := range pr.Commits {
+		for _, coAuthor := range ParseCoAuthors(commit.MessageHeadline + "\n" + commit.MessageBody) {
+			if !seen[coAuthor.Email] {
+				seen[coAuthor.Email] = true
+				coAuthors = append(coAuthors, coAuthor)
+			}
+		}
+	}
+	return coAuthors
+}
+
+// CollaborationGroup holds review outcomes for PRs with the same authoring style.
+type CollaborationGroup struct {
+	PRs                 int
+	MedianTimeToReview  time.Duration // PR open → first review
+	MedianReviewStage   time.Duration // First review → final approval
+	MedianLeadTime      time.Duration
+	AverageReviewRounds float64 // Reviews per PR
+}
+
+// CoAuthorCount is how many PRs a co-author was credited on.
+type CoAuthorCount struct {
+	Name string
+	PRs  int
+}
+
+// PairingReport summarizes pairing/mobbing detected from Co-authored-by trailers.
+type PairingReport struct {
+	AnalyzedPRs    int // PRs with commit data
+	PairedPRs      int // Exactly one co-author (two people)
+	MobbedPRs      int // Two or more co-authors (three or more people)
+	CoAuthoredRate float64
+	Solo           CollaborationGroup
+	CoAuthored     CollaborationGroup
+	TopCoAuthors   []CoAuthorCount
+}
+
+// CalculatePairingReport detects co-authored PRs and compares how fast they are reviewed against solo PRs.
+// PRs without commit data are skipped.
+func CalculatePairingReport(prs []github.PullRequest) PairingReport {
+	var report PairingReport
+	var solo, coAuthored []github.PullRequest
+	counts := make(map[string]*CoAuthorCount)
+
+	for _, pr := range prs {
+		if len(pr.Commits) == 0 {
+			continue
+		}
+		report.AnalyzedPRs++
+
+		coAuthors := PRCoAuthors(pr)
+		switch {
+		case len(coAuthors) == 0:
+			solo = append(solo, pr)
+			continue
+		case len(coAuthors) == 1:
+			report.PairedPRs++
+		default:
+			report.MobbedPRs++
+		}
+		coAuthored = append(coAuthored, pr)
+
+		for _, coAuthor := range coAuthors {
+			c, ok := counts[coAuthor.Email]
+			if !ok {
+				c = &CoAuthorCount{Name: coAuthor.Name}
+				counts[coAuthor.Email] = c
+			}
+			c.PRs++
+		}
+	}
+
+	if report.AnalyzedPRs > 0 {
+		report.CoAuthoredRate = float64(len(coAuthored)) / float64(report.AnalyzedPRs) * 100
+	}
+	report.Solo = collaborationGroup(solo)
+	report.CoAuthored = collaborationGroup(coAuthored)
+
+	for _, c := range counts {
+		report.TopCoAuthors = append(report.TopCoAuthors, *c)
+	}
+	sort.Slice(report.TopCoAuthors, func(i, j int) bool {
+		if report.TopCoAuthors[i].PRs != report.TopCoAuthors[j].PRs {
+			return report.TopCoAuthors[i].PRs > report.TopCoAuthors[j].PRs
+		}
+		return report.TopCoAuthors[i].Name < report.TopCoAuthors[j].Name
+	})
+	if len(report.TopCoAuthors) > 5 {
+		report.TopCoAuthors = report.TopCoAuthors[:5]
+	}
+
+	return report
+}
+
+// collaborationGroup aggregates review timing for a set of PRs.
+func collaborationGroup(prs []github.PullRequest) CollaborationGroup {
+	group := CollaborationGroup{PRs: len(prs)}
+	if len(prs) == 0 {
+		return group
+	}
+
+	var pickup, review, lead []time.Duration
+	reviews := 0
+	for _, pr := range prs {
+		pickup = append(pickup, pr.PickupTime)
+		review = append(review, pr.ReviewTime)
+		if pr.Merged {
+			lead = append(lead, pr.LeadTime)
+		}
+		reviews += len(pr.Reviews)
+	}
+	_, group.MedianTimeToReview = averageAndMedian(pickup)
+	_, group.MedianReviewStage = averageAndMedian(review)
+	_, group.MedianLeadTime = averageAndMedian(lead)
+	group.AverageReviewRounds = float64(reviews) / float64(len(prs))
+	return group
+}
@@ -0,0 +1,125 @@
+package stats
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// UnclassifiedPRType is the type of PRs whose title matches neither a custom rule nor a conventional prefix.
+const UnclassifiedPRType = "other"
+
+// PRTypeRule assigns Type to PRs whose title matches Pattern. Rules take precedence over conventional prefixes.
+type PRTypeRule struct {
+	Pattern *regexp.Regexp
+	Type    string
+}
+
+// ClassifyPRTitle returns the type of a PR title and the title with its type marker removed:
+// the first matching rule wins, then a conventional "type(scope)!:" prefix, else UnclassifiedPRType.
+func ClassifyPRTitle(title string, rules []PRTypeRule) (string, string) {
+	if t, subject, ok := matchPRTypeRule(title, rules); ok {
+		return t, subject
+	}
+	if parsed, ok := ParseConventionalTitle(title); ok {
+		return parsed.Type, parsed.Subject
+	}
+	return UnclassifiedPRType, strings.TrimSpace(title)
+}
+
+// matchPRTypeRule applies the first rule matching title. A match at the start of the title is
+// stripped from the returned subject.
+func matchPRTypeRule(title string, rules []PRTypeRule) (string, string, bool) {
+	for _, rule := range rules {
+		loc := rule.Pattern.FindStringIndex(title)
+		if loc == nil {
+			continue
+		}
+		if loc[0] == 0 {
+			title = strings.TrimLeft(title[loc[1]:], " :-")
+		}
+		return rule.Type, strings.TrimSpace(title), true
+	}
+	return "", "", false
+}
+
+// PRTypeStats summarizes the PRs of one title type.
+type PRTypeStats struct {
+	Type              string
+	PRs               int
+	Merged            int
+	Share             float64 // Percentage of all PRs
+	AverageLeadTime   time.Duration
+	MedianLeadTime    time.Duration
+	LeadTimeVsOverall float64 // Median lead time relative to the median of all merged PRs (1.0 = same); 0 without merged PRs
+	AverageSize       float64 // Additions + deletions
+}
+
+// PRTypeReport is the distribution of PR title types.
+type PRTypeReport struct {
+	Types          []PRTypeStats // Most PRs first
+	Classified     int           // PRs with a type other than UnclassifiedPRType
+	Total          int
+	MedianLeadTime time.Duration // Over all merged PRs
+}
+
+// CalculatePRTypeReport classifies PRs by title (see ClassifyPRTitle) and compares the lead time of
+// merged PRs per type with the overall median.
+func CalculatePRTypeReport(prs []github.PullRequest, rules []PRTypeRule) PRTypeReport {
+	report := PRTypeReport{Total: len(prs)}
+	type accumulator struct {
+		prs, merged int
+		size        int
+		leadTimes   []time.Duration
+	}
+	byType := make(map[string]*accumulator)
+	var allLeadTimes []time.Duration
+
+	for _, pr := range prs {
+		t, _ := ClassifyPRTitle(pr.Title, rules)
+		acc, ok := byType[t]
+		if !ok {
+			acc = &accumulator{}
+			byType[t] = acc
+		}
+		acc.prs++
+		acc.size += pr.Additions + pr.Deletions
+		if t != UnclassifiedPRType {
+			report.Classified++
+		}
+		if pr.Merged && pr.LeadTime > 0 {
+			acc.merged++
+			acc.leadTimes = append(acc.leadTimes, pr.LeadTime)
+			allLeadTimes = append(allLeadTimes, pr.LeadTime)
+		}
+	}
+	_, report.MedianLeadTime = averageAndMedian(allLeadTimes)
+
+	for t, acc := range byType {
+		s := PRTypeStats{
+			Type:        t,
+			PRs:         acc.prs,
+			Merged:      acc.merged,
+			Share:       float64(acc.prs) / float64(report.Total) * 100,
+			AverageSize: float64(acc.size) / float64(acc.prs),
+		}
+		s.AverageLeadTime, s.MedianLeadTime = averageAndMedian(acc.leadTimes)
+		if acc.merged > 0 && report.MedianLeadTime > 0 {
+			s.LeadTimeVsOverall = float64(s.MedianLeadTime) / float64(report.MedianLeadTime)
+		}
+		report.Types = append(report.Types, s)
+	}
+	sort.Slice(report.Types, func(i, j int) bool {
+		a, b := report.Types[i], report.Types[j]
+		if (a.Type == UnclassifiedPRType) != (b.Type == UnclassifiedPRType) {
+			return b.Type == UnclassifiedPRType
+		}
+		if a.PRs != b.PRs {
+			return a.PRs > b.PRs
+		}
+		return a.Type < b.Type
+	})
+	return report
+}
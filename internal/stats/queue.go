@@ -0,0 +1,95 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// UnrequestedReviewer is the queue name for open PRs without pending review requests.
+const UnrequestedReviewer = "(no reviewer requested)"
+
+// QueueItem is an open PR waiting on a reviewer.
+type QueueItem struct {
+	PR           github.PullRequest
+	Age          time.Duration // Since the PR was opened
+	Waiting      time.Duration // Since the last review, or since opening when there is none
+	ChangedLines int
+	Size         string
+}
+
+// ReviewQueue is the list of PRs waiting on one reviewer, most stale first.
+type ReviewQueue struct {
+	Reviewer string
+	Items    []QueueItem
+}
+
+// SizeLabel classifies a PR by changed lines (additions + deletions).
+func SizeLabel(changedLines int) string {
+	switch {
+	case changedLines < 10:
+		return "XS"
+	case changedLines < 100:
+		return "S"
+	case changedLines < 500:
+		return "M"
+	case changedLines < 1000:
+		return "L"
+	default:
+		return "XL"
+	}
+}
+
+// newQueueItem measures how long an open PR has been waiting.
+func newQueueItem(pr github.PullRequest, now time.Time) QueueItem {
+	lastActivity := pr.ReviewableAt()
+	for _, review := range pr.Reviews {
+		if review.SubmittedAt.After(lastActivity) {
+			lastActivity = review.SubmittedAt
+		}
+	}
+	lines := pr.Additions + pr.Deletions
+	return QueueItem{
+		PR:           pr,
+		Age:          now.Sub(pr.CreatedAt),
+		Waiting:      now.Sub(lastActivity),
+		ChangedLines: lines,
+		Size:         SizeLabel(lines),
+	}
+}
+
+// BuildReviewQueues groups open PRs by requested reviewer. Drafts are skipped unless includeDrafts is set.
+// Queues are ordered by their most stale PR.
+func BuildReviewQueues(prs []github.PullRequest, now time.Time, includeDrafts bool) []ReviewQueue {
+	byReviewer := make(map[string][]QueueItem)
+	for _, pr := range prs {
+		if pr.State != "OPEN" || (pr.IsDraft && !includeDrafts) {
+			continue
+		}
+
+		item := newQueueItem(pr, now)
+		if len(pr.ReviewRequests) == 0 {
+			byReviewer[UnrequestedReviewer] = append(byReviewer[UnrequestedReviewer], item)
+			continue
+		}
+		for _, request := range pr.ReviewRequests {
+			byReviewer[request.Reviewer()] = append(byReviewer[request.Reviewer()], item)
+		}
+	}
+
+	queues := make([]ReviewQueue, 0, len(byReviewer))
+	for reviewer, items := range byReviewer {
+		sort.Slice(items, func(i, j int) bool { return items[i].Waiting > items[j].Waiting })
+		queues = append(queues, ReviewQueue{Reviewer: reviewer, Items: items})
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		if (queues[i].Reviewer == UnrequestedReviewer) != (queues[j].Reviewer == UnrequestedReviewer) {
+			return queues[j].Reviewer == UnrequestedReviewer
+		}
+		if queues[i].Items[0].Waiting != queues[j].Items[0].Waiting {
+			return queues[i].Items[0].Waiting > queues[j].Items[0].Waiting
+		}
+		return queues[i].Reviewer < queues[j].Reviewer
+	})
+	return queues
+}
@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// ReworkedFile is a file that was changed again shortly after being merged.
+type ReworkedFile struct {
+	Path    string
+	Reworks int // Times a later PR touched the file within the window
+}
+
+// ReworkReport approximates churn: how much of what was merged gets modified again within the window.
+// Overlap is measured per file, so any later change to a file counts all of the earlier PR's lines in it.
+type ReworkReport struct {
+	Window        time.Duration
+	AnalyzedPRs   int     // Merged PRs with file data
+	ChangedLines  int     // Additions + deletions across analyzed PRs
+	ReworkedLines int     // Lines in files touched again by a later PR within the window
+	ReworkRate    float64 // ReworkedLines / ChangedLines, as a percentage
+	ReworkedPRs   int     // PRs with at least one reworked file
+	PRReworkRate  float64
+	TopFiles      []ReworkedFile
+}
+
+// CalculateRework checks, for every merged PR, whether later PRs merged within window modified the same files.
+func CalculateRework(prs []github.PullRequest, window time.Duration) ReworkReport {
+	report := ReworkReport{Window: window}
+
+	var merged []github.PullRequest
+	for _, pr := range prs {
+		if pr.Merged && !pr.MergedAt.IsZero() && len(pr.Files) > 0 {
+			merged = append(merged, pr)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].MergedAt.Before(merged[j].MergedAt) })
+	report.AnalyzedPRs = len(merged)
+
+	fileReworks := make(map[string]int)
+	for i, pr := range merged {
+		// Files touched by PRs merged after this one, within the window
+		touchedLater := make(map[string]bool)
+		for _, later := range merged[i+1:] {
+			if later.MergedAt.Sub(pr.MergedAt) > window {
+				break
+			}
+			for _, file := range later.Files {
+				touchedLater[file.Path] = true
+			}
+		}
+
+		reworked := false
+		for _, file := range pr.Files {
+			lines := file.Additions + file.Deletions
+			report.ChangedLines += lines
+			if touchedLater[file.Path] {
+				report.ReworkedLines += lines
+				fileReworks[file.Path]++
+				reworked = true
+			}
+		}
+		if reworked {
+			report.ReworkedPRs++
+		}
+	}
+
+	if report.ChangedLines > 0 {
+		report.ReworkRate = float64(report.ReworkedLines) / float64(report.ChangedLines) * 100
+	}
+	if report.AnalyzedPRs > 0 {
+		report.PRReworkRate = float64(report.ReworkedPRs) / float64(report.AnalyzedPRs) * 100
+	}
+
+	for path, count := range fileReworks {
+		report.TopFiles = append(report.TopFiles, ReworkedFile{Path: path, Reworks: count})
+	}
+	sort.Slice(report.TopFiles, func(i, j int) bool {
+		if report.TopFiles[i].Reworks != report.TopFiles[j].Reworks {
+			return report.TopFiles[i].Reworks > report.TopFiles[j].Reworks
+		}
+		return report.TopFiles[i].Path < report.TopFiles[j].Path
+	})
+	if len(report.TopFiles) > 10 {
+		report.TopFiles = report.TopFiles[:10]
+	}
+
+	return report
+}
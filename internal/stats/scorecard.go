@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+)
+
+// ScorecardRow is the compact health summary of one repository.
+type ScorecardRow struct {
+	Repo           string
+	MergedPRs      int
+	MedianLeadTime time.Duration
+	MergeRate      float64 // Percentage of the period's PRs that were merged, open ones included (like the PR report)
+	CIRuns         int
+	CISuccessRate  float64 // Percentage of completed runs that succeeded
+	OpenPRs        int
+	MedianOpenAge  time.Duration // Median age of currently open PRs
+	Err            string        // Why the repository could not be analyzed, if it could not
+}
+
+// ScorecardColumns are the columns a scorecard can be sorted by, in display order.
+var ScorecardColumns = []string{"repo", "merged", "lead-time", "merge-rate", "ci-success", "open", "open-age"}
+
+// BuildScorecardRow summarizes the PRs created in the period (open ones included), the currently open PRs and the CI runs of a repository.
+func BuildScorecardRow(repo string, prs, openPRs []github.PullRequest, runs []actions.WorkflowRun, now time.Time) ScorecardRow {
+	row := ScorecardRow{Repo: repo, OpenPRs: len(openPRs)}
+
+	var leadTimes, openAges []time.Duration
+	for _, pr := range prs {
+		if pr.Merged {
+			row.MergedPRs++
+			leadTimes = append(leadTimes, positiveDuration(pr.CreatedAt, pr.MergedAt))
+		}
+	}
+	if len(prs) > 0 {
+		row.MergeRate = float64(row.MergedPRs) / float64(len(prs)) * 100
+	}
+	_, row.MedianLeadTime = averageAndMedian(leadTimes)
+
+	for _, pr := range openPRs {
+		openAges = append(openAges, positiveDuration(pr.CreatedAt, now))
+	}
+	_, row.MedianOpenAge = averageAndMedian(openAges)
+
+	successes, completed := 0, 0
+	for _, run := range runs {
+		if run.Status != "completed" || run.Conclusion == "skipped" || run.Conclusion == "cancelled" {
+			continue
+		}
+		completed++
+		if run.Conclusion == "success" {
+			successes++
+		}
+	}
+	row.CIRuns = completed
+	if completed > 0 {
+		row.CISuccessRate = float64(successes) / float64(completed) * 100
+	}
+	return row
+}
+
+// SortScorecard orders rows by column (one of ScorecardColumns), best first: shortest lead time and
+// open PR age, highest merge and CI success rates, most merged PRs, fewest open PRs. Rows that could not
+// be analyzed go last.
+func SortScorecard(rows []ScorecardRow, column string) error {
+	var less func(a, b ScorecardRow) bool
+	switch strings.ToLower(column) {
+	case "repo", "":
+		less = func(a, b ScorecardRow) bool { return a.Repo < b.Repo }
+	case "merged":
+		less = func(a, b ScorecardRow) bool { return a.MergedPRs > b.MergedPRs }
+	case "lead-time":
+		less = func(a, b ScorecardRow) bool { return lessNonZero(a.MedianLeadTime, b.MedianLeadTime) }
+	case "merge-rate":
+		less = func(a, b ScorecardRow) bool { return a.MergeRate > b.MergeRate }
+	case "ci-success":
+		less = func(a, b ScorecardRow) bool { return a.CISuccessRate > b.CISuccessRate }
+	case "open":
+		less = func(a, b ScorecardRow) bool { return a.OpenPRs < b.OpenPRs }
+	case "open-age":
+		less = func(a, b ScorecardRow) bool { return lessNonZero(a.MedianOpenAge, b.MedianOpenAge) }
+	default:
+		return fmt.Errorf("unknown scorecard column %q (use %s)", column, strings.Join(ScorecardColumns, ", "))
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if (rows[i].Err == "") != (rows[j].Err == "") {
+			return rows[i].Err == ""
+		}
+		return less(rows[i], rows[j])
+	})
+	return nil
+}
+
+// lessNonZero orders durations ascending with zero (no data) last.
+func lessNonZero(a, b time.Duration) bool {
+	if a == 0 || b == 0 {
+		return a != 0 && b == 0
+	}
+	return a < b
+}
@@ -0,0 +1,88 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// SecurityPR is a security-related PR with its time to merge (or age while open).
+type SecurityPR struct {
+	PR       github.PullRequest
+	Source   string        // "dependabot", "renovate" or "human"
+	Elapsed  time.Duration // Open → merge, or open → now for open PRs
+	Breached bool          // Merged after the target, or still open past it
+}
+
+// SecurityStats reports security PRs separately from ordinary work, against a time-to-merge target.
+type SecurityStats struct {
+	Target             time.Duration
+	Opened             int
+	Merged             int
+	Closed             int // Closed without merging
+	Open               int
+	MergedWithinTarget int
+	Breached           int // Merged late or open past the target
+	AverageTimeToMerge time.Duration
+	MedianTimeToMerge  time.Duration
+	PRs                []SecurityPR // Breaches first, then longest elapsed
+}
+
+// AttainmentRate returns the percentage of merged security PRs merged within the target.
+func (s SecurityStats) AttainmentRate() float64 {
+	if s.Merged == 0 {
+		return 0
+	}
+	return float64(s.MergedWithinTarget) / float64(s.Merged) * 100
+}
+
+// CalculateSecurityStats picks the security-related PRs (see PullRequest.IsSecurityRelated) from human
+// and dependency PRs alike and measures their time to merge against target.
+func CalculateSecurityStats(prs []github.PullRequest, target time.Duration, now time.Time) SecurityStats {
+	result := SecurityStats{Target: target}
+	var mergeTimes []time.Duration
+
+	for _, pr := range prs {
+		if !pr.IsSecurityRelated() {
+			continue
+		}
+		source := pr.DependencyTool()
+		if source == "" {
+			source = "human"
+		}
+		entry := SecurityPR{PR: pr, Source: source}
+		result.Opened++
+
+		switch {
+		case pr.Merged:
+			result.Merged++
+			entry.Elapsed = positiveDuration(pr.CreatedAt, pr.MergedAt)
+			mergeTimes = append(mergeTimes, entry.Elapsed)
+			if entry.Elapsed <= target {
+				result.MergedWithinTarget++
+			} else {
+				entry.Breached = true
+			}
+		case pr.State == "CLOSED":
+			result.Closed++
+			continue
+		default:
+			result.Open++
+			entry.Elapsed = positiveDuration(pr.CreatedAt, now)
+			entry.Breached = entry.Elapsed > target
+		}
+		if entry.Breached {
+			result.Breached++
+		}
+		result.PRs = append(result.PRs, entry)
+	}
+
+	result.AverageTimeToMerge, result.MedianTimeToMerge = averageAndMedian(mergeTimes)
+	sort.SliceStable(result.PRs, func(i, j int) bool {
+		if result.PRs[i].Breached != result.PRs[j].Breached {
+			return result.PRs[i].Breached
+		}
+		return result.PRs[i].Elapsed > result.PRs[j].Elapsed
+	})
+	return result
+}
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+	"visuche/internal/github"
+)
+
+// SizeBucket aggregates review depth and defect proxies for merged PRs of one size class.
+type SizeBucket struct {
+	Size                  string // SizeLabel, or "≤N"/">N" for the threshold split
+	PRs                   int
+	AverageReviewComments float64
+	AverageReviewRounds   float64
+	AverageTimeToApproval time.Duration
+	Defects               int     // PRs whose files a later revert/hotfix touched
+	DefectRate            float64 // Defects / PRs, in percent
+}
+
+// SizeCorrelationReport relates PR size (changed lines) to review depth and defects.
+type SizeCorrelationReport struct {
+	Buckets   []SizeBucket // XS → XL, empty buckets omitted
+	Threshold []SizeBucket // At or under / over the size guideline, empty buckets omitted
+	// Pearson correlation of changed lines with each measure, across merged PRs
+	CommentsCorrelation float64
+	RoundsCorrelation   float64
+	DefectCorrelation   float64
+	HasFileData         bool // Defect proxies need changed-file data
+}
+
+// reviewRounds counts review iterations: one per change request plus the final round, 0 when never reviewed.
+func reviewRounds(pr github.PullRequest) int {
+	if len(pr.Reviews) == 0 {
+		return 0
+	}
+	rounds := 1
+	for _, review := range pr.Reviews {
+		if review.State == "CHANGES_REQUESTED" {
+			rounds++
+		}
+	}
+	return rounds
+}
+
+// hasLaterFix reports whether a revert or hotfix merged after pr touched one of its files.
+func hasLaterFix(pr github.PullRequest, fixes []github.PullRequest) bool {
+	files := make(map[string]bool, len(pr.Files))
+	for _, f := range pr.Files {
+		files[f.Path] = true
+	}
+	for _, fix := range fixes {
+		if fix.Number == pr.Number || !fix.MergedAt.After(pr.MergedAt) {
+			continue
+		}
+		for _, f := range fix.Files {
+			if files[f.Path] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sizeAccumulator collects per-bucket sums while scanning PRs.
+type sizeAccumulator struct {
+	prs, comments, rounds, defects int
+	approvals                      []time.Duration
+}
+
+func (a *sizeAccumulator) bucket(size string) SizeBucket {
+	b := SizeBucket{
+		Size:                  size,
+		PRs:                   a.prs,
+		AverageReviewComments: float64(a.comments) / float64(a.prs),
+		AverageReviewRounds:   float64(a.rounds) / float64(a.prs),
+		Defects:               a.defects,
+		DefectRate:            float64(a.defects) / float64(a.prs) * 100,
+	}
+	b.AverageTimeToApproval, _ = averageAndMedian(a.approvals)
+	return b
+}
+
+// CalculateSizeCorrelation buckets merged PRs by size and measures review comments, review rounds,
+// time to approval and a defect proxy (a later revert/hotfix touching the same files) per bucket.
+// PRs are also split at threshold changed lines to check a size guideline.
+func CalculateSizeCorrelation(prs []github.PullRequest, threshold int) SizeCorrelationReport {
+	var report SizeCorrelationReport
+	var fixes []github.PullRequest
+	for _, pr := range prs {
+		if pr.Merged && (isRevertLike(pr) || isHotfix(pr)) {
+			fixes = append(fixes, pr)
+		}
+		if len(pr.Files) > 0 {
+			report.HasFileData = true
+		}
+	}
+
+	buckets := make(map[string]*sizeAccumulator)
+	under, over := fmt.Sprintf("≤%d", threshold), fmt.Sprintf(">%d", threshold)
+	var sizes, comments, rounds, defects []float64
+
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+		lines := pr.Additions + pr.Deletions
+		split := under
+		if lines > threshold {
+			split = over
+		}
+
+		r := reviewRounds(pr)
+		defect := 0
+		if report.HasFileData && hasLaterFix(pr, fixes) {
+			defect = 1
+		}
+		approval := positiveDuration(pr.ReviewableAt(), lastApprovalTime(pr))
+		for _, key := range []string{SizeLabel(lines), split} {
+			b, ok := buckets[key]
+			if !ok {
+				b = &sizeAccumulator{}
+				buckets[key] = b
+			}
+			b.prs++
+			b.comments += pr.ReviewCommentCount
+			b.rounds += r
+			b.defects += defect
+			if approval > 0 {
+				b.approvals = append(b.approvals, approval)
+			}
+		}
+
+		sizes = append(sizes, float64(lines))
+		comments = append(comments, float64(pr.ReviewCommentCount))
+		rounds = append(rounds, float64(r))
+		defects = append(defects, float64(defect))
+	}
+
+	for _, label := range []string{"XS", "S", "M", "L", "XL"} {
+		if b, ok := buckets[label]; ok {
+			report.Buckets = append(report.Buckets, b.bucket(label))
+		}
+	}
+	for _, key := range []string{under, over} {
+		if b, ok := buckets[key]; ok {
+			report.Threshold = append(report.Threshold, b.bucket(key))
+		}
+	}
+
+	report.CommentsCorrelation = pearson(sizes, comments)
+	report.RoundsCorrelation = pearson(sizes, rounds)
+	if report.HasFileData {
+		report.DefectCorrelation = pearson(sizes, defects)
+	}
+	return report
+}
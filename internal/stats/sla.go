@@ -0,0 +1,191 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// SLATarget describes a first-review response target.
+type SLATarget struct {
+	FirstReview        time.Duration
+	MaintainerResponse time.Duration // First maintainer response on PRs from forks; see CalculateMaintainerResponse
+	BusinessHours      bool
+	WorkdayStart       int // Hour of day, local time
+	WorkdayEnd         int // Hour of day, local time
+}
+
+// SLABucket is the attainment for one week or one reviewer.
+type SLABucket struct {
+	Key        string
+	Evaluated  int
+	Met        int
+	Attainment float64
+}
+
+// SLAViolation is a PR whose first review missed the target.
+type SLAViolation struct {
+	Number    int
+	Title     string
+	URL       string
+	Author    string
+	Reviewer  string // Empty when the PR is still waiting for a first review
+	CreatedAt time.Time
+	Wait      time.Duration
+}
+
+// SLAReport summarizes first-review SLA attainment.
+type SLAReport struct {
+	Target     SLATarget
+	Evaluated  int
+	Met        int
+	Attainment float64
+	Weekly     []SLABucket
+	Reviewers  []SLABucket
+	Violations []SLAViolation
+}
+
+// CalculateSLA evaluates the first-review SLA for non-draft PRs.
+// PRs still waiting for a review count as violations once they exceed the target;
+// closed PRs that never received a review are not evaluated.
+func CalculateSLA(prs []github.PullRequest, target SLATarget, now time.Time) SLAReport {
+	report := SLAReport{Target: target}
+	weekly := make(map[string]*SLABucket)
+	reviewers := make(map[string]*SLABucket)
+
+	for _, pr := range prs {
+		if pr.IsDraft {
+			continue
+		}
+
+		// First review by someone other than the author
+		var firstReview time.Time
+		var reviewer string
+		for _, r := range pr.Reviews {
+			if r.Author.Login == pr.Author.Login {
+				continue
+			}
+			if firstReview.IsZero() || r.SubmittedAt.Before(firstReview) {
+				firstReview = r.SubmittedAt
+				reviewer = r.Author.Login
+			}
+		}
+
+		var wait time.Duration
+		if !firstReview.IsZero() {
+			wait = target.elapsed(pr.ReviewableAt(), firstReview)
+		} else if pr.State == "OPEN" {
+			wait = target.elapsed(pr.ReviewableAt(), now)
+			if wait <= target.FirstReview {
+				continue // Still within target, nothing to judge yet
+			}
+		} else {
+			continue
+		}
+
+		met := wait <= target.FirstReview
+		report.Evaluated++
+		if met {
+			report.Met++
+		}
+
+		year, week := pr.CreatedAt.ISOWeek()
+		addSLAResult(weekly, isoWeekKey(year, week), met)
+		if reviewer != "" {
+			addSLAResult(reviewers, reviewer, met)
+		}
+
+		if !met {
+			report.Violations = append(report.Violations, SLAViolation{
+				Number:    pr.Number,
+				Title:     pr.Title,
+				URL:       pr.URL,
+				Author:    pr.Author.Login,
+				Reviewer:  reviewer,
+				CreatedAt: pr.CreatedAt,
+				Wait:      wait,
+			})
+		}
+	}
+
+	if report.Evaluated > 0 {
+		report.Attainment = float64(report.Met) / float64(report.Evaluated) * 100.0
+	}
+	report.Weekly = sortedSLABuckets(weekly)
+	report.Reviewers = sortedSLABuckets(reviewers)
+	sort.Slice(report.Violations, func(i, j int) bool {
+		return report.Violations[i].Wait > report.Violations[j].Wait
+	})
+
+	return report
+}
+
+// elapsed returns the wait between start and end, counting only working hours when configured.
+func (t SLATarget) elapsed(start, end time.Time) time.Duration {
+	if !end.After(start) {
+		return 0
+	}
+	if !t.BusinessHours {
+		return end.Sub(start)
+	}
+	return BusinessDuration(start, end, t.WorkdayStart, t.WorkdayEnd)
+}
+
+// BusinessDuration counts the time between start and end that falls on weekdays
+// between workdayStart and workdayEnd (local time).
+func BusinessDuration(start, end time.Time, workdayStart, workdayEnd int) time.Duration {
+	start, end = start.Local(), end.Local()
+	if !end.After(start) || workdayEnd <= workdayStart {
+		return 0
+	}
+
+	var total time.Duration
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	for !day.After(end) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			open := day.Add(time.Duration(workdayStart) * time.Hour)
+			closeAt := day.Add(time.Duration(workdayEnd) * time.Hour)
+			if open.Before(start) {
+				open = start
+			}
+			if closeAt.After(end) {
+				closeAt = end
+			}
+			if closeAt.After(open) {
+				total += closeAt.Sub(open)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return total
+}
+
+// addSLAResult records a met/missed result in the bucket for key.
+func addSLAResult(buckets map[string]*SLABucket, key string, met bool) {
+	b, ok := buckets[key]
+	if !ok {
+		b = &SLABucket{Key: key}
+		buckets[key] = b
+	}
+	b.Evaluated++
+	if met {
+		b.Met++
+	}
+}
+
+// sortedSLABuckets computes attainment and returns the buckets sorted by key.
+func sortedSLABuckets(buckets map[string]*SLABucket) []SLABucket {
+	result := make([]SLABucket, 0, len(buckets))
+	for _, b := range buckets {
+		b.Attainment = float64(b.Met) / float64(b.Evaluated) * 100.0
+		result = append(result, *b)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// isoWeekKey formats an ISO week as YYYY-Www.
+func isoWeekKey(year, week int) string {
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
@@ -0,0 +1,178 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+// PRStack is a chain (or tree) of PRs where each PR's base branch is the head branch of its parent.
+type PRStack struct {
+	PRs    []github.PullRequest // Root first, then each PR before the PRs stacked on it
+	Depth  int                  // PRs in the longest root-to-tip chain
+	Merged int
+	Open   int
+}
+
+// StackReport compares stacked PRs with PRs opened directly against a long-lived branch.
+type StackReport struct {
+	Stacks     []PRStack // Largest first, most recent root first on ties
+	StackSizes map[int]int
+	Stacked    StackGroupStats
+	Standalone StackGroupStats
+}
+
+// StackGroupStats holds the merge times of the stacked or standalone PRs.
+type StackGroupStats struct {
+	PRs             int
+	Merged          int
+	MedianLeadTime  time.Duration // Creation → merge
+	MedianMergeTime time.Duration // Final approval → merge
+}
+
+// CalculateStackReport detects PR stacks: a PR whose base branch is another PR's head branch is stacked
+// on it, provided the parent was still open when the child was created (branch names get reused).
+// GitHub retargets a child to the parent's base when the parent's branch is deleted on merge, so
+// stacks merged that way are only detected while they were still stacked at fetch time.
+// Long-lived branches are never parents, so feature PRs into develop are not stacked on an open
+// develop → main release PR; see longLivedBranches.
+func CalculateStackReport(prs []github.PullRequest, defaultBranch string) StackReport {
+	longLived := longLivedBranches(prs, defaultBranch)
+	byHead := make(map[string][]int)
+	for i, pr := range prs {
+		// A fork's branches aren't in the repository, so nothing can be stacked on them
+		if pr.HeadRefName != "" && !pr.IsCrossRepository && !longLived[strings.ToLower(pr.HeadRefName)] {
+			byHead[pr.HeadRefName] = append(byHead[pr.HeadRefName], i)
+		}
+	}
+
+	parent := make([]int, len(prs))
+	children := make(map[int][]int)
+	for i, pr := range prs {
+		parent[i] = -1
+		if pr.BaseRefName == "" || longLived[strings.ToLower(pr.BaseRefName)] {
+			continue
+		}
+		// The most recent PR from the base branch that was open when this one was created
+		for _, j := range byHead[pr.BaseRefName] {
+			candidate := prs[j]
+			if !candidate.CreatedAt.Before(pr.CreatedAt) || closedBefore(candidate, pr.CreatedAt) {
+				continue
+			}
+			if parent[i] < 0 || candidate.CreatedAt.After(prs[parent[i]].CreatedAt) {
+				parent[i] = j
+			}
+		}
+		if parent[i] >= 0 {
+			children[parent[i]] = append(children[parent[i]], i)
+		}
+	}
+
+	report := StackReport{StackSizes: make(map[int]int)}
+	stacked := make([]bool, len(prs))
+	for i := range prs {
+		if parent[i] >= 0 || len(children[i]) == 0 {
+			continue
+		}
+		var stack PRStack
+		var walk func(i, depth int)
+		walk = func(i, depth int) {
+			pr := prs[i]
+			stacked[i] = true
+			stack.PRs = append(stack.PRs, pr)
+			if depth > stack.Depth {
+				stack.Depth = depth
+			}
+			if pr.Merged {
+				stack.Merged++
+			} else if pr.State == "OPEN" {
+				stack.Open++
+			}
+			for _, c := range children[i] {
+				walk(c, depth+1)
+			}
+		}
+		walk(i, 1)
+		report.Stacks = append(report.Stacks, stack)
+		report.StackSizes[len(stack.PRs)]++
+	}
+	sort.SliceStable(report.Stacks, func(i, j int) bool {
+		if len(report.Stacks[i].PRs) != len(report.Stacks[j].PRs) {
+			return len(report.Stacks[i].PRs) > len(report.Stacks[j].PRs)
+		}
+		return report.Stacks[i].PRs[0].CreatedAt.After(report.Stacks[j].PRs[0].CreatedAt)
+	})
+
+	var stackedPRs, standalonePRs []github.PullRequest
+	for i, pr := range prs {
+		if stacked[i] {
+			stackedPRs = append(stackedPRs, pr)
+		} else {
+			standalonePRs = append(standalonePRs, pr)
+		}
+	}
+	report.Stacked = stackGroupStats(stackedPRs)
+	report.Standalone = stackGroupStats(standalonePRs)
+	return report
+}
+
+// longLivedBranches returns the lowercased branches PRs are never stacked on: the default, release and
+// configured long-lived branches, plus branches that look like integration branches from the PRs. A
+// branch opened against the default branch is one when it was the head of several such PRs (repeated
+// release PRs) or PRs from more than one author target it; a stack parent is opened once and built on
+// by its own author.
+func longLivedBranches(prs []github.PullRequest, defaultBranch string) map[string]bool {
+	longLived := make(map[string]bool)
+	intoDefault := make(map[string]int)
+	authors := make(map[string]map[string]bool)
+	for _, pr := range prs {
+		for _, branch := range []string{pr.BaseRefName, pr.HeadRefName} {
+			if branch != "" && isLongLivedBranch(branch, defaultBranch) {
+				longLived[strings.ToLower(branch)] = true
+			}
+		}
+		if pr.HeadRefName != "" && !pr.IsCrossRepository && IsDefaultBranch(pr.BaseRefName, defaultBranch) {
+			intoDefault[strings.ToLower(pr.HeadRefName)]++
+		}
+		if pr.BaseRefName != "" {
+			base := strings.ToLower(pr.BaseRefName)
+			if authors[base] == nil {
+				authors[base] = make(map[string]bool)
+			}
+			authors[base][strings.ToLower(pr.Author.Login)] = true
+		}
+	}
+	for branch, count := range intoDefault {
+		if count > 1 || len(authors[branch]) > 1 {
+			longLived[branch] = true
+		}
+	}
+	return longLived
+}
+
+// closedBefore reports whether pr was merged or closed before t.
+func closedBefore(pr github.PullRequest, t time.Time) bool {
+	if !pr.MergedAt.IsZero() && pr.MergedAt.Before(t) {
+		return true
+	}
+	return !pr.ClosedAt.IsZero() && pr.ClosedAt.Before(t)
+}
+
+func stackGroupStats(prs []github.PullRequest) StackGroupStats {
+	s := StackGroupStats{PRs: len(prs)}
+	var leadTimes, mergeTimes []time.Duration
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+		s.Merged++
+		leadTimes = append(leadTimes, pr.LeadTime)
+		if pr.MergeTime > 0 {
+			mergeTimes = append(mergeTimes, pr.MergeTime)
+		}
+	}
+	_, s.MedianLeadTime = averageAndMedian(leadTimes)
+	_, s.MedianMergeTime = averageAndMedian(mergeTimes)
+	return s
+}
@@ -29,6 +29,7 @@ type Stats struct {
 	MergeTypeTrend              map[string]float64 // squash, merge, rebase
 	CommitFrequencyPerWeek      float64
 	ReleaseCount                int
+	ReleasesPerWeek             float64 // Deployment frequency: releases over the analyzed period (at least one week)
 	AverageApprovalToMerge      time.Duration
 	MedianApprovalToMerge       time.Duration
 	ReopenedPRs                 int
@@ -63,581 +64,507 @@ type Stats struct {
 	MaxReviewCommentsInPR      int
 	PRsWithReviewComments      int
 	PRsWithoutReviewComments   int
-}
 
-func CalculateStats(prs []github.PullRequest) Stats {
-	var totalLeadTime time.Duration
-	var mergedCount int
-	var leadTimes []time.Duration
+	// Cycle-time stage metrics (coding → pickup → review → merge)
+	AverageCodingTime      time.Duration
+	MedianCodingTime       time.Duration
+	AveragePickupTime      time.Duration
+	MedianPickupTime       time.Duration
+	AverageReviewStageTime time.Duration
+	MedianReviewStageTime  time.Duration
+	AverageMergeStageTime  time.Duration
+	MedianMergeStageTime   time.Duration
+
+	// Merge automation metrics (auto-merge and merge bots are reported separately from human merges)
+	AutoMergedPRs                   int
+	BotMergedPRs                    int
+	AutomatedMergeRate              float64
+	AverageAutomatedApprovalToMerge time.Duration
+	MedianAutomatedApprovalToMerge  time.Duration
+
+	// Open PRs by review status and age (at the time of the analysis)
+	OpenPRs          int
+	OpenDraftPRs     int
+	OpenReadyPRs     int // Ready for review, not approved yet
+	OpenApprovedPRs  int // Approved, awaiting merge
+	OpenPRsUnder1Day int
+	OpenPRs1To3Days  int
+	OpenPRs3To7Days  int
+	OpenPRsOver7Days int
+	OldestOpenPR     int // PR number, 0 without open PRs
+	OldestOpenPRAge  time.Duration
+	AverageOpenPRAge time.Duration
+
+	// Merge blockers of open, non-draft PRs (GitHub mergeability); a PR can have several
+	MergeStatePRs        int // Open, non-draft PRs with mergeability data
+	BlockedByConflicts   int
+	BlockedByChecks      int // Failing or pending (required) checks
+	BlockedByReviews     int // Reviews required or changes requested
+	BehindBasePRs        int
+	ReadyToMergePRs      int
+	UnknownMergeStatePRs int // Mergeability not computed yet, or no known blocker
+
+	// Distribution of each timing metric; the Average*/Median* fields above are its Mean and Median
+	LeadTimeSummary                 DurationSummary
+	ReviewTimeSummary               DurationSummary
+	MergeWaitSummary                DurationSummary // Over merged PRs with a wait, unlike AverageMergeWaitTime
+	ApprovalToMergeSummary          DurationSummary
+	AutomatedApprovalToMergeSummary DurationSummary
+	ReopenToMergeSummary            DurationSummary
+	HotfixAfterReleaseSummary       DurationSummary
+	TimeToFirstCommentSummary       DurationSummary // Unlike AverageTimeToFirstComment, averaged over PRs with a comment time
+	TimeToFirstReviewSummary        DurationSummary
+	ReviewResponseSummary           DurationSummary
+	CodingTimeSummary               DurationSummary
+	PickupTimeSummary               DurationSummary
+	ReviewStageSummary              DurationSummary
+	MergeStageSummary               DurationSummary
+
+	// Distribution of the PR size metrics; the Average* fields above are their Mean
+	FilesChangedSummary CountSummary
+	AdditionsSummary    CountSummary
+	DeletionsSummary    CountSummary
+	CommitsPerPRSummary CountSummary // Over the PRs whose commits were fetched (merged PRs)
+
+	// DefaultBranch is the branch whose merges are counted as releases (empty means main/master)
+	DefaultBranch string
+
+	computed map[string]bool // Calculators that ran; nil when all did
+}
 
-	var totalFilesChanged int
-	var totalAdditions int
-	var totalDeletions int
-	var totalReviewTime time.Duration
-	var totalMergeWaitTime time.Duration
-	var totalApprovalToMerge time.Duration
-	var reviewPRCount int
-	var reviewDurations []time.Duration
-	var mergeWaitDurations []time.Duration
-	var approvalToMergeDurations []time.Duration
-	var reopenToMergeDurations []time.Duration
-	var totalCommitToPRTime time.Duration
-	var totalCommits int
-	var validCommitToPRCount int
-	var totalReviewers int
-	var selfMergedCount int
-	var approvalMergeCount int
-	mergeTypeCounts := make(map[string]int)
-	var reopenedPRs int
-	var revertLikeMerges int
-	var releaseMergeTimes []time.Time
-	var hotfixMerges int
-	var hotfixDurations []time.Duration
-	var hotfixWithoutRelease int
-	type hotfixRecord struct {
-		mergedAt time.Time
-	}
-	var hotfixRecords []hotfixRecord
-
-	var openPRs int
-	var earliestPRDate, latestPRDate time.Time
-
-	// Comment timing variables
-	var totalTimeToFirstComment, totalTimeToFirstReview, totalReviewResponseTime time.Duration
-	var timeToFirstCommentSlice, timeToFirstReviewSlice []time.Duration
-	var prsWithComments, prsWithReviews, prsWithResponseTime int
-
-	// Comment quantity variables
-	var totalComments int
-	var commentCountSlice []int
-	var maxComments int
-	var prsWithoutComments int
-	var releaseCount int
-
-	// Review comment quantity variables
-	var totalReviewComments int
-	var reviewCommentCountSlice []int
-	var maxReviewComments int
-	var prsWithReviewComments int
-	var prsWithoutReviewComments int
-
-	for _, pr := range prs {
-		// Track date range for commit frequency calculation
-		if earliestPRDate.IsZero() || pr.CreatedAt.Before(earliestPRDate) {
-			earliestPRDate = pr.CreatedAt
-		}
-		if latestPRDate.IsZero() || pr.CreatedAt.After(latestPRDate) {
-			latestPRDate = pr.CreatedAt
-		}
-		// Lead Time
-		if pr.Merged {
-			totalLeadTime += pr.LeadTime
-			mergedCount++
-			leadTimes = append(leadTimes, pr.LeadTime)
-		}
+// isRevertLike reports whether pr looks like a revert (title heuristic or revert label).
+func isRevertLike(pr github.PullRequest) bool {
+	return strings.Contains(strings.ToLower(pr.Title), "revert") || hasAnyLabel(pr, heuristics.RevertLabels)
+}
 
-		// Average Files Changed, Additions, Deletions
-		totalFilesChanged += pr.ChangedFiles
-		totalAdditions += pr.Additions
-		totalDeletions += pr.Deletions
-
-		// Sort reviews by time once for multiple metrics
-		var firstReviewTime time.Time
-		var lastReviewTime time.Time
-		if len(pr.Reviews) > 0 {
-			sort.Slice(pr.Reviews, func(i, j int) bool {
-				return pr.Reviews[i].SubmittedAt.Before(pr.Reviews[j].SubmittedAt)
-			})
-			firstReviewTime = pr.Reviews[0].SubmittedAt
-			lastReviewTime = pr.Reviews[len(pr.Reviews)-1].SubmittedAt
-		}
-
-		// Average Review Time (creation -> first review)
-		if !firstReviewTime.IsZero() {
-			reviewTime := firstReviewTime.Sub(pr.CreatedAt)
-			if reviewTime > 0 {
-				totalReviewTime += reviewTime
-				reviewPRCount++
-				reviewDurations = append(reviewDurations, reviewTime)
-			}
-		}
+// IsDefaultBranch reports whether branch is the repository's default branch.
+// When the default branch is unknown it falls back to the main/master convention.
+func IsDefaultBranch(branch, defaultBranch string) bool {
+	if defaultBranch != "" {
+		return strings.EqualFold(branch, defaultBranch)
+	}
+	return strings.EqualFold(branch, "main") || strings.EqualFold(branch, "master")
+}
 
-		// Average Merge Wait Time (Approximation: last review to merge time)
-		if pr.Merged && !lastReviewTime.IsZero() {
-			start := lastReviewTime
-
-			// For main/master targets, do not count draft time as "waiting to merge" (unless hotfix prefix).
-			if (strings.EqualFold(pr.BaseRefName, "main") || strings.EqualFold(pr.BaseRefName, "master")) &&
-				pr.IsDraft &&
-				!strings.HasPrefix(strings.ToLower(pr.HeadRefName), "hotfix") {
-				readyTime := firstReviewTime
-				if readyTime.IsZero() {
-					readyTime = pr.MergedAt
-				}
-				if start.Before(readyTime) {
-					start = readyTime
-				}
-			}
+func init() {
+	Register(Calculator{Name: "volume", Compute: computeVolume})
+	Register(Calculator{Name: "lead-time", Compute: computeLeadTime})
+	Register(Calculator{Name: "review-time", Compute: computeReviewTime})
+	Register(Calculator{Name: "merge-wait", Requires: []string{"volume"}, Compute: computeMergeWait})
+	Register(Calculator{Name: "approval-to-merge", Compute: computeApprovalToMerge})
+	Register(Calculator{Name: "merge-automation", Requires: []string{"volume"}, Compute: computeMergeAutomation})
+	Register(Calculator{Name: "cycle-stages", Compute: computeCycleStages})
+	Register(Calculator{Name: "code-change", Compute: computeCodeChange})
+	Register(Calculator{Name: "commit-frequency", Compute: computeCommitFrequency})
+	Register(Calculator{Name: "wip", Compute: computeWIP})
+	Register(Calculator{Name: "open-prs", Compute: computeOpenPRs})
+	Register(Calculator{Name: "merge-blockers", Compute: computeMergeBlockers})
+	Register(Calculator{Name: "reviewers", Compute: computeReviewers})
+	Register(Calculator{Name: "self-merge", Requires: []string{"volume"}, Compute: computeSelfMerge})
+	Register(Calculator{Name: "releases", Compute: computeReleases})
+	Register(Calculator{Name: "reopens", Compute: computeReopens})
+	Register(Calculator{Name: "reverts", Compute: computeReverts})
+	Register(Calculator{Name: "hotfixes", Compute: computeHotfixes})
+	Register(Calculator{Name: "merge-types", Requires: []string{"volume"}, Compute: computeMergeTypes})
+	Register(Calculator{Name: "comments", Compute: computeComments})
+	Register(Calculator{Name: "comment-timing", Requires: []string{"comments"}, Compute: computeCommentTiming})
+	Register(Calculator{Name: "review-comments", Compute: computeReviewComments})
+}
 
-			if pr.MergedAt.After(start) {
-				mergeWaitTime := pr.MergedAt.Sub(start)
-				totalMergeWaitTime += mergeWaitTime
-				mergeWaitDurations = append(mergeWaitDurations, mergeWaitTime)
-			}
+// reviewBounds returns the first and last review submission times (zero without reviews).
+func reviewBounds(pr github.PullRequest) (first, last time.Time) {
+	for _, r := range pr.Reviews {
+		if first.IsZero() || r.SubmittedAt.Before(first) {
+			first = r.SubmittedAt
+		}
+		if last.IsZero() || r.SubmittedAt.After(last) {
+			last = r.SubmittedAt
 		}
+	}
+	return first, last
+}
 
-		// Approval -> merge time
+func computeVolume(in Input, s *Stats) {
+	s.TotalPRs = len(in.PRs)
+	for _, pr := range in.PRs {
 		if pr.Merged {
-			var lastApproval time.Time
-			for _, r := range pr.Reviews {
-				if strings.EqualFold(r.State, "APPROVED") && r.SubmittedAt.After(lastApproval) {
-					lastApproval = r.SubmittedAt
-				}
-			}
-			if !lastApproval.IsZero() && pr.MergedAt.After(lastApproval) {
-				totalApprovalToMerge += pr.MergedAt.Sub(lastApproval)
-				approvalMergeCount++
-				approvalToMergeDurations = append(approvalToMergeDurations, pr.MergedAt.Sub(lastApproval))
-			}
+			s.MergedPRs++
 		}
+	}
+}
 
-		// Average Commits per PR - disabled due to GraphQL complexity
-		// totalCommits += len(pr.Commits)
-
-		// Commit to PR creation time - disabled due to GraphQL complexity
-		// if len(pr.Commits) > 0 {
-		// 	// Find the earliest commit
-		// 	var earliestCommit time.Time
-		// 	for _, commit := range pr.Commits {
-		// 		if earliestCommit.IsZero() || commit.CommittedDate.Before(earliestCommit) {
-		// 			earliestCommit = commit.CommittedDate
-		// 		}
-		// 	}
-		// 	if !earliestCommit.IsZero() {
-		// 		commitToPRTime := pr.CreatedAt.Sub(earliestCommit)
-		// 		if commitToPRTime >= 0 { // Only count positive durations
-		// 			totalCommitToPRTime += commitToPRTime
-		// 			validCommitToPRCount++
-		// 		}
-		// 	}
-		// }
-
-		// WIP PR Count
-		if pr.State == "OPEN" && pr.IsDraft {
-			openPRs++
-		}
-
-		// Average Reviewers per PR
-		reviewers := make(map[string]bool)
-		for _, review := range pr.Reviews {
-			reviewers[review.Author.Login] = true
+func computeLeadTime(in Input, s *Stats) {
+	var leadTimes []time.Duration
+	for _, pr := range in.PRs {
+		if pr.Merged {
+			leadTimes = append(leadTimes, pr.LeadTime)
 		}
-		totalReviewers += len(reviewers)
+	}
+	s.LeadTimeSummary = Summary(leadTimes)
+	s.AverageLeadTime, s.MedianLeadTime = s.LeadTimeSummary.Mean, s.LeadTimeSummary.Median
+}
 
-		// Self-Merge Rate
-		if pr.Merged && pr.Author.Login == pr.MergedBy.Login {
-			selfMergedCount++
+// computeReviewTime measures creation (or ready-for-review) to the first review.
+func computeReviewTime(in Input, s *Stats) {
+	var durations []time.Duration
+	for _, pr := range in.PRs {
+		first, _ := reviewBounds(pr)
+		if first.IsZero() {
+			continue
 		}
-
-		// Release count: merged into main/master
-		if pr.Merged && (strings.EqualFold(pr.BaseRefName, "main") || strings.EqualFold(pr.BaseRefName, "master")) {
-			releaseCount++
-			if !pr.MergedAt.IsZero() {
-				releaseMergeTimes = append(releaseMergeTimes, pr.MergedAt)
-			}
+		if reviewTime := first.Sub(pr.ReviewableAt()); reviewTime > 0 {
+			durations = append(durations, reviewTime)
 		}
+	}
+	// Average only across PRs that actually have review data and valid timestamps
+	s.ReviewTimeSummary = Summary(durations)
+	s.AverageReviewTime, s.MedianReviewTime = s.ReviewTimeSummary.Mean, s.ReviewTimeSummary.Median
+}
 
-		// Reopened metrics
-		if pr.IsReopened {
-			reopenedPRs++
-			if pr.Merged && !pr.FirstReopenedAt.IsZero() && pr.MergedAt.After(pr.FirstReopenedAt) {
-				duration := pr.MergedAt.Sub(pr.FirstReopenedAt)
-				reopenToMergeDurations = append(reopenToMergeDurations, duration)
-			}
-		}
-
-		// Merge Type Trend (Approximation based on merge commit presence and PR state)
-		if pr.Merged {
-			if pr.MergeCommit.Oid != "" {
-				// This is a heuristic. GitHub API doesn't directly expose merge method.
-				// If a merge commit exists, it's likely a merge or squash.
-				// Further analysis of commit history would be needed for true accuracy.
-				mergeTypeCounts["merge/squash"]++
-			} else {
-				// Could be rebase and merge, or other scenarios
-				mergeTypeCounts["rebase/other"]++
-			}
-
-			// Revert-like detection (title heuristic)
-			titleLower := strings.ToLower(pr.Title)
-			if strings.Contains(titleLower, "revert") {
-				revertLikeMerges++
+// computeMergeWait approximates merge wait time as last review to merge.
+func computeMergeWait(in Input, s *Stats) {
+	var durations []time.Duration
+	for _, pr := range in.PRs {
+		first, last := reviewBounds(pr)
+		if !pr.Merged || last.IsZero() {
+			continue
+		}
+		start := last
+
+		// For default branch targets, do not count draft time as "waiting to merge" (unless hotfix prefix).
+		if IsDefaultBranch(pr.BaseRefName, in.DefaultBranch) && pr.IsDraft && !isHotfix(pr) {
+			readyTime := first
+			if readyTime.IsZero() {
+				readyTime = pr.MergedAt
 			}
-
-			// Hotfix detection (head branch prefix)
-			if strings.HasPrefix(strings.ToLower(pr.HeadRefName), "hotfix") {
-				hotfixMerges++
-				if !pr.MergedAt.IsZero() {
-					hotfixRecords = append(hotfixRecords, hotfixRecord{mergedAt: pr.MergedAt})
-				}
+			if start.Before(readyTime) {
+				start = readyTime
 			}
 		}
 
-		// Comment timing statistics
-		if pr.TimeToFirstComment > 0 {
-			totalTimeToFirstComment += pr.TimeToFirstComment
-			timeToFirstCommentSlice = append(timeToFirstCommentSlice, pr.TimeToFirstComment)
+		if pr.MergedAt.After(start) {
+			durations = append(durations, pr.MergedAt.Sub(start))
 		}
+	}
+	// Averaged over all merged PRs, so merges without a wait pull the average down
+	s.MergeWaitSummary = Summary(durations)
+	if s.MergedPRs > 0 {
+		s.AverageMergeWaitTime = sumDurations(durations) / time.Duration(s.MergedPRs)
+	}
+	s.MedianMergeWaitTime = s.MergeWaitSummary.Median
+}
 
-		if pr.TimeToFirstReview > 0 {
-			totalTimeToFirstReview += pr.TimeToFirstReview
-			timeToFirstReviewSlice = append(timeToFirstReviewSlice, pr.TimeToFirstReview)
-			prsWithReviews++
+// computeApprovalToMerge measures the last approval to merge of human merges; automation decides when
+// automated merges land, so they are reported by merge-automation instead.
+func computeApprovalToMerge(in Input, s *Stats) {
+	var durations []time.Duration
+	for _, pr := range in.PRs {
+		if !pr.Merged || pr.IsAutomatedMerge() {
+			continue
 		}
-
-		if pr.AvgReviewResponseTime > 0 {
-			totalReviewResponseTime += pr.AvgReviewResponseTime
-			prsWithResponseTime++
+		if lastApproval := lastApprovalTime(pr); !lastApproval.IsZero() && pr.MergedAt.After(lastApproval) {
+			durations = append(durations, pr.MergedAt.Sub(lastApproval))
 		}
+	}
+	s.ApprovalToMergeSummary = Summary(durations)
+	s.AverageApprovalToMerge, s.MedianApprovalToMerge = s.ApprovalToMergeSummary.Mean, s.ApprovalToMergeSummary.Median
+}
 
-		// Comment quantity statistics
-		totalComments += pr.CommentCount
-		commentCountSlice = append(commentCountSlice, pr.CommentCount)
-		if pr.CommentCount > maxComments {
-			maxComments = pr.CommentCount
+func computeMergeAutomation(in Input, s *Stats) {
+	var durations []time.Duration
+	for _, pr := range in.PRs {
+		if !pr.Merged || !pr.IsAutomatedMerge() {
+			continue
 		}
-		if pr.CommentCount > 0 {
-			prsWithComments++
+		if pr.AutoMergeEnabled {
+			s.AutoMergedPRs++
 		} else {
-			prsWithoutComments++
-		}
-
-		// Review comment quantity statistics
-		totalReviewComments += pr.ReviewCommentCount
-		reviewCommentCountSlice = append(reviewCommentCountSlice, pr.ReviewCommentCount)
-		if pr.ReviewCommentCount > maxReviewComments {
-			maxReviewComments = pr.ReviewCommentCount
+			s.BotMergedPRs++
 		}
-		if pr.ReviewCommentCount > 0 {
-			prsWithReviewComments++
-		} else {
-			prsWithoutReviewComments++
+		if lastApproval := lastApprovalTime(pr); !lastApproval.IsZero() && pr.MergedAt.After(lastApproval) {
+			durations = append(durations, pr.MergedAt.Sub(lastApproval))
 		}
 	}
-
-	var avgLeadTime time.Duration
-	if mergedCount > 0 {
-		avgLeadTime = totalLeadTime / time.Duration(mergedCount)
+	if s.MergedPRs > 0 {
+		s.AutomatedMergeRate = float64(s.AutoMergedPRs+s.BotMergedPRs) / float64(s.MergedPRs) * 100.0
 	}
+	s.AutomatedApprovalToMergeSummary = Summary(nonZero(durations))
+	s.AverageAutomatedApprovalToMerge, s.MedianAutomatedApprovalToMerge = s.AutomatedApprovalToMergeSummary.Mean, s.AutomatedApprovalToMergeSummary.Median
+}
 
-	var medianLeadTime time.Duration
-	if len(leadTimes) > 0 {
-		sort.Slice(leadTimes, func(i, j int) bool {
-			return leadTimes[i] < leadTimes[j]
-		})
-
-		mid := len(leadTimes) / 2
-		if len(leadTimes)%2 == 0 {
-			medianLeadTime = (leadTimes[mid-1] + leadTimes[mid]) / 2
-		} else {
-			medianLeadTime = leadTimes[mid]
-		}
-	}
+// computeCycleStages aggregates the per-PR stages set by CalculateCycleStages.
+func computeCycleStages(in Input, s *Stats) {
+	var coding, pickup, review, merge []time.Duration
+	for _, pr := range in.PRs {
+		coding = append(coding, pr.CodingTime)
+		pickup = append(pickup, pr.PickupTime)
+		review = append(review, pr.ReviewTime)
+		merge = append(merge, pr.MergeTime)
+	}
+	// Stages that could not be measured are zero and left out
+	s.CodingTimeSummary = Summary(nonZero(coding))
+	s.PickupTimeSummary = Summary(nonZero(pickup))
+	s.ReviewStageSummary = Summary(nonZero(review))
+	s.MergeStageSummary = Summary(nonZero(merge))
+	s.AverageCodingTime, s.MedianCodingTime = s.CodingTimeSummary.Mean, s.CodingTimeSummary.Median
+	s.AveragePickupTime, s.MedianPickupTime = s.PickupTimeSummary.Mean, s.PickupTimeSummary.Median
+	s.AverageReviewStageTime, s.MedianReviewStageTime = s.ReviewStageSummary.Mean, s.ReviewStageSummary.Median
+	s.AverageMergeStageTime, s.MedianMergeStageTime = s.MergeStageSummary.Mean, s.MergeStageSummary.Median
+}
 
-	numPRs := float64(len(prs))
+// computeCodeChange summarizes the size of PRs. Commits per PR only covers PRs whose commits were
+// fetched (merged PRs), and commit→PR time stays zero.
+func computeCodeChange(in Input, s *Stats) {
+	files := make([]int, 0, len(in.PRs))
+	additions := make([]int, 0, len(in.PRs))
+	deletions := make([]int, 0, len(in.PRs))
+	var commits []int
+	for _, pr := range in.PRs {
+		files = append(files, pr.ChangedFiles)
+		additions = append(additions, pr.Additions)
+		deletions = append(deletions, pr.Deletions)
+		if len(pr.Commits) > 0 {
+			commits = append(commits, len(pr.Commits))
+		}
+	}
+	s.FilesChangedSummary = SummarizeCounts(files)
+	s.AdditionsSummary = SummarizeCounts(additions)
+	s.DeletionsSummary = SummarizeCounts(deletions)
+	s.CommitsPerPRSummary = SummarizeCounts(commits)
+	s.AverageFilesChanged = s.FilesChangedSummary.Mean
+	s.AverageAdditions = s.AdditionsSummary.Mean
+	s.AverageDeletions = s.DeletionsSummary.Mean
+	s.AverageCommitsPerPR = s.CommitsPerPRSummary.Mean
+}
 
-	avgFilesChanged := 0.0
-	avgAdditions := 0.0
-	avgDeletions := 0.0
-	if numPRs > 0 {
-		avgFilesChanged = float64(totalFilesChanged) / numPRs
-		avgAdditions = float64(totalAdditions) / numPRs
-		avgDeletions = float64(totalDeletions) / numPRs
+// computeCommitFrequency approximates commits per week by PR frequency, as commit data is costly to fetch.
+func computeCommitFrequency(in Input, s *Stats) {
+	earliest, latest := createdRange(in.PRs)
+	if earliest.IsZero() {
+		return
 	}
-
-	avgReviewTime := time.Duration(0)
-	if reviewPRCount > 0 { // Average only across PRs that actually have review data and valid timestamps
-		avgReviewTime = totalReviewTime / time.Duration(reviewPRCount)
-	}
-	medianReviewTime := time.Duration(0)
-	if len(reviewDurations) > 0 {
-		sort.Slice(reviewDurations, func(i, j int) bool { return reviewDurations[i] < reviewDurations[j] })
-		mid := len(reviewDurations) / 2
-		if len(reviewDurations)%2 == 0 {
-			medianReviewTime = (reviewDurations[mid-1] + reviewDurations[mid]) / 2
-		} else {
-			medianReviewTime = reviewDurations[mid]
-		}
+	weeks := latest.Sub(earliest).Hours() / (24 * 7)
+	if weeks > 0 {
+		// Multiply the PR frequency by an estimated number of commits per PR (typical range: 3-5)
+		avgCommitsPerPREstimate := 3.5
+		s.CommitFrequencyPerWeek = (float64(len(in.PRs)) / weeks) * avgCommitsPerPREstimate
 	}
+}
 
-	avgMergeWaitTime := time.Duration(0)
-	if mergedCount > 0 {
-		avgMergeWaitTime = totalMergeWaitTime / time.Duration(mergedCount)
-	}
-	medianMergeWaitTime := time.Duration(0)
-	if len(mergeWaitDurations) > 0 {
-		sort.Slice(mergeWaitDurations, func(i, j int) bool { return mergeWaitDurations[i] < mergeWaitDurations[j] })
-		mid := len(mergeWaitDurations) / 2
-		if len(mergeWaitDurations)%2 == 0 {
-			medianMergeWaitTime = (mergeWaitDurations[mid-1] + mergeWaitDurations[mid]) / 2
-		} else {
-			medianMergeWaitTime = mergeWaitDurations[mid]
+func computeWIP(in Input, s *Stats) {
+	for _, pr := range in.PRs {
+		if isWIP(pr) {
+			s.WIPPRCount++
 		}
 	}
+}
 
-	avgApprovalToMerge := time.Duration(0)
-	if approvalMergeCount > 0 {
-		avgApprovalToMerge = totalApprovalToMerge / time.Duration(approvalMergeCount)
+func computeReviewers(in Input, s *Stats) {
+	if len(in.PRs) == 0 {
+		return
 	}
-	medianApprovalToMerge := time.Duration(0)
-	if len(approvalToMergeDurations) > 0 {
-		sort.Slice(approvalToMergeDurations, func(i, j int) bool { return approvalToMergeDurations[i] < approvalToMergeDurations[j] })
-		mid := len(approvalToMergeDurations) / 2
-		if len(approvalToMergeDurations)%2 == 0 {
-			medianApprovalToMerge = (approvalToMergeDurations[mid-1] + approvalToMergeDurations[mid]) / 2
-		} else {
-			medianApprovalToMerge = approvalToMergeDurations[mid]
+	var total int
+	for _, pr := range in.PRs {
+		reviewers := make(map[string]bool)
+		for _, review := range pr.Reviews {
+			reviewers[review.Author.Login] = true
 		}
+		total += len(reviewers)
 	}
+	s.AverageReviewersPerPR = float64(total) / float64(len(in.PRs))
+}
 
-	avgReopenToMerge := time.Duration(0)
-	medianReopenToMerge := time.Duration(0)
-	if len(reopenToMergeDurations) > 0 {
-		var total time.Duration
-		for _, d := range reopenToMergeDurations {
-			total += d
+func computeSelfMerge(in Input, s *Stats) {
+	var selfMerged int
+	for _, pr := range in.PRs {
+		if pr.Merged && pr.Author.Login == pr.MergedBy.Login {
+			selfMerged++
 		}
-		avgReopenToMerge = total / time.Duration(len(reopenToMergeDurations))
+	}
+	if s.MergedPRs > 0 {
+		s.SelfMergeRate = float64(selfMerged) / float64(s.MergedPRs) * 100.0
+	}
+}
 
-		sort.Slice(reopenToMergeDurations, func(i, j int) bool { return reopenToMergeDurations[i] < reopenToMergeDurations[j] })
-		mid := len(reopenToMergeDurations) / 2
-		if len(reopenToMergeDurations)%2 == 0 {
-			medianReopenToMerge = (reopenToMergeDurations[mid-1] + reopenToMergeDurations[mid]) / 2
-		} else {
-			medianReopenToMerge = reopenToMergeDurations[mid]
+// computeReleases counts merges into a release branch (the default branch unless configured) and the
+// deployment frequency they imply.
+func computeReleases(in Input, s *Stats) {
+	for _, pr := range in.PRs {
+		if pr.Merged && isReleaseBranch(pr.BaseRefName, in.DefaultBranch) {
+			s.ReleaseCount++
 		}
 	}
 
-	// Hotfix after release durations
-	if len(releaseMergeTimes) > 0 {
-		sort.Slice(releaseMergeTimes, func(i, j int) bool { return releaseMergeTimes[i].Before(releaseMergeTimes[j]) })
-	}
-	if len(hotfixRecords) > 0 {
-		for _, h := range hotfixRecords {
-			idx := sort.Search(len(releaseMergeTimes), func(i int) bool {
-				return releaseMergeTimes[i].After(h.mergedAt) || releaseMergeTimes[i].Equal(h.mergedAt)
-			})
-			if idx == 0 {
-				hotfixWithoutRelease++
-				continue
-			}
-			prevRelease := releaseMergeTimes[idx-1]
-			if prevRelease.Before(h.mergedAt) {
-				hotfixDurations = append(hotfixDurations, h.mergedAt.Sub(prevRelease))
-			}
+	// Periods shorter than a week count as one week so a single merge isn't extrapolated
+	earliest, latest := createdRange(in.PRs)
+	if s.ReleaseCount > 0 && !earliest.IsZero() {
+		weeks := latest.Sub(earliest).Hours() / (24 * 7)
+		if weeks < 1 {
+			weeks = 1
 		}
+		s.ReleasesPerWeek = float64(s.ReleaseCount) / weeks
 	}
+}
 
-	avgHotfixAfterRelease := time.Duration(0)
-	medianHotfixAfterRelease := time.Duration(0)
-	if len(hotfixDurations) > 0 {
-		var total time.Duration
-		for _, d := range hotfixDurations {
-			total += d
+func computeReopens(in Input, s *Stats) {
+	var durations []time.Duration
+	for _, pr := range in.PRs {
+		if !pr.IsReopened {
+			continue
 		}
-		avgHotfixAfterRelease = total / time.Duration(len(hotfixDurations))
-
-		sort.Slice(hotfixDurations, func(i, j int) bool { return hotfixDurations[i] < hotfixDurations[j] })
-		mid := len(hotfixDurations) / 2
-		if len(hotfixDurations)%2 == 0 {
-			medianHotfixAfterRelease = (hotfixDurations[mid-1] + hotfixDurations[mid]) / 2
-		} else {
-			medianHotfixAfterRelease = hotfixDurations[mid]
+		s.ReopenedPRs++
+		if pr.Merged && !pr.FirstReopenedAt.IsZero() && pr.MergedAt.After(pr.FirstReopenedAt) {
+			durations = append(durations, pr.MergedAt.Sub(pr.FirstReopenedAt))
 		}
 	}
-
-	avgCommitsPerPR := 0.0
-	if numPRs > 0 {
-		avgCommitsPerPR = float64(totalCommits) / numPRs
-	}
-
-	avgCommitToPRTime := time.Duration(0)
-	if validCommitToPRCount > 0 {
-		avgCommitToPRTime = totalCommitToPRTime / time.Duration(validCommitToPRCount)
+	if len(in.PRs) > 0 {
+		s.ReopenRate = float64(s.ReopenedPRs) / float64(len(in.PRs)) * 100.0
 	}
+	s.ReopenToMergeSummary = Summary(durations)
+	s.AverageReopenToMerge, s.MedianReopenToMerge = s.ReopenToMergeSummary.Mean, s.ReopenToMergeSummary.Median
+}
 
-	avgReviewersPerPR := 0.0
-	if numPRs > 0 {
-		avgReviewersPerPR = float64(totalReviewers) / numPRs
+func computeReverts(in Input, s *Stats) {
+	for _, pr := range in.PRs {
+		if pr.Merged && isRevertLike(pr) {
+			s.RevertLikeMerges++
+		}
 	}
+}
 
-	selfMergeRate := 0.0
-	if mergedCount > 0 {
-		selfMergeRate = float64(selfMergedCount) / float64(mergedCount) * 100.0
+// computeHotfixes counts hotfix merges and measures how long after the preceding release each landed.
+func computeHotfixes(in Input, s *Stats) {
+	var releaseMergeTimes, hotfixMergeTimes []time.Time
+	for _, pr := range in.PRs {
+		if !pr.Merged {
+			continue
+		}
+		if isReleaseBranch(pr.BaseRefName, in.DefaultBranch) && !pr.MergedAt.IsZero() {
+			releaseMergeTimes = append(releaseMergeTimes, pr.MergedAt)
+		}
+		if isHotfix(pr) {
+			s.HotfixMerges++
+			if !pr.MergedAt.IsZero() {
+				hotfixMergeTimes = append(hotfixMergeTimes, pr.MergedAt)
+			}
+		}
 	}
 
-	mergeTypeTrend := make(map[string]float64)
-	if mergedCount > 0 {
-		for k, v := range mergeTypeCounts {
-			mergeTypeTrend[k] = float64(v) / float64(mergedCount) * 100.0
+	sort.Slice(releaseMergeTimes, func(i, j int) bool { return releaseMergeTimes[i].Before(releaseMergeTimes[j]) })
+	var durations []time.Duration
+	for _, mergedAt := range hotfixMergeTimes {
+		idx := sort.Search(len(releaseMergeTimes), func(i int) bool {
+			return !releaseMergeTimes[i].Before(mergedAt)
+		})
+		if idx == 0 {
+			s.HotfixWithoutReleaseContext++
+			continue
+		}
+		if prevRelease := releaseMergeTimes[idx-1]; prevRelease.Before(mergedAt) {
+			durations = append(durations, mergedAt.Sub(prevRelease))
 		}
 	}
+	s.HotfixAfterReleaseSummary = Summary(durations)
+	s.AverageHotfixAfterRelease, s.MedianHotfixAfterRelease = s.HotfixAfterReleaseSummary.Mean, s.HotfixAfterReleaseSummary.Median
+}
 
-	reopenRate := 0.0
-	if len(prs) > 0 {
-		reopenRate = float64(reopenedPRs) / float64(len(prs)) * 100.0
+// computeMergeTypes approximates the merge method from the presence of a merge commit.
+func computeMergeTypes(in Input, s *Stats) {
+	counts := make(map[string]int)
+	for _, pr := range in.PRs {
+		if !pr.Merged {
+			continue
+		}
+		// This is a heuristic: the GraphQL API doesn't expose the merge method directly. A merge commit
+		// means a merge or squash; without one it could be rebase and merge, or other scenarios.
+		if pr.MergeCommit.Oid != "" {
+			counts["merge/squash"]++
+		} else {
+			counts["rebase/other"]++
+		}
 	}
-
-	// Calculate commit frequency per week (approximated by PR frequency since commit data is complex to fetch)
-	commitFrequencyPerWeek := 0.0
-	if !earliestPRDate.IsZero() && !latestPRDate.IsZero() {
-		duration := latestPRDate.Sub(earliestPRDate)
-		weeks := duration.Hours() / (24 * 7) // Convert to weeks
-		if weeks > 0 {
-			// Use PR frequency as a proxy for commit frequency
-			// Multiply by average estimated commits per PR (typical range: 3-5)
-			avgCommitsPerPREstimate := 3.5
-			commitFrequencyPerWeek = (float64(len(prs)) / weeks) * avgCommitsPerPREstimate
+	s.MergeTypeTrend = make(map[string]float64)
+	if s.MergedPRs > 0 {
+		for k, v := range counts {
+			s.MergeTypeTrend[k] = float64(v) / float64(s.MergedPRs) * 100.0
 		}
 	}
+}
 
-	// Calculate comment timing statistics
-	avgTimeToFirstComment := time.Duration(0)
-	if prsWithComments > 0 {
-		avgTimeToFirstComment = totalTimeToFirstComment / time.Duration(prsWithComments)
+func computeComments(in Input, s *Stats) {
+	var total, additions, deletions int
+	counts := make([]int, 0, len(in.PRs))
+	for _, pr := range in.PRs {
+		total += pr.CommentCount
+		additions += pr.Additions
+		deletions += pr.Deletions
+		counts = append(counts, pr.CommentCount)
+		if pr.CommentCount > s.MaxCommentsInPR {
+			s.MaxCommentsInPR = pr.CommentCount
+		}
+		if pr.CommentCount > 0 {
+			s.PRsWithComments++
+		} else {
+			s.PRsWithoutComments++
+		}
 	}
-
-	avgTimeToFirstReview := time.Duration(0)
-	if prsWithReviews > 0 {
-		avgTimeToFirstReview = totalTimeToFirstReview / time.Duration(prsWithReviews)
+	if len(in.PRs) > 0 {
+		s.AverageCommentsPerPR = float64(total) / float64(len(in.PRs))
 	}
+	s.MedianCommentsPerPR = SummarizeCounts(counts).Median
 
-	avgReviewResponseTime := time.Duration(0)
-	if prsWithResponseTime > 0 {
-		avgReviewResponseTime = totalReviewResponseTime / time.Duration(prsWithResponseTime)
+	// Comments per 100 lines of code changed
+	if additions+deletions > 0 {
+		s.CommentDensity = float64(total) / float64(additions+deletions) * 100.0
 	}
+}
 
-	// Calculate median times
-	var medianTimeToFirstComment, medianTimeToFirstReview time.Duration
-
-	if len(timeToFirstCommentSlice) > 0 {
-		sort.Slice(timeToFirstCommentSlice, func(i, j int) bool {
-			return timeToFirstCommentSlice[i] < timeToFirstCommentSlice[j]
-		})
-		mid := len(timeToFirstCommentSlice) / 2
-		if len(timeToFirstCommentSlice)%2 == 0 {
-			medianTimeToFirstComment = (timeToFirstCommentSlice[mid-1] + timeToFirstCommentSlice[mid]) / 2
-		} else {
-			medianTimeToFirstComment = timeToFirstCommentSlice[mid]
+// computeCommentTiming aggregates the per-PR comment timing set during enrichment.
+func computeCommentTiming(in Input, s *Stats) {
+	var toFirstComment, toFirstReview, responseTimes []time.Duration
+	for _, pr := range in.PRs {
+		if pr.TimeToFirstComment > 0 {
+			toFirstComment = append(toFirstComment, pr.TimeToFirstComment)
 		}
-	}
-
-	if len(timeToFirstReviewSlice) > 0 {
-		sort.Slice(timeToFirstReviewSlice, func(i, j int) bool {
-			return timeToFirstReviewSlice[i] < timeToFirstReviewSlice[j]
-		})
-		mid := len(timeToFirstReviewSlice) / 2
-		if len(timeToFirstReviewSlice)%2 == 0 {
-			medianTimeToFirstReview = (timeToFirstReviewSlice[mid-1] + timeToFirstReviewSlice[mid]) / 2
-		} else {
-			medianTimeToFirstReview = timeToFirstReviewSlice[mid]
+		if pr.TimeToFirstReview > 0 {
+			toFirstReview = append(toFirstReview, pr.TimeToFirstReview)
+		}
+		if pr.AvgReviewResponseTime > 0 {
+			responseTimes = append(responseTimes, pr.AvgReviewResponseTime)
 		}
 	}
+	s.PRsWithReviews = len(toFirstReview)
 
-	// Calculate comment quantity statistics
-	avgCommentsPerPR := 0.0
-	if numPRs > 0 {
-		avgCommentsPerPR = float64(totalComments) / numPRs
+	// The time to first comment is averaged over the PRs with comments (see comments)
+	s.TimeToFirstCommentSummary = Summary(toFirstComment)
+	if s.PRsWithComments > 0 {
+		s.AverageTimeToFirstComment = sumDurations(toFirstComment) / time.Duration(s.PRsWithComments)
 	}
+	s.MedianTimeToFirstComment = s.TimeToFirstCommentSummary.Median
+	s.TimeToFirstReviewSummary = Summary(toFirstReview)
+	s.AverageTimeToFirstReview, s.MedianTimeToFirstReview = s.TimeToFirstReviewSummary.Mean, s.TimeToFirstReviewSummary.Median
+	s.ReviewResponseSummary = Summary(responseTimes)
+	s.AverageReviewResponseTime = s.ReviewResponseSummary.Mean
+}
 
-	var medianCommentsPerPR float64
-	if len(commentCountSlice) > 0 {
-		sort.Ints(commentCountSlice)
-		mid := len(commentCountSlice) / 2
-		if len(commentCountSlice)%2 == 0 {
-			medianCommentsPerPR = float64(commentCountSlice[mid-1]+commentCountSlice[mid]) / 2.0
+// computeReviewComments aggregates code review comments (excluding replies).
+func computeReviewComments(in Input, s *Stats) {
+	var total int
+	counts := make([]int, 0, len(in.PRs))
+	for _, pr := range in.PRs {
+		total += pr.ReviewCommentCount
+		counts = append(counts, pr.ReviewCommentCount)
+		if pr.ReviewCommentCount > s.MaxReviewCommentsInPR {
+			s.MaxReviewCommentsInPR = pr.ReviewCommentCount
+		}
+		if pr.ReviewCommentCount > 0 {
+			s.PRsWithReviewComments++
 		} else {
-			medianCommentsPerPR = float64(commentCountSlice[mid])
+			s.PRsWithoutReviewComments++
 		}
 	}
-
-	// Calculate comment density (comments per 100 lines of code changed)
-	commentDensity := 0.0
-	if totalAdditions+totalDeletions > 0 {
-		commentDensity = float64(totalComments) / float64(totalAdditions+totalDeletions) * 100.0
-	}
-
-	// Calculate review comment statistics
-	avgReviewCommentsPerPR := 0.0
-	if numPRs > 0 {
-		avgReviewCommentsPerPR = float64(totalReviewComments) / numPRs
-	}
-
-	var medianReviewCommentsPerPR float64
-	if len(reviewCommentCountSlice) > 0 {
-		sort.Ints(reviewCommentCountSlice)
-		mid := len(reviewCommentCountSlice) / 2
-		if len(reviewCommentCountSlice)%2 == 0 {
-			medianReviewCommentsPerPR = float64(reviewCommentCountSlice[mid-1]+reviewCommentCountSlice[mid]) / 2.0
-		} else {
-			medianReviewCommentsPerPR = float64(reviewCommentCountSlice[mid])
-		}
-	}
-
-	return Stats{
-		AverageLeadTime:             avgLeadTime,
-		MedianLeadTime:              medianLeadTime,
-		MergedPRs:                   mergedCount,
-		TotalPRs:                    len(prs),
-		AverageFilesChanged:         avgFilesChanged,
-		AverageAdditions:            avgAdditions,
-		AverageDeletions:            avgDeletions,
-		AverageReviewTime:           avgReviewTime,
-		MedianReviewTime:            medianReviewTime,
-		AverageMergeWaitTime:        avgMergeWaitTime,
-		MedianMergeWaitTime:         medianMergeWaitTime,
-		AverageApprovalToMerge:      avgApprovalToMerge,
-		MedianApprovalToMerge:       medianApprovalToMerge,
-		AverageReopenToMerge:        avgReopenToMerge,
-		MedianReopenToMerge:         medianReopenToMerge,
-		HotfixMerges:                hotfixMerges,
-		AverageHotfixAfterRelease:   avgHotfixAfterRelease,
-		MedianHotfixAfterRelease:    medianHotfixAfterRelease,
-		HotfixWithoutReleaseContext: hotfixWithoutRelease,
-		AverageCommitToPRTime:       avgCommitToPRTime,
-		AverageCommitsPerPR:         avgCommitsPerPR,
-		ForcePushRate:               0.0, // Cannot accurately calculate with current data
-		WIPPRCount:                  openPRs,
-		AverageReviewersPerPR:       avgReviewersPerPR,
-		SelfMergeRate:               selfMergeRate,
-		MergeTypeTrend:              mergeTypeTrend,
-		CommitFrequencyPerWeek:      commitFrequencyPerWeek,
-		ReopenedPRs:                 reopenedPRs,
-		ReopenRate:                  reopenRate,
-		RevertLikeMerges:            revertLikeMerges,
-		ReleaseCount:                releaseCount,
-
-		// Comment timing metrics
-		AverageTimeToFirstComment: avgTimeToFirstComment,
-		MedianTimeToFirstComment:  medianTimeToFirstComment,
-		AverageTimeToFirstReview:  avgTimeToFirstReview,
-		MedianTimeToFirstReview:   medianTimeToFirstReview,
-		AverageReviewResponseTime: avgReviewResponseTime,
-		PRsWithComments:           prsWithComments,
-		PRsWithReviews:            prsWithReviews,
-
-		// Comment quantity metrics
-		AverageCommentsPerPR: avgCommentsPerPR,
-		MedianCommentsPerPR:  medianCommentsPerPR,
-		CommentDensity:       commentDensity,
-		MaxCommentsInPR:      maxComments,
-		PRsWithoutComments:   prsWithoutComments,
-
-		// Review comment metrics
-		AverageReviewCommentsPerPR: avgReviewCommentsPerPR,
-		MedianReviewCommentsPerPR:  medianReviewCommentsPerPR,
-		MaxReviewCommentsInPR:      maxReviewComments,
-		PRsWithReviewComments:      prsWithReviewComments,
-		PRsWithoutReviewComments:   prsWithoutReviewComments,
+	if len(in.PRs) > 0 {
+		s.AverageReviewCommentsPerPR = float64(total) / float64(len(in.PRs))
 	}
+	s.MedianReviewCommentsPerPR = SummarizeCounts(counts).Median
 }
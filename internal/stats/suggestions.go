@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"visuche/internal/github"
+)
+
+var (
+	suggestionBlockPattern = regexp.MustCompile("(?im)^\\s*```suggestion\\b")
+	batchSuggestionPattern = regexp.MustCompile(`(?i)^apply suggestions from`)
+	noreplyLoginPattern    = regexp.MustCompile(`^(?:\d+\+)?([^@]+)@users\.noreply\.github\.com$`)
+)
+
+// HasSuggestion reports whether a review comment contains a GitHub suggested-change block.
+func HasSuggestion(c github.ReviewComment) bool {
+	return suggestionBlockPattern.MatchString(c.Body)
+}
+
+// SuggestionReport measures how often reviewers propose changes as suggestion blocks and how often
+// authors apply them.
+type SuggestionReport struct {
+	ReviewComments     int     // Review comments by reviewers (not the PR author or bots)
+	Suggestions        int     // ... of which contain a suggestion block
+	SuggestionShare    float64 // Percentage of review comments with a suggestion
+	PRsWithSuggestions int
+	Applied            int
+	AdoptionRate       float64 // Percentage of suggestions applied
+	MedianTimeToApply  time.Duration
+	AverageTimeToApply time.Duration
+}
+
+// CalculateSuggestions counts the suggestion blocks in the review comments attached by FetchReviewComments.
+// Only merged PRs count, since their commits are the ones fetched. A suggestion counts as applied when
+// a later commit credits its reviewer in a Co-authored-by trailer, as GitHub's apply button does; see
+// appliedSuggestions for how many suggestions one commit can account for. The time to apply runs from
+// the comment to that commit.
+func CalculateSuggestions(prs []github.PullRequest) SuggestionReport {
+	var report SuggestionReport
+	var timesToApply []time.Duration
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+		var suggestions []github.ReviewComment
+		for _, c := range pr.ReviewComments {
+			if c.Author == pr.Author.Login || github.IsBotLogin(c.Author) || strings.TrimSpace(c.Body) == "" {
+				continue
+			}
+			report.ReviewComments++
+			if HasSuggestion(c) {
+				suggestions = append(suggestions, c)
+			}
+		}
+		if len(suggestions) == 0 {
+			continue
+		}
+		report.Suggestions += len(suggestions)
+		report.PRsWithSuggestions++
+		for i, appliedAt := range appliedSuggestions(pr.Commits, suggestions) {
+			if !appliedAt.IsZero() {
+				report.Applied++
+				timesToApply = append(timesToApply, positiveDuration(suggestions[i].CreatedAt, appliedAt))
+			}
+		}
+	}
+
+	if report.ReviewComments > 0 {
+		report.SuggestionShare = float64(report.Suggestions) / float64(report.ReviewComments) * 100
+	}
+	if report.Suggestions > 0 {
+		report.AdoptionRate = float64(report.Applied) / float64(report.Suggestions) * 100
+	}
+	report.AverageTimeToApply, report.MedianTimeToApply = averageAndMedian(timesToApply)
+	return report
+}
+
+// appliedSuggestions returns when each suggestion was applied (zero when it wasn't). Suggestions are
+// matched oldest first to the earliest later commit crediting their reviewer that has room left. A
+// commit accounts for one suggestion, except a batch ("Apply suggestions from ...") commit, which
+// accounts for the suggestions of its credited reviewers posted since the previous commit: a batch
+// can only apply suggestions on the current revision.
+func appliedSuggestions(commits []github.Commit, suggestions []github.ReviewComment) []time.Time {
+	commits = append([]github.Commit(nil), commits...)
+	sort.SliceStable(commits, func(i, j int) bool { return commits[i].CommittedDate.Before(commits[j].CommittedDate) })
+
+	room := make([]int, len(commits))
+	for i, commit := range commits {
+		credited := creditedLogins(commit)
+		if len(credited) == 0 {
+			continue
+		}
+		room[i] = 1
+		if !batchSuggestionPattern.MatchString(commit.MessageHeadline) {
+			continue
+		}
+		var since time.Time
+		if i > 0 {
+			since = commits[i-1].CommittedDate
+		}
+		pending := 0
+		for _, s := range suggestions {
+			if credited[strings.ToLower(s.Author)] && s.CreatedAt.After(since) && s.CreatedAt.Before(commit.CommittedDate) {
+				pending++
+			}
+		}
+		if pending > room[i] {
+			room[i] = pending
+		}
+	}
+
+	order := make([]int, len(suggestions))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return suggestions[order[a]].CreatedAt.Before(suggestions[order[b]].CreatedAt) })
+
+	applied := make([]time.Time, len(suggestions))
+	for _, i := range order {
+		s := suggestions[i]
+		for j, commit := range commits {
+			if room[j] == 0 || commit.CommittedDate.Before(s.CreatedAt) || !creditedLogins(commit)[strings.ToLower(s.Author)] {
+				continue
+			}
+			room[j]--
+			applied[i] = commit.CommittedDate
+			break
+		}
+	}
+	return applied
+}
+
+// creditedLogins returns the lowercased logins a commit credits in its Co-authored-by trailers, by name
+// or by GitHub noreply address.
+func creditedLogins(commit github.Commit) map[string]bool {
+	logins := make(map[string]bool)
+	for _, coAuthor := range ParseCoAuthors(commit.MessageBody) {
+		logins[strings.ToLower(coAuthor.Name)] = true
+		if match := noreplyLoginPattern.FindStringSubmatch(coAuthor.Email); match != nil {
+			logins[strings.ToLower(match[1])] = true
+		}
+	}
+	return logins
+}
@@ -0,0 +1,33 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/summary"
+)
+
+// DurationSummary describes the distribution of a timing metric.
+type DurationSummary = summary.DurationSummary
+
+// CountSummary describes the distribution of a per-PR count such as changed files.
+type CountSummary = summary.CountSummary
+
+// Summary summarizes durations (all zero when empty) without reordering them; see summary.Durations.
+func Summary(durations []time.Duration) DurationSummary {
+	return summary.Durations(durations)
+}
+
+// SummarizeCounts summarizes values (all zero when empty) without reordering them; see summary.Counts.
+func SummarizeCounts(values []int) CountSummary {
+	return summary.Counts(values)
+}
+
+// nonZero returns the positive durations, for metrics where zero means "not measured".
+func nonZero(durations []time.Duration) []time.Duration {
+	values := make([]time.Duration, 0, len(durations))
+	for _, d := range durations {
+		if d > 0 {
+			values = append(values, d)
+		}
+	}
+	return values
+}
@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+)
+
+// Weekly time series metric names.
+const (
+	MetricMergedPRs             = "merged_prs"
+	MetricLeadTimeMedianHours   = "lead_time_median_hours"
+	MetricPickupTimeMedianHours = "pickup_time_median_hours"
+	MetricReviewTimeMedianHours = "review_time_median_hours"
+	MetricCIRuns                = "ci_runs"
+	MetricCISuccessRate         = "ci_success_rate"
+)
+
+// PRMetrics and CIMetrics list the available series.
+var (
+	PRMetrics = []string{MetricMergedPRs, MetricLeadTimeMedianHours, MetricPickupTimeMedianHours, MetricReviewTimeMedianHours}
+	CIMetrics = []string{MetricCIRuns, MetricCISuccessRate}
+)
+
+// Point is one bucket of a time series.
+type Point struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// WeekStart returns midnight UTC of the Monday of t's week.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+// WeeklyPRSeries buckets merged PRs by the week they were merged and returns every PR metric.
+func WeeklyPRSeries(prs []github.PullRequest) map[string][]Point {
+	type bucket struct {
+		merged                 int
+		leadTimes, pickup, rev []time.Duration
+	}
+	buckets := make(map[time.Time]*bucket)
+	for _, pr := range prs {
+		if !pr.Merged || pr.MergedAt.IsZero() {
+			continue
+		}
+		week := WeekStart(pr.MergedAt)
+		b, ok := buckets[week]
+		if !ok {
+			b = &bucket{}
+			buckets[week] = b
+		}
+		b.merged++
+		b.leadTimes = append(b.leadTimes, pr.LeadTime)
+		b.pickup = append(b.pickup, pr.PickupTime)
+		b.rev = append(b.rev, pr.ReviewTime)
+	}
+
+	series := make(map[string][]Point, len(PRMetrics))
+	for _, week := range sortedWeeks(buckets) {
+		b := buckets[week]
+		_, lead := averageAndMedian(b.leadTimes)
+		_, pickup := averageAndMedian(b.pickup)
+		_, review := averageAndMedian(b.rev)
+		series[MetricMergedPRs] = append(series[MetricMergedPRs], Point{week, float64(b.merged)})
+		series[MetricLeadTimeMedianHours] = append(series[MetricLeadTimeMedianHours], Point{week, lead.Hours()})
+		series[MetricPickupTimeMedianHours] = append(series[MetricPickupTimeMedianHours], Point{week, pickup.Hours()})
+		series[MetricReviewTimeMedianHours] = append(series[MetricReviewTimeMedianHours], Point{week, review.Hours()})
+	}
+	return series
+}
+
+// WeeklyCISeries buckets completed workflow runs by the week they were created.
+func WeeklyCISeries(runs []actions.WorkflowRun) map[string][]Point {
+	type bucket struct{ total, successes int }
+	buckets := make(map[time.Time]*bucket)
+	for _, run := range runs {
+		if run.Status != "completed" || run.Conclusion == "skipped" || run.Conclusion == "cancelled" {
+			continue
+		}
+		week := WeekStart(run.CreatedAt)
+		b, ok := buckets[week]
+		if !ok {
+			b = &bucket{}
+			buckets[week] = b
+		}
+		b.total++
+		if run.Conclusion == "success" {
+			b.successes++
+		}
+	}
+
+	series := make(map[string][]Point, len(CIMetrics))
+	for _, week := range sortedWeeks(buckets) {
+		b := buckets[week]
+		series[MetricCIRuns] = append(series[MetricCIRuns], Point{week, float64(b.total)})
+		series[MetricCISuccessRate] = append(series[MetricCISuccessRate], Point{week, float64(b.successes) / float64(b.total) * 100})
+	}
+	return series
+}
+
+// sortedWeeks returns the keys of a week-bucket map in chronological order.
+func sortedWeeks[T any](buckets map[time.Time]T) []time.Time {
+	weeks := make([]time.Time, 0, len(buckets))
+	for week := range buckets {
+		weeks = append(weeks, week)
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Before(weeks[j]) })
+	return weeks
+}
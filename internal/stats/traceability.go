@@ -0,0 +1,67 @@
+package stats
+
+import (
+	"time"
+	"visuche/internal/github"
+)
+
+// TraceabilityReport shows how merged PRs trace back to issues and the end-to-end cycle time for linked pairs.
+type TraceabilityReport struct {
+	MergedPRs int
+	LinkedPRs int
+	LinkRate  float64 // Percentage of merged PRs closing at least one issue
+
+	AverageIssueToPR    time.Duration // Issue created → PR opened
+	MedianIssueToPR     time.Duration
+	AveragePRToMerge    time.Duration // PR opened → merged, linked PRs only
+	MedianPRToMerge     time.Duration
+	AverageIssueToMerge time.Duration // Issue created → PR merged (end-to-end)
+	MedianIssueToMerge  time.Duration
+}
+
+// CalculateTraceability builds the linked-issue report from PRs enriched with LinkedIssues.
+// When a PR closes several issues, the oldest one marks the start of the work.
+func CalculateTraceability(prs []github.PullRequest) TraceabilityReport {
+	var report TraceabilityReport
+	var issueToPR, prToMerge, issueToMerge []time.Duration
+
+	for _, pr := range prs {
+		if !pr.Merged || pr.MergedAt.IsZero() {
+			continue
+		}
+		report.MergedPRs++
+
+		issueCreatedAt := earliestIssueCreatedAt(pr.LinkedIssues)
+		if issueCreatedAt.IsZero() {
+			continue
+		}
+		report.LinkedPRs++
+
+		issueToPR = append(issueToPR, positiveDuration(issueCreatedAt, pr.CreatedAt))
+		prToMerge = append(prToMerge, positiveDuration(pr.CreatedAt, pr.MergedAt))
+		issueToMerge = append(issueToMerge, positiveDuration(issueCreatedAt, pr.MergedAt))
+	}
+
+	if report.MergedPRs > 0 {
+		report.LinkRate = float64(report.LinkedPRs) / float64(report.MergedPRs) * 100
+	}
+	report.AverageIssueToPR, report.MedianIssueToPR = averageAndMedian(issueToPR)
+	report.AveragePRToMerge, report.MedianPRToMerge = averageAndMedian(prToMerge)
+	report.AverageIssueToMerge, report.MedianIssueToMerge = averageAndMedian(issueToMerge)
+
+	return report
+}
+
+// earliestIssueCreatedAt returns the creation time of the oldest linked issue, or zero if there is none.
+func earliestIssueCreatedAt(issues []github.LinkedIssue) time.Time {
+	var earliest time.Time
+	for _, issue := range issues {
+		if issue.CreatedAt.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || issue.CreatedAt.Before(earliest) {
+			earliest = issue.CreatedAt
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+)
+
+// WatchSnapshot is the state shown by one refresh of the live view.
+type WatchSnapshot struct {
+	TakenAt          time.Time
+	OpenPRs          int
+	DraftPRs         int
+	Approved         int
+	ChangesRequested int
+	AwaitingReview   int
+	StuckPRs         []QueueItem // Non-draft PRs waiting longer than the threshold, most stale first
+
+	RunsInProgress []actions.WorkflowRun // Queued or running, oldest first
+	RecentRuns     int                   // Completed within the lookback window
+	RecentFailures int
+	LatestRuns     []actions.WorkflowRun // Most recent completed run per workflow
+}
+
+// BuildWatchSnapshot summarizes open PRs and recent workflow runs. PRs waiting longer than stuckAfter are
+// listed as stuck; completed runs older than lookback are ignored.
+func BuildWatchSnapshot(prs []github.PullRequest, runs []actions.WorkflowRun, now time.Time, stuckAfter, lookback time.Duration) WatchSnapshot {
+	snapshot := WatchSnapshot{TakenAt: now}
+
+	for _, pr := range prs {
+		if pr.State != "OPEN" {
+			continue
+		}
+		snapshot.OpenPRs++
+		if pr.IsDraft {
+			snapshot.DraftPRs++
+			continue
+		}
+		switch pr.ReviewDecision {
+		case "APPROVED":
+			snapshot.Approved++
+		case "CHANGES_REQUESTED":
+			snapshot.ChangesRequested++
+		default:
+			snapshot.AwaitingReview++
+		}
+		if item := newQueueItem(pr, now); item.Waiting > stuckAfter {
+			snapshot.StuckPRs = append(snapshot.StuckPRs, item)
+		}
+	}
+	sort.Slice(snapshot.StuckPRs, func(i, j int) bool { return snapshot.StuckPRs[i].Waiting > snapshot.StuckPRs[j].Waiting })
+
+	latest := make(map[string]actions.WorkflowRun)
+	for _, run := range runs {
+		if run.Status != "completed" {
+			snapshot.RunsInProgress = append(snapshot.RunsInProgress, run)
+			continue
+		}
+		if now.Sub(run.UpdatedAt) > lookback {
+			continue
+		}
+		snapshot.RecentRuns++
+		if run.Conclusion == "failure" || run.Conclusion == "timed_out" {
+			snapshot.RecentFailures++
+		}
+		if current, ok := latest[run.WorkflowName]; !ok || run.CreatedAt.After(current.CreatedAt) {
+			latest[run.WorkflowName] = run
+		}
+	}
+	sort.Slice(snapshot.RunsInProgress, func(i, j int) bool {
+		return snapshot.RunsInProgress[i].CreatedAt.Before(snapshot.RunsInProgress[j].CreatedAt)
+	})
+	for _, run := range latest {
+		snapshot.LatestRuns = append(snapshot.LatestRuns, run)
+	}
+	sort.Slice(snapshot.LatestRuns, func(i, j int) bool { return snapshot.LatestRuns[i].WorkflowName < snapshot.LatestRuns[j].WorkflowName })
+
+	return snapshot
+}
@@ -0,0 +1,128 @@
+package stats
+
+import (
+	"sort"
+	"time"
+	"visuche/internal/github"
+)
+
+// WIPDay is the number of PRs open at the end of a day (UTC).
+type WIPDay struct {
+	Date    time.Time
+	Open    int
+	Authors int // Distinct authors with at least one open PR
+}
+
+// AuthorWIP is how many PRs an author keeps open at once, over the days they had any open.
+type AuthorWIP struct {
+	Author     string
+	Average    float64
+	Peak       int
+	ActiveDays int
+}
+
+// WIPReport describes concurrently open PRs over a window.
+type WIPReport struct {
+	Days             []WIPDay
+	AverageOpen      float64
+	PeakOpen         int
+	PeakDate         time.Time
+	AveragePerAuthor float64 // Mean over days of open PRs per author with open PRs
+	Authors          []AuthorWIP
+}
+
+// openInterval returns when pr was open; end is zero while it is still open.
+func openInterval(pr github.PullRequest) (start, end time.Time) {
+	switch {
+	case pr.Merged && !pr.MergedAt.IsZero():
+		end = pr.MergedAt
+	case !pr.ClosedAt.IsZero():
+		end = pr.ClosedAt
+	}
+	return pr.CreatedAt, end
+}
+
+// CalculateWIP reconstructs each PR's open interval and counts the PRs open at the end of every day
+// from `from` through `to` (UTC dates). The last day is sampled at now when it hasn't ended yet.
+func CalculateWIP(prs []github.PullRequest, from, to, now time.Time) WIPReport {
+	var report WIPReport
+	from = truncateDay(from)
+	to = truncateDay(to)
+	if to.Before(from) {
+		return report
+	}
+
+	type authorDays struct {
+		total, peak, days int
+	}
+	perAuthor := make(map[string]*authorDays)
+	var perAuthorSum float64
+	var activeDays int
+	var totalOpen int
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		at := day.AddDate(0, 0, 1)
+		if at.After(now) {
+			at = now
+		}
+
+		openByAuthor := make(map[string]int)
+		open := 0
+		for _, pr := range prs {
+			start, end := openInterval(pr)
+			if start.Before(at) && (end.IsZero() || !end.Before(at)) {
+				open++
+				openByAuthor[pr.Author.Login]++
+			}
+		}
+
+		report.Days = append(report.Days, WIPDay{Date: day, Open: open, Authors: len(openByAuthor)})
+		totalOpen += open
+		if open > report.PeakOpen {
+			report.PeakOpen = open
+			report.PeakDate = day
+		}
+		if len(openByAuthor) > 0 {
+			perAuthorSum += float64(open) / float64(len(openByAuthor))
+			activeDays++
+		}
+		for author, count := range openByAuthor {
+			a, ok := perAuthor[author]
+			if !ok {
+				a = &authorDays{}
+				perAuthor[author] = a
+			}
+			a.total += count
+			a.days++
+			if count > a.peak {
+				a.peak = count
+			}
+		}
+	}
+
+	report.AverageOpen = float64(totalOpen) / float64(len(report.Days))
+	if activeDays > 0 {
+		report.AveragePerAuthor = perAuthorSum / float64(activeDays)
+	}
+	for author, a := range perAuthor {
+		report.Authors = append(report.Authors, AuthorWIP{
+			Author:     author,
+			Average:    float64(a.total) / float64(a.days),
+			Peak:       a.peak,
+			ActiveDays: a.days,
+		})
+	}
+	sort.Slice(report.Authors, func(i, j int) bool {
+		if report.Authors[i].Average != report.Authors[j].Average {
+			return report.Authors[i].Average > report.Authors[j].Average
+		}
+		return report.Authors[i].Author < report.Authors[j].Author
+	})
+	return report
+}
+
+// truncateDay returns midnight UTC of t's date.
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,293 @@
+// Package store keeps pull requests and workflow runs on disk so they can be analyzed without
+// fetching history from the API again. It is filled incrementally by the webhook collector.
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"visuche/internal/actions"
+	"visuche/internal/github"
+)
+
+// File names inside a repository's directory. They match the mock provider's fixtures, so the
+// directory of one repository can also be passed to --fixtures.
+const (
+	PullRequestsFile = "pull_requests.json"
+	WorkflowRunsFile = "workflow_runs.json"
+)
+
+// Store keeps the PRs and runs of each repository in the gh CLI JSON shape, one directory per repository.
+type Store struct {
+	Dir string
+	mu  sync.Mutex
+}
+
+// New returns a store under dir.
+func New(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// DefaultDir returns the default store location ($XDG_DATA_HOME/visuche/store or ~/.local/share/visuche/store).
+func DefaultDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "visuche", "store")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "visuche-store")
+	}
+	return filepath.Join(home, ".local", "share", "visuche", "store")
+}
+
+// RepoDir returns the directory holding repo's data. repo must be a slash-separated path of plain
+// names (owner/repo, or group/subgroup/project on GitLab) that stays inside the store.
+func (s *Store) RepoDir(repo string) (string, error) {
+	parts := strings.Split(repo, "/")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("invalid repository %q, expected owner/repo", repo)
+	}
+	for _, part := range parts {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `\:`) {
+			return "", fmt.Errorf("invalid repository %q, expected owner/repo", repo)
+		}
+	}
+	dir := filepath.Join(s.Dir, filepath.FromSlash(repo))
+	if rel, err := filepath.Rel(s.Dir, dir); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("repository %q is outside the store", repo)
+	}
+	return dir, nil
+}
+
+// PullRequests returns the stored PRs of repo ordered by number.
+func (s *Store) PullRequests(repo string) ([]github.PullRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pullRequests(repo)
+}
+
+// WorkflowRuns returns the stored workflow runs of repo, newest first.
+func (s *Store) WorkflowRuns(repo string) ([]actions.WorkflowRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.workflowRuns(repo)
+}
+
+// PutPullRequests adds prs to repo, replacing stored PRs with the same number.
+func (s *Store) PutPullRequests(repo string, prs ...github.PullRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.putPullRequests(repo, prs)
+}
+
+func (s *Store) putPullRequests(repo string, prs []github.PullRequest) error {
+	stored, err := s.pullRequests(repo)
+	if err != nil {
+		return err
+	}
+	byNumber := make(map[int]github.PullRequest, len(stored)+len(prs))
+	for _, pr := range append(stored, prs...) {
+		byNumber[pr.Number] = pr
+	}
+	merged := make([]github.PullRequest, 0, len(byNumber))
+	for _, pr := range byNumber {
+		merged = append(merged, pr)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Number < merged[j].Number })
+	return s.write(repo, PullRequestsFile, merged)
+}
+
+// ImportPullRequests adds the prs of repo that are not stored yet and returns how many were added.
+// Stored PRs are kept, since they carry more detail than imported exports.
+func (s *Store) ImportPullRequests(repo string, prs []github.PullRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.pullRequests(repo)
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[int]bool, len(stored))
+	for _, pr := range stored {
+		known[pr.Number] = true
+	}
+	var added []github.PullRequest
+	for _, pr := range prs {
+		if !known[pr.Number] {
+			known[pr.Number] = true
+			added = append(added, pr)
+		}
+	}
+	if len(added) == 0 {
+		return 0, nil
+	}
+	return len(added), s.putPullRequests(repo, added)
+}
+
+// PutWorkflowRuns adds runs to repo, replacing stored runs with the same ID but keeping their actor
+// and dispatch inputs when the new run lacks them.
+func (s *Store) PutWorkflowRuns(repo string, runs ...actions.WorkflowRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.workflowRuns(repo)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]actions.WorkflowRun, len(stored)+len(runs))
+	for _, run := range append(stored, runs...) {
+		// gh run list has neither the actor nor the dispatch inputs; keep what a webhook recorded
+		if prev, ok := byID[run.DatabaseId]; ok {
+			if run.Actor == "" {
+				run.Actor = prev.Actor
+			}
+			if run.Inputs == nil {
+				run.Inputs = prev.Inputs
+			}
+		}
+		byID[run.DatabaseId] = run
+	}
+	merged := make([]actions.WorkflowRun, 0, len(byID))
+	for _, run := range byID {
+		merged = append(merged, run)
+	}
+	// Newest first, like gh run list
+	sort.Slice(merged, func(i, j int) bool { return merged[i].DatabaseId > merged[j].DatabaseId })
+	return s.write(repo, WorkflowRunsFile, merged)
+}
+
+// Repos returns the repositories with stored data, sorted.
+func (s *Store) Repos() ([]string, error) {
+	seen := make(map[string]bool)
+	err := filepath.WalkDir(s.Dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (d.Name() != PullRequestsFile && d.Name() != WorkflowRunsFile) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.Dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		seen[filepath.ToSlash(rel)] = true
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store: %w", err)
+	}
+	repos := make([]string, 0, len(seen))
+	for repo := range seen {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	return repos, nil
+}
+
+// Prune removes the PRs of repo closed before cutoff and its workflow runs created before cutoff,
+// returning how many of each were removed. Open PRs are always kept.
+func (s *Store) Prune(repo string, cutoff time.Time) (prs, runs int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	storedPRs, err := s.pullRequests(repo)
+	if err != nil {
+		return 0, 0, err
+	}
+	keptPRs := storedPRs[:0]
+	for _, pr := range storedPRs {
+		if pr.State != "OPEN" && !pr.ClosedAt.IsZero() && pr.ClosedAt.Before(cutoff) {
+			continue
+		}
+		keptPRs = append(keptPRs, pr)
+	}
+	if prs = len(storedPRs) - len(keptPRs); prs > 0 {
+		if err := s.write(repo, PullRequestsFile, keptPRs); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	storedRuns, err := s.workflowRuns(repo)
+	if err != nil {
+		return prs, 0, err
+	}
+	keptRuns := storedRuns[:0]
+	for _, run := range storedRuns {
+		if run.CreatedAt.Before(cutoff) {
+			continue
+		}
+		keptRuns = append(keptRuns, run)
+	}
+	if runs = len(storedRuns) - len(keptRuns); runs > 0 {
+		if err := s.write(repo, WorkflowRunsFile, keptRuns); err != nil {
+			return prs, 0, err
+		}
+	}
+	return prs, runs, nil
+}
+
+func (s *Store) pullRequests(repo string) ([]github.PullRequest, error) {
+	dir, err := s.RepoDir(repo)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, PullRequestsFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store: %w", err)
+	}
+	return github.ParsePullRequests(data)
+}
+
+func (s *Store) workflowRuns(repo string) ([]actions.WorkflowRun, error) {
+	dir, err := s.RepoDir(repo)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, WorkflowRunsFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store: %w", err)
+	}
+	return actions.ParseWorkflowRuns(data)
+}
+
+// write replaces the file atomically so concurrent readers never see a partial file.
+func (s *Store) write(repo, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal store data: %w", err)
+	}
+	dir, err := s.RepoDir(repo)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create store directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, name+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write store: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write store: %w", err)
+	}
+	tmp.Close()
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write store: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Package summary describes distributions of durations and counts. It has no visuche dependencies, so
+// every package reporting percentiles shares one definition.
+package summary
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// DurationSummary describes the distribution of a timing metric.
+type DurationSummary struct {
+	Count  int
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	Median time.Duration
+	P90    time.Duration
+	P95    time.Duration
+	StdDev time.Duration // Population standard deviation
+}
+
+// Durations summarizes durations (all zero when empty) without reordering them. Percentiles interpolate
+// between the closest ranks, so the median of an even count is the mean of the two middle values.
+func Durations(durations []time.Duration) DurationSummary {
+	if len(durations) == 0 {
+		return DurationSummary{}
+	}
+	sorted := SortedDurations(durations)
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	mean := total / time.Duration(len(sorted))
+	var variance float64
+	for _, d := range sorted {
+		diff := float64(d - mean)
+		variance += diff * diff
+	}
+	variance /= float64(len(sorted))
+
+	return DurationSummary{
+		Count:  len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   mean,
+		Median: Percentile(sorted, 50),
+		P90:    Percentile(sorted, 90),
+		P95:    Percentile(sorted, 95),
+		StdDev: time.Duration(math.Sqrt(variance)),
+	}
+}
+
+// SortedDurations returns a sorted copy of durations.
+func SortedDurations(durations []time.Duration) []time.Duration {
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// Percentile returns the p-th percentile of sorted, interpolating between the closest ranks, or zero
+// when it is empty.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lower] + time.Duration((rank-float64(lower))*float64(sorted[lower+1]-sorted[lower]))
+}
+
+// CountSummary describes the distribution of a per-PR count such as changed files.
+type CountSummary struct {
+	Count  int
+	Mean   float64
+	Median float64
+	P90    float64
+}
+
+// Counts summarizes values (all zero when empty) without reordering them, interpolating percentiles
+// like Durations.
+func Counts(values []int) CountSummary {
+	if len(values) == 0 {
+		return CountSummary{}
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	var total int
+	for _, v := range sorted {
+		total += v
+	}
+	floats := make([]float64, len(sorted))
+	for i, v := range sorted {
+		floats[i] = float64(v)
+	}
+	return CountSummary{
+		Count:  len(sorted),
+		Mean:   float64(total) / float64(len(sorted)),
+		Median: percentileFloat(floats, 50),
+		P90:    percentileFloat(floats, 90),
+	}
+}
+
+// Median returns the median of values (zero when empty) without reordering them, interpolating like Durations.
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return percentileFloat(sorted, 50)
+}
+
+// percentileFloat returns the p-th percentile of sorted, interpolating between the closest ranks.
+func percentileFloat(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
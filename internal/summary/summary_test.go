@@ -0,0 +1,117 @@
+package summary
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDurations(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      DurationSummary
+	}{
+		{name: "empty", durations: nil, want: DurationSummary{}},
+		{
+			name:      "one value",
+			durations: []time.Duration{time.Hour},
+			want:      DurationSummary{Count: 1, Min: time.Hour, Max: time.Hour, Mean: time.Hour, Median: time.Hour, P90: time.Hour, P95: time.Hour},
+		},
+		{
+			name:      "two values interpolate",
+			durations: []time.Duration{3 * time.Hour, time.Hour},
+			want: DurationSummary{
+				Count:  2,
+				Min:    time.Hour,
+				Max:    3 * time.Hour,
+				Mean:   2 * time.Hour,
+				Median: 2 * time.Hour,
+				P90:    2*time.Hour + 48*time.Minute,
+				P95:    2*time.Hour + 54*time.Minute,
+				StdDev: time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append([]time.Duration(nil), tt.durations...)
+			if got := Durations(tt.durations); got != tt.want {
+				t.Errorf("Durations(%v) = %+v, want %+v", tt.durations, got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.durations, input) {
+				t.Errorf("Durations reordered its input to %v", tt.durations)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []time.Duration
+		p      float64
+		want   time.Duration
+	}{
+		{name: "empty", sorted: nil, p: 50, want: 0},
+		{name: "one value", sorted: []time.Duration{time.Second}, p: 99, want: time.Second},
+		{name: "two values p0", sorted: []time.Duration{time.Second, 3 * time.Second}, p: 0, want: time.Second},
+		{name: "two values p50", sorted: []time.Duration{time.Second, 3 * time.Second}, p: 50, want: 2 * time.Second},
+		{name: "two values p99", sorted: []time.Duration{time.Second, 3 * time.Second}, p: 99, want: 2980 * time.Millisecond},
+		{name: "two values p100", sorted: []time.Duration{time.Second, 3 * time.Second}, p: 100, want: 3 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Percentile(tt.sorted, tt.p); got != tt.want {
+				t.Errorf("Percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCounts(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   CountSummary
+	}{
+		{name: "empty", values: nil, want: CountSummary{}},
+		{name: "one value", values: []int{7}, want: CountSummary{Count: 1, Mean: 7, Median: 7, P90: 7}},
+		{name: "two values", values: []int{10, 0}, want: CountSummary{Count: 2, Mean: 5, Median: 5, P90: 9}},
+		{name: "three values", values: []int{1, 9, 2}, want: CountSummary{Count: 3, Mean: 4, Median: 2, P90: 7.6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Counts(tt.values)
+			if got.Count != tt.want.Count || !near(got.Mean, tt.want.Mean) || !near(got.Median, tt.want.Median) || !near(got.P90, tt.want.P90) {
+				t.Errorf("Counts(%v) = %+v, want %+v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{name: "empty", values: nil, want: 0},
+		{name: "one value", values: []float64{2.5}, want: 2.5},
+		{name: "two values", values: []float64{4, 1}, want: 2.5},
+		{name: "three values", values: []float64{3, -1, 10}, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Median(tt.values); !near(got, tt.want) {
+				t.Errorf("Median(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+// near compares floats computed by interpolation.
+func near(a, b float64) bool {
+	const epsilon = 1e-9
+	return a-b < epsilon && b-a < epsilon
+}
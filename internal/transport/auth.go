@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// AuthRoute selects the host and credentials of the calls for one repository owner.
+type AuthRoute struct {
+	Owner  string   // Organization, user or GitLab group; "*" matches every owner without a route of its own
+	Host   string   // GitHub Enterprise Server or self-managed GitLab hostname; empty keeps the CLI default
+	Tokens []string // Used in turn, spreading calls over their rate limits; empty keeps the CLI's stored login
+
+	next uint64
+}
+
+var routes []*AuthRoute
+
+// SetAuthRoutes routes later gh/glab calls by the owner of the repository they address.
+func SetAuthRoutes(r []AuthRoute) {
+	mu.Lock()
+	defer mu.Unlock()
+	routes = nil
+	for i := range r {
+		route := r[i]
+		routes = append(routes, &route)
+	}
+}
+
+// graphQLOwnerPattern finds the owner of inline GraphQL queries.
+var graphQLOwnerPattern = regexp.MustCompile(`owner:\s*"([^"]+)"`)
+
+// ownerFromArgs returns the repository owner a gh/glab call addresses: its --repo, the owner of a
+// repos/<owner>/... or projects/<group>%2F... endpoint, a GraphQL owner variable or inline owner, or
+// the owner argument of "repo list". It returns "" when the call names no owner.
+func ownerFromArgs(args []string) string {
+	for i, arg := range args {
+		switch {
+		case (arg == "--repo" || arg == "-R") && i+1 < len(args):
+			parts := strings.Split(args[i+1], "/")
+			if len(parts) == 3 { // HOST/OWNER/REPO
+				return parts[1]
+			}
+			return parts[0]
+		case strings.HasPrefix(arg, "repos/"):
+			return strings.SplitN(strings.TrimPrefix(arg, "repos/"), "/", 2)[0]
+		case strings.HasPrefix(arg, "projects/"):
+			project, _, _ := strings.Cut(strings.TrimPrefix(arg, "projects/"), "/")
+			if unescaped, err := url.PathUnescape(project); err == nil {
+				project = unescaped
+			}
+			return strings.SplitN(project, "/", 2)[0]
+		case strings.HasPrefix(arg, "owner="):
+			return strings.TrimPrefix(arg, "owner=")
+		case strings.HasPrefix(arg, "query="):
+			if m := graphQLOwnerPattern.FindStringSubmatch(arg); m != nil {
+				return m[1]
+			}
+		case arg == "list" && i > 0 && args[i-1] == "repo" && i+1 < len(args):
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// authEnv returns the environment variables that point the call at the host and next token of its route.
+// Only gh and glab calls are routed; other tools never see the tokens.
+func authEnv(name string, args []string) []string {
+	if name != "gh" && name != "glab" {
+		return nil
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if len(routes) == 0 {
+		return nil
+	}
+
+	owner := ownerFromArgs(args)
+	var route *AuthRoute
+	for _, r := range routes {
+		if owner != "" && strings.EqualFold(r.Owner, owner) {
+			route = r
+			break
+		}
+		if r.Owner == "*" && route == nil {
+			route = r
+		}
+	}
+	if route == nil {
+		return nil
+	}
+
+	tokenVars, hostVar := []string{"GH_TOKEN"}, "GH_HOST"
+	if name == "glab" {
+		tokenVars, hostVar = []string{"GITLAB_TOKEN"}, "GITLAB_HOST"
+	} else if route.Host != "" && route.Host != "github.com" && !strings.HasSuffix(route.Host, ".ghe.com") {
+		tokenVars = []string{"GH_ENTERPRISE_TOKEN", "GH_TOKEN"} // gh reads GH_ENTERPRISE_TOKEN for GHES hosts
+	}
+
+	var env []string
+	if route.Host != "" {
+		env = append(env, hostVar+"="+route.Host)
+	}
+	if len(route.Tokens) > 0 {
+		token := route.Tokens[(atomic.AddUint64(&route.next, 1)-1)%uint64(len(route.Tokens))]
+		for _, v := range tokenVars {
+			env = append(env, v+"="+token)
+		}
+	}
+	return env
+}
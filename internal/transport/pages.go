@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// defaultPerPage is the page size of the GitHub REST API when the endpoint doesn't set per_page.
+const defaultPerPage = 30
+
+var perPagePattern = regexp.MustCompile(`[?&]per_page=(\d+)`)
+
+// paginated reports whether the command pages through an API with --paginate, issuing one request per page.
+func (c *Cmd) paginated() bool {
+	for _, arg := range c.Args {
+		if arg == "--paginate" {
+			return true
+		}
+	}
+	return false
+}
+
+// pageMeter wraps the stdout of a paginated command so that take is called before each page after the
+// first is passed on. Without --jq every page is one top-level JSON value; with --jq the pages are
+// counted as per_page output lines each.
+func (c *Cmd) pageMeter(w io.Writer, take func() error) io.Writer {
+	m := &pageMeter{w: w, take: take, lineStart: true}
+	for _, arg := range c.Args {
+		if arg == "--jq" || arg == "-q" {
+			m.perPage = defaultPerPage
+		}
+	}
+	if m.perPage > 0 {
+		for _, arg := range c.Args {
+			if match := perPagePattern.FindStringSubmatch(arg); match != nil {
+				if n, err := strconv.Atoi(match[1]); err == nil && n > 0 {
+					m.perPage = n
+				}
+			}
+		}
+	}
+	return m
+}
+
+// pageMeter detects page boundaries in the output of a paginated command. Blocking in take holds
+// back the command too: once the pipe is full, gh waits before fetching the next page.
+type pageMeter struct {
+	w       io.Writer
+	take    func() error
+	perPage int // Lines per page for --jq output; 0 for raw JSON output
+
+	// Raw JSON output
+	depth    int
+	inString bool
+	escaped  bool
+	values   int
+
+	// Line output
+	lineStart bool
+	lines     int
+}
+
+func (m *pageMeter) Write(p []byte) (int, error) {
+	start := 0
+	for i, b := range p {
+		if !m.newPage(b) {
+			continue
+		}
+		if _, err := m.w.Write(p[start:i]); err != nil {
+			return start, err
+		}
+		start = i
+		if err := m.take(); err != nil {
+			return start, err
+		}
+	}
+	n, err := m.w.Write(p[start:])
+	return start + n, err
+}
+
+// newPage reports whether b is the first byte of a page after the first.
+func (m *pageMeter) newPage(b byte) bool {
+	if m.perPage > 0 {
+		if b == '\n' {
+			m.lineStart = true
+			return false
+		}
+		if !m.lineStart {
+			return false
+		}
+		m.lineStart = false
+		m.lines++
+		return m.lines > 1 && (m.lines-1)%m.perPage == 0
+	}
+
+	if m.inString {
+		switch {
+		case m.escaped:
+			m.escaped = false
+		case b == '\\':
+			m.escaped = true
+		case b == '"':
+			m.inString = false
+		}
+		return false
+	}
+	switch b {
+	case '"':
+		m.inString = true
+	case '{', '[':
+		m.depth++
+		if m.depth == 1 {
+			m.values++
+			return m.values > 1
+		}
+	case '}', ']':
+		m.depth--
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default scheduler limits. Every worker pool of every repository runs its commands through the same
+// scheduler, so analyzing many repositories at once never exceeds these.
+const (
+	DefaultMaxConcurrency = 8
+	// secondaryLimitPause is how long every command waits after GitHub reports a secondary rate limit.
+	secondaryLimitPause = 60 * time.Second
+)
+
+// scheduler bounds the live commands of the whole process: at most concurrency at once, at most
+// perMinute requests per minute (0 for no budget), and a shared pause after a secondary rate limit.
+type scheduler struct {
+	mu          sync.Mutex
+	slots       chan struct{}
+	perMinute   int
+	nextStart   time.Time // Earliest start of the next command under the per-minute budget
+	pausedUntil time.Time
+}
+
+var sched = newScheduler(DefaultMaxConcurrency, 0)
+
+func newScheduler(concurrency, perMinute int) *scheduler {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &scheduler{slots: make(chan struct{}, concurrency), perMinute: perMinute}
+}
+
+// SetLimits replaces the global limits: at most concurrency commands run at once and, when perMinute
+// is positive, at most perMinute requests go out per minute, each page of a paginated call counting
+// as one. Call it before any command runs.
+func SetLimits(concurrency, perMinute int) {
+	mu.Lock()
+	defer mu.Unlock()
+	sched = newScheduler(concurrency, perMinute)
+}
+
+func currentScheduler() *scheduler {
+	mu.RLock()
+	defer mu.RUnlock()
+	return sched
+}
+
+// acquire waits for a free slot and the command's turn in the rate budget. The returned function
+// releases the slot.
+func (s *scheduler) acquire(ctx context.Context) (func(), error) {
+	select {
+	case s.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := func() { <-s.slots }
+	if err := s.take(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// take waits for the next turn in the rate budget, and for any pause to end. Every request counts: a
+// command takes one turn when it starts and a paginated one another turn per further page.
+func (s *scheduler) take(ctx context.Context) error {
+	s.mu.Lock()
+	start := time.Now()
+	if s.pausedUntil.After(start) {
+		start = s.pausedUntil
+	}
+	if s.perMinute > 0 {
+		if s.nextStart.After(start) {
+			start = s.nextStart
+		}
+		s.nextStart = start.Add(time.Minute / time.Duration(s.perMinute))
+	}
+	s.mu.Unlock()
+
+	if wait := time.Until(start); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// pause holds back every command that has not started yet for d.
+func (s *scheduler) pause(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until := time.Now().Add(d); until.After(s.pausedUntil) {
+		s.pausedUntil = until
+		fmt.Fprintf(os.Stderr, "⚠️  GitHub secondary rate limit hit; pausing requests for %s\n", d)
+	}
+}
+
+// isSecondaryRateLimit reports whether stderr of a gh command reports a secondary (abuse) rate limit.
+func isSecondaryRateLimit(stderr string) bool {
+	stderr = strings.ToLower(stderr)
+	return strings.Contains(stderr, "secondary rate limit") || strings.Contains(stderr, "abuse detection")
+}
@@ -0,0 +1,268 @@
+// Package transport runs the external CLIs (gh, glab, gcloud) that visuche talks to. Every call goes
+// through Command so responses can be recorded to disk and replayed later without network access.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode selects how commands are executed.
+type Mode int
+
+const (
+	// Live runs commands normally.
+	Live Mode = iota
+	// Record runs commands and saves each response to the recording directory.
+	Record
+	// Replay serves responses from the recording directory and never runs a command.
+	Replay
+)
+
+var (
+	mu   sync.RWMutex
+	mode = Live
+	dir  string
+)
+
+// SetMode switches every later command to mode, reading or writing recordings under recordingDir.
+func SetMode(m Mode, recordingDir string) error {
+	if m == Record {
+		if err := os.MkdirAll(recordingDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create recording dir: %w", err)
+		}
+	}
+	if m == Replay {
+		info, err := os.Stat(recordingDir)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("recording dir %s does not exist", recordingDir)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	mode, dir = m, recordingDir
+	return nil
+}
+
+func current() (Mode, string) {
+	mu.RLock()
+	defer mu.RUnlock()
+	return mode, dir
+}
+
+// Cmd is an external command. Like exec.Cmd, Stdin, Stdout and Stderr are optional and must be set before Run.
+type Cmd struct {
+	Name   string
+	Args   []string
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Credential marks a command that prints a secret, such as an access token. It always runs live
+	// and is never recorded, so no token ends up in a recording directory.
+	Credential bool
+
+	ctx context.Context
+}
+
+// Command returns the command name with args.
+func Command(name string, args ...string) *Cmd {
+	return CommandContext(context.Background(), name, args...)
+}
+
+// CommandContext is like Command but kills the process when ctx is done.
+func CommandContext(ctx context.Context, name string, args ...string) *Cmd {
+	return &Cmd{Name: name, Args: args, ctx: ctx}
+}
+
+// recording is the on-disk format of one recorded command.
+type recording struct {
+	Command []string `json:"command"`
+	Stdin   string   `json:"stdin,omitempty"`
+	Stdout  string   `json:"stdout"`
+	Stderr  string   `json:"stderr,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// Run runs the command according to the current mode and waits for it to finish.
+func (c *Cmd) Run() error {
+	var stdin []byte
+	if c.Stdin != nil {
+		data, err := io.ReadAll(c.Stdin)
+		if err != nil {
+			return err
+		}
+		stdin = data
+	}
+
+	m, recordingDir := current()
+	if c.Credential {
+		m = Live
+	}
+	path := filepath.Join(recordingDir, c.key(stdin))
+	if m == Replay {
+		return c.replay(path)
+	}
+
+	// Every live command goes through the global scheduler; a secondary rate limit pauses all of them.
+	// Stdout streams to c.Stdout while the command runs, so the command is only retried after the
+	// pause when it has not written anything yet
+	s := currentScheduler()
+	out := &streamWriter{dst: c.Stdout}
+	if m == Record {
+		out.record = &bytes.Buffer{}
+	}
+	stderr, runErr := c.runScheduled(s, stdin, out)
+	if runErr != nil && out.n == 0 && isSecondaryRateLimit(stderr.String()) {
+		s.pause(secondaryLimitPause)
+		stderr, runErr = c.runScheduled(s, stdin, out)
+	}
+	if c.Stderr != nil {
+		_, _ = c.Stderr.Write(stderr.Bytes())
+	}
+	if m != Record {
+		return runErr
+	}
+
+	rec := recording{
+		Command: append([]string{c.Name}, c.Args...),
+		Stdin:   string(stdin),
+		Stdout:  out.record.String(),
+		Stderr:  stderr.String(),
+	}
+	if runErr != nil {
+		rec.Error = runErr.Error()
+	}
+	if err := save(path, rec); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Failed to record %s: %v\n", c.String(), err)
+	}
+	return runErr
+}
+
+// runScheduled runs the command once it gets a slot from s, streaming its output to stdout and
+// capturing stderr. Every page after the first of a paginated call takes its own turn in the rate budget.
+func (c *Cmd) runScheduled(s *scheduler, stdin []byte, stdout io.Writer) (stderr *bytes.Buffer, err error) {
+	stderr = &bytes.Buffer{}
+	release, err := s.acquire(c.ctx)
+	if err != nil {
+		return stderr, err
+	}
+	defer release()
+
+	cmd := exec.CommandContext(c.ctx, c.Name, c.Args...)
+	if env := authEnv(c.Name, c.Args); len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if c.paginated() {
+		cmd.Stdout = c.pageMeter(stdout, func() error { return s.take(c.ctx) })
+	}
+	return stderr, cmd.Run()
+}
+
+// streamWriter passes the output through to dst (if any) as it arrives, keeping a copy in record
+// (if any) and counting the bytes written.
+type streamWriter struct {
+	dst    io.Writer
+	record *bytes.Buffer
+	n      int
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if w.record != nil {
+		w.record.Write(p)
+	}
+	w.n += len(p)
+	if w.dst == nil {
+		return len(p), nil
+	}
+	return w.dst.Write(p)
+}
+
+// Output runs the command and returns its standard output.
+func (c *Cmd) Output() ([]byte, error) {
+	var stdout bytes.Buffer
+	c.Stdout = &stdout
+	err := c.Run()
+	return stdout.Bytes(), err
+}
+
+// String returns the command line, for messages.
+func (c *Cmd) String() string {
+	return strings.Join(append([]string{c.Name}, c.Args...), " ")
+}
+
+// replay writes the recorded output to Stdout/Stderr and returns the recorded error.
+func (c *Cmd) replay(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("no recorded response for %s (record it with --record)", c.String())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %w", err)
+	}
+	var rec recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return fmt.Errorf("failed to parse recording %s: %w", path, err)
+	}
+
+	if c.Stdout != nil {
+		if _, err := io.WriteString(c.Stdout, rec.Stdout); err != nil {
+			return err
+		}
+	}
+	if c.Stderr != nil {
+		_, _ = io.WriteString(c.Stderr, rec.Stderr)
+	}
+	if rec.Error != "" {
+		return errors.New(rec.Error)
+	}
+	return nil
+}
+
+// key names the recording of this command; identical commands with identical input share it.
+func (c *Cmd) key(stdin []byte) string {
+	h := sha256.New()
+	h.Write([]byte(c.Name))
+	for _, arg := range c.Args {
+		h.Write([]byte{0})
+		h.Write([]byte(arg))
+	}
+	h.Write([]byte{0})
+	h.Write(stdin)
+	return c.Name + "-" + hex.EncodeToString(h.Sum(nil))[:24] + ".json"
+}
+
+func save(path string, rec recording) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write via a temp file so parallel fetches of the same command never leave a torn recording
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".recording-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(append(data, '\n'))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,206 @@
+package warehouse
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const bigQueryBaseURL = "https://bigquery.googleapis.com/bigquery/v2"
+
+var (
+	bigQueryIDPattern      = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	bigQueryProjectPattern = regexp.MustCompile(`^[a-z][a-z0-9.:-]*[a-z0-9]$`)
+)
+
+// BigQuery appends rows to a BigQuery table with the streaming insert API.
+type BigQuery struct {
+	Project string
+	Dataset string
+	Table   string
+	Token   string // OAuth access token with the bigquery scope
+	BaseURL string
+	HTTP    *http.Client
+}
+
+// ParseBigQueryTable splits a "dataset.table" or "project.dataset.table" destination; defaultProject is
+// used when the destination has no project.
+func ParseBigQueryTable(dest, defaultProject string) (project, dataset, table string, err error) {
+	parts := strings.Split(dest, ".")
+	switch len(parts) {
+	case 2:
+		project, dataset, table = defaultProject, parts[0], parts[1]
+	case 3:
+		project, dataset, table = parts[0], parts[1], parts[2]
+	default:
+		return "", "", "", fmt.Errorf("invalid BigQuery table %q (expected dataset.table or project.dataset.table)", dest)
+	}
+	if project == "" {
+		return "", "", "", fmt.Errorf("no Google Cloud project for BigQuery table %q: use project.dataset.table or set GOOGLE_CLOUD_PROJECT", dest)
+	}
+	if !bigQueryProjectPattern.MatchString(project) || !bigQueryIDPattern.MatchString(dataset) || !bigQueryIDPattern.MatchString(table) {
+		return "", "", "", fmt.Errorf("invalid BigQuery table %q", dest)
+	}
+	return project, dataset, table, nil
+}
+
+// NewBigQuery returns a client for the dataset.table (or project.dataset.table) destination.
+func NewBigQuery(dest, defaultProject, token string) (*BigQuery, error) {
+	project, dataset, table, err := ParseBigQueryTable(dest, defaultProject)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, fmt.Errorf("no BigQuery access token (set BIGQUERY_ACCESS_TOKEN or log in with gcloud)")
+	}
+	return &BigQuery{
+		Project: project,
+		Dataset: dataset,
+		Table:   table,
+		Token:   token,
+		BaseURL: bigQueryBaseURL,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Insert creates the table (partitioned by day of recorded_at) when it does not exist and streams rows into it.
+// A freshly created table can take a moment to accept streaming inserts, so the first push may need a retry.
+func (b *BigQuery) Insert(rows []Row) error {
+	if err := b.ensureTable(); err != nil {
+		return err
+	}
+
+	type insertRow struct {
+		InsertID string                 `json:"insertId"`
+		JSON     map[string]interface{} `json:"json"`
+	}
+	payload := struct {
+		Rows []insertRow `json:"rows"`
+	}{}
+	for _, r := range rows {
+		record := make(map[string]interface{})
+		for j, v := range r.values() {
+			if t, ok := v.(time.Time); ok {
+				v = t.Format(time.RFC3339Nano)
+			}
+			if v != nil {
+				record[columns[j].name] = v
+			}
+		}
+		// The insert ID lets BigQuery drop duplicates when a push is retried
+		id := sha1.Sum([]byte(r.RunID + "\x00" + r.Metric + "\x00" + r.Labels))
+		payload.Rows = append(payload.Rows, insertRow{InsertID: hex.EncodeToString(id[:]), JSON: record})
+	}
+
+	var result struct {
+		InsertErrors []struct {
+			Index  int `json:"index"`
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		} `json:"insertErrors"`
+	}
+	if err := b.do(http.MethodPost, b.tableURL()+"/insertAll", payload, &result); err != nil {
+		return err
+	}
+	if len(result.InsertErrors) > 0 {
+		e := result.InsertErrors[0]
+		msg := ""
+		if len(e.Errors) > 0 {
+			msg = e.Errors[0].Message
+		}
+		return fmt.Errorf("BigQuery rejected %d rows (row %d: %s)", len(result.InsertErrors), e.Index, msg)
+	}
+	return nil
+}
+
+// ensureTable creates the table with the warehouse schema unless it exists.
+func (b *BigQuery) ensureTable() error {
+	err := b.do(http.MethodGet, b.tableURL(), nil, nil)
+	if err == nil {
+		return nil
+	}
+	if !isNotFound(err) {
+		return err
+	}
+
+	type field struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+		Mode string `json:"mode"`
+	}
+	var fields []field
+	for _, c := range columns {
+		mode := "REQUIRED"
+		if c.nullable {
+			mode = "NULLABLE"
+		}
+		fields = append(fields, field{Name: c.name, Type: c.bigQuery, Mode: mode})
+	}
+	table := map[string]interface{}{
+		"tableReference":   map[string]string{"projectId": b.Project, "datasetId": b.Dataset, "tableId": b.Table},
+		"schema":           map[string]interface{}{"fields": fields},
+		"timePartitioning": map[string]string{"type": "DAY", "field": "recorded_at"},
+	}
+	return b.do(http.MethodPost, fmt.Sprintf("%s/projects/%s/datasets/%s/tables", b.BaseURL, b.Project, b.Dataset), table, nil)
+}
+
+func (b *BigQuery) tableURL() string {
+	return fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s", b.BaseURL, b.Project, b.Dataset, b.Table)
+}
+
+// statusError is a non-2xx response of the BigQuery API.
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("BigQuery API returned %d: %s", e.status, e.msg)
+}
+
+func isNotFound(err error) bool {
+	se, ok := err.(*statusError)
+	return ok && se.status == http.StatusNotFound
+}
+
+// do sends a JSON request and decodes the JSON response into out (when not nil).
+func (b *BigQuery) do(method, url string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+b.Token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &statusError{status: resp.StatusCode, msg: strings.TrimSpace(string(msg))}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
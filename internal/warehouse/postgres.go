@@ -0,0 +1,72 @@
+package warehouse
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	_ "github.com/lib/pq" // postgres driver
+)
+
+// postgresTablePattern accepts table or schema.table made of plain (unquoted) identifiers.
+var postgresTablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Postgres appends rows to a PostgreSQL table.
+type Postgres struct {
+	DSN   string
+	Table string
+}
+
+// NewPostgres returns a client for table (optionally schema-qualified) in the database at dsn.
+func NewPostgres(table, dsn string) (*Postgres, error) {
+	if !postgresTablePattern.MatchString(table) {
+		return nil, fmt.Errorf("invalid PostgreSQL table %q (expected table or schema.table)", table)
+	}
+	if dsn == "" {
+		return nil, fmt.Errorf("no PostgreSQL connection string (set VISUCHE_POSTGRES_DSN or warehouse.postgresDSN in the config)")
+	}
+	return &Postgres{DSN: dsn, Table: table}, nil
+}
+
+// Insert creates the table when it does not exist and inserts rows in one transaction.
+func (p *Postgres) Insert(rows []Row) error {
+	db, err := sql.Open("postgres", p.DSN)
+	if err != nil {
+		return fmt.Errorf("failed to open PostgreSQL database: %w", err)
+	}
+	defer db.Close()
+
+	defs := make([]string, len(columns))
+	names := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	for i, c := range columns {
+		def := c.name + " " + c.postgres
+		if !c.nullable {
+			def += " NOT NULL"
+		}
+		defs[i] = def
+		names[i] = c.name
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	if _, err := db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", p.Table, strings.Join(defs, ", "))); err != nil {
+		return fmt.Errorf("failed to create table %s: %w", p.Table, err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", p.Table, strings.Join(names, ", "), strings.Join(placeholders, ", ")))
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to prepare insert into %s: %w", p.Table, err)
+	}
+	for _, r := range rows {
+		if _, err := stmt.Exec(r.values()...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to insert into %s: %w", p.Table, err)
+		}
+	}
+	return tx.Commit()
+}
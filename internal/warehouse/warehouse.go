@@ -0,0 +1,91 @@
+// Package warehouse appends computed metrics to data warehouse tables (BigQuery, PostgreSQL): one row
+// per metric per analysis run, creating the table on first use.
+package warehouse
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+	"visuche/internal/metrics"
+)
+
+// Run identifies one analysis run; all rows pushed by a run share its ID.
+type Run struct {
+	ID         string
+	RecordedAt time.Time
+	Since      string // Analyzed period (YYYY-MM-DD), empty when unknown
+	Until      string
+}
+
+// NewRun returns a run with a random ID, recorded at now.
+func NewRun(since, until string, now time.Time) Run {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return Run{ID: hex.EncodeToString(id), RecordedAt: now.UTC(), Since: since, Until: until}
+}
+
+// Row is one metric of one analysis run, as stored in the warehouse table.
+type Row struct {
+	RunID      string
+	RecordedAt time.Time
+	Repo       string
+	Since      string
+	Until      string
+	Metric     string
+	Unit       string
+	Value      float64
+	Labels     string // Gauge labels (repo, team...) as a JSON object
+}
+
+// Rows converts gauges to the rows of run.
+func Rows(run Run, gauges []metrics.Gauge) []Row {
+	rows := make([]Row, 0, len(gauges))
+	for _, g := range gauges {
+		labels, _ := json.Marshal(g.Labels)
+		rows = append(rows, Row{
+			RunID:      run.ID,
+			RecordedAt: run.RecordedAt,
+			Repo:       g.Labels["repo"],
+			Since:      run.Since,
+			Until:      run.Until,
+			Metric:     g.Name,
+			Unit:       g.Unit,
+			Value:      g.Value,
+			Labels:     string(labels),
+		})
+	}
+	return rows
+}
+
+// column is a column of the warehouse table with its type in each backend.
+type column struct {
+	name     string
+	bigQuery string
+	postgres string
+	nullable bool
+}
+
+// columns is the table schema shared by the backends, in Row field order.
+var columns = []column{
+	{"run_id", "STRING", "TEXT", false},
+	{"recorded_at", "TIMESTAMP", "TIMESTAMPTZ", false},
+	{"repo", "STRING", "TEXT", false},
+	{"period_since", "DATE", "DATE", true},
+	{"period_until", "DATE", "DATE", true},
+	{"metric", "STRING", "TEXT", false},
+	{"unit", "STRING", "TEXT", false},
+	{"value", "FLOAT64", "DOUBLE PRECISION", false},
+	{"labels", "STRING", "JSONB", false},
+}
+
+// values returns the column values of r in column order; empty dates are nil.
+func (r Row) values() []interface{} {
+	date := func(s string) interface{} {
+		if s == "" {
+			return nil
+		}
+		return s
+	}
+	return []interface{}{r.RunID, r.RecordedAt, r.Repo, date(r.Since), date(r.Until), r.Metric, r.Unit, r.Value, r.Labels}
+}
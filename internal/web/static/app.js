@@ -0,0 +1,202 @@
+// visuche dashboard: fetches the serve-mode JSON API and draws dependency-free SVG charts.
+"use strict";
+
+const SVG_NS = "http://www.w3.org/2000/svg";
+
+function el(name, attrs, text) {
+  const node = document.createElementNS(SVG_NS, name);
+  for (const [key, value] of Object.entries(attrs || {})) {
+    node.setAttribute(key, value);
+  }
+  if (text !== undefined) {
+    node.textContent = text;
+  }
+  return node;
+}
+
+function median(values) {
+  if (values.length === 0) {
+    return 0;
+  }
+  const sorted = [...values].sort((a, b) => a - b);
+  const mid = Math.floor(sorted.length / 2);
+  return sorted.length % 2 === 0 ? (sorted[mid - 1] + sorted[mid]) / 2 : sorted[mid];
+}
+
+// weekStart returns the Monday (UTC) of the week containing date, as YYYY-MM-DD.
+function weekStart(date) {
+  const d = new Date(Date.UTC(date.getUTCFullYear(), date.getUTCMonth(), date.getUTCDate()));
+  const day = (d.getUTCDay() + 6) % 7;
+  d.setUTCDate(d.getUTCDate() - day);
+  return d.toISOString().slice(0, 10);
+}
+
+function formatHours(hours) {
+  if (hours >= 48) {
+    return (hours / 24).toFixed(1) + "d";
+  }
+  return hours.toFixed(1) + "h";
+}
+
+function drawLineChart(container, points, formatValue) {
+  container.replaceChildren();
+  if (points.length === 0) {
+    container.textContent = "No data";
+    return;
+  }
+  const width = 800, height = 240, pad = 40;
+  const svg = el("svg", { viewBox: `0 0 ${width} ${height}` });
+  const max = Math.max(...points.map((p) => p.value)) || 1;
+  const step = points.length > 1 ? (width - pad * 2) / (points.length - 1) : 0;
+  const x = (i) => pad + i * step;
+  const y = (v) => height - pad - (v / max) * (height - pad * 2);
+
+  svg.appendChild(el("line", { x1: pad, y1: height - pad, x2: width - pad, y2: height - pad, stroke: "#d0d7de" }));
+  svg.appendChild(el("text", { x: 4, y: pad }, formatValue(max)));
+  const path = points.map((p, i) => `${i === 0 ? "M" : "L"}${x(i)},${y(p.value)}`).join(" ");
+  svg.appendChild(el("path", { d: path, fill: "none", stroke: "#0969da", "stroke-width": 2 }));
+  points.forEach((p, i) => {
+    const dot = el("circle", { cx: x(i), cy: y(p.value), r: 3, fill: "#0969da" });
+    dot.appendChild(el("title", {}, `${p.label}: ${formatValue(p.value)}`));
+    svg.appendChild(dot);
+    if (points.length <= 12 || i % Math.ceil(points.length / 12) === 0) {
+      svg.appendChild(el("text", { x: x(i), y: height - pad + 16, "text-anchor": "middle" }, p.label.slice(5)));
+    }
+  });
+  container.appendChild(svg);
+}
+
+function drawBarChart(container, bars, formatValue, maxValue) {
+  container.replaceChildren();
+  if (bars.length === 0) {
+    container.textContent = "No data";
+    return;
+  }
+  const rowHeight = 24, labelWidth = 180, width = 800;
+  const height = bars.length * rowHeight + 8;
+  const svg = el("svg", { viewBox: `0 0 ${width} ${height}` });
+  const max = maxValue || Math.max(...bars.map((b) => b.value)) || 1;
+  bars.forEach((bar, i) => {
+    const y = i * rowHeight + 4;
+    const barWidth = ((width - labelWidth - 60) * bar.value) / max;
+    svg.appendChild(el("text", { x: labelWidth - 8, y: y + 15, "text-anchor": "end" }, bar.label));
+    svg.appendChild(el("rect", { x: labelWidth, y, width: Math.max(barWidth, 1), height: rowHeight - 6, rx: 3, fill: bar.color || "#0969da" }));
+    svg.appendChild(el("text", { x: labelWidth + barWidth + 6, y: y + 15 }, formatValue(bar.value)));
+  });
+  container.appendChild(svg);
+}
+
+function renderCards(stats) {
+  const cards = [
+    ["PRs", stats.totalPRs],
+    ["Merged", stats.mergedPRs],
+    ["Lead time (median)", formatHours(stats.medianLeadTimeHours)],
+    ["First review (median)", formatHours(stats.medianTimeToFirstReviewHours)],
+    ["Approval → merge (median)", formatHours(stats.medianApprovalToMergeHours)],
+    ["Releases", stats.releaseCount],
+  ];
+  const container = document.getElementById("cards");
+  container.replaceChildren();
+  for (const [label, value] of cards) {
+    const card = document.createElement("div");
+    card.className = "card";
+    card.innerHTML = `<div class="label"></div><div class="value"></div>`;
+    card.querySelector(".label").textContent = label;
+    card.querySelector(".value").textContent = value;
+    container.appendChild(card);
+  }
+}
+
+function renderLeadTime(prs) {
+  const weeks = new Map();
+  for (const pr of prs) {
+    if (!pr.merged || !pr.mergedAt) {
+      continue;
+    }
+    const week = weekStart(new Date(pr.mergedAt));
+    if (!weeks.has(week)) {
+      weeks.set(week, []);
+    }
+    weeks.get(week).push(pr.leadTimeHours);
+  }
+  const points = [...weeks.keys()].sort().map((week) => ({ label: week, value: median(weeks.get(week)) }));
+  drawLineChart(document.getElementById("lead-time-chart"), points, formatHours);
+}
+
+function renderCI(actions) {
+  const bars = Object.entries(actions.WorkflowStats || {})
+    .map(([name, s]) => ({
+      label: name,
+      value: s.TotalRuns ? (s.Successes / s.TotalRuns) * 100 : 0,
+    }))
+    .sort((a, b) => a.value - b.value)
+    .map((bar) => ({ ...bar, color: bar.value < 80 ? "#cf222e" : "#1a7f37" }));
+  drawBarChart(document.getElementById("ci-chart"), bars, (v) => v.toFixed(0) + "%", 100);
+}
+
+function renderReviewers(prs) {
+  const load = new Map();
+  for (const pr of prs) {
+    for (const reviewer of pr.reviewers || []) {
+      load.set(reviewer, (load.get(reviewer) || 0) + 1);
+    }
+  }
+  const bars = [...load.entries()]
+    .map(([label, value]) => ({ label, value }))
+    .sort((a, b) => b.value - a.value)
+    .slice(0, 15);
+  drawBarChart(document.getElementById("reviewer-chart"), bars, (v) => String(v));
+}
+
+async function fetchJSON(path, params) {
+  const response = await fetch(`${path}?${params}`);
+  const body = await response.json();
+  if (!response.ok) {
+    throw new Error(body.error || response.statusText);
+  }
+  return body;
+}
+
+async function load(form) {
+  const params = new URLSearchParams();
+  for (const [key, value] of new FormData(form)) {
+    if (value) {
+      params.set(key, value);
+    }
+  }
+  history.replaceState(null, "", `?${params}`);
+
+  const status = document.getElementById("status");
+  status.className = "";
+  status.textContent = "Loading… (the first query for a repository can take a while)";
+  try {
+    const [stats, prs, actions] = await Promise.all([
+      fetchJSON("/api/stats", params),
+      fetchJSON("/api/prs", params),
+      fetchJSON("/api/actions", params),
+    ]);
+    renderCards(stats);
+    renderLeadTime(prs);
+    renderCI(actions);
+    renderReviewers(prs);
+    status.textContent = "";
+  } catch (err) {
+    status.className = "error";
+    status.textContent = err.message;
+  }
+}
+
+const form = document.getElementById("query");
+const initial = new URLSearchParams(location.search);
+for (const key of ["repo", "since", "until"]) {
+  if (initial.has(key)) {
+    form.elements[key].value = initial.get(key);
+  }
+}
+form.addEventListener("submit", (event) => {
+  event.preventDefault();
+  load(form);
+});
+if (form.elements.repo.value) {
+  load(form);
+}